@@ -0,0 +1,45 @@
+package deletes
+
+import (
+	"github.com/entiqon/db/contract"
+	"github.com/entiqon/db/dialect"
+)
+
+// DeleteBuilder defines the contract for constructing SQL DELETE queries.
+//
+// Methods:
+//   - From: set the table
+//   - Where / AndWhere / OrWhere: manage WHERE conditions
+//   - Limit: cap the number of deleted rows
+//   - Build / BuildFor: construct the final SQL, optionally for another dialect
+//   - Dialect / Debug: return the dialect given to New and a diagnostic view
+type DeleteBuilder interface {
+	contract.Debuggable
+
+	// From sets the table to delete from.
+	From(table string) DeleteBuilder
+
+	// Where sets the WHERE clause, replacing existing conditions.
+	Where(condition string, values ...any) DeleteBuilder
+
+	// AndWhere appends a condition with AND.
+	AndWhere(condition string, values ...any) DeleteBuilder
+
+	// OrWhere appends a condition with OR.
+	OrWhere(condition string, values ...any) DeleteBuilder
+
+	// Limit caps the number of deleted rows.
+	//
+	// Notes:
+	//   • Only dialects accepting a limit on DELETE (MySQL, SQLite) run it.
+	Limit(n int) DeleteBuilder
+
+	// Build renders the statement for the dialect given to New.
+	Build() (string, []any, error)
+
+	// BuildFor renders the statement for d.
+	BuildFor(d dialect.SQLDialect) (string, []any, error)
+
+	// Dialect returns the dialect given to New, or nil.
+	Dialect() dialect.SQLDialect
+}
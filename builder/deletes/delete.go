@@ -0,0 +1,79 @@
+// File: db/builder/deletes/delete.go
+
+package deletes
+
+import (
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/internal/builder"
+)
+
+// deleteBuilder implements DeleteBuilder on top of the internal DELETE
+// builder.
+type deleteBuilder struct {
+	dialect dialect.SQLDialect
+	inner   *builder.DeleteBuilder
+}
+
+// New creates a new DeleteBuilder rendering for d. If nil is passed, the
+// statement is rendered with "?" placeholders.
+//
+// Example:
+//
+//	sql, args, err := deletes.New(oracle.New()).
+//	    From("users").
+//	    Where("id = ?", 7).
+//	    Build()
+//	// DELETE FROM users WHERE id = :1
+func New(d dialect.SQLDialect) DeleteBuilder {
+	return &deleteBuilder{dialect: d, inner: builder.NewDelete(builder.FromDialect(d))}
+}
+
+// From sets the table to delete from.
+func (b *deleteBuilder) From(table string) DeleteBuilder {
+	b.inner.From(table)
+	return b
+}
+
+// Where sets the WHERE clause, replacing existing conditions.
+func (b *deleteBuilder) Where(condition string, values ...any) DeleteBuilder {
+	b.inner.Where(condition, values...)
+	return b
+}
+
+// AndWhere appends a condition with AND.
+func (b *deleteBuilder) AndWhere(condition string, values ...any) DeleteBuilder {
+	b.inner.AndWhere(condition, values...)
+	return b
+}
+
+// OrWhere appends a condition with OR.
+func (b *deleteBuilder) OrWhere(condition string, values ...any) DeleteBuilder {
+	b.inner.OrWhere(condition, values...)
+	return b
+}
+
+// Limit caps the number of deleted rows.
+func (b *deleteBuilder) Limit(n int) DeleteBuilder {
+	b.inner.Limit(n)
+	return b
+}
+
+// Build renders the statement for the dialect given to New.
+func (b *deleteBuilder) Build() (string, []any, error) {
+	return b.inner.Build()
+}
+
+// BuildFor renders the statement for d; a nil d renders as Build.
+func (b *deleteBuilder) BuildFor(d dialect.SQLDialect) (string, []any, error) {
+	return b.inner.BuildFor(d)
+}
+
+// Dialect returns the dialect given to New, or nil.
+func (b *deleteBuilder) Dialect() dialect.SQLDialect {
+	return b.dialect
+}
+
+// Debug returns a developer-facing summary of the builder state.
+func (b *deleteBuilder) Debug() string {
+	return b.inner.Debug()
+}
@@ -0,0 +1,26 @@
+// Package deletes provides a builder for SQL DELETE statements.
+//
+// # Overview
+//
+// DeleteBuilder constructs DELETE queries with support for:
+//
+//   - Target table (FROM)
+//   - Conditions (WHERE, AND, OR) with bound values
+//   - Row limits, rendered with the dialect pagination syntax
+//
+// # Example
+//
+//	del := deletes.New(postgres.New()).
+//	    From("sessions").
+//	    Where("expires_at < ?", now)
+//
+//	sql, args, err := del.Build()
+//	// DELETE FROM sessions WHERE expires_at < $1
+//
+// # Notes
+//
+//   - Mutators return the builder for chaining.
+//   - Passing nil as dialect renders with "?" placeholders.
+//   - BuildFor renders the same builder for another dialect, so it can be
+//     run with exec.Exec on a runner bound to any dialect.
+package deletes
@@ -0,0 +1,35 @@
+// File: db/builder/deletes/example_test.go
+
+package deletes_test
+
+import (
+	"fmt"
+
+	"github.com/entiqon/db/builder/deletes"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/dialect/sqlite"
+)
+
+func ExampleDeleteBuilder() {
+	del := deletes.New(oracle.New()).
+		From("sessions").
+		Where("user_id = ?", 7).
+		AndWhere("expired", true)
+
+	sql, args, _ := del.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+	// Output:
+	// DELETE FROM sessions WHERE user_id = :1 AND expired = :2
+	// [7 true]
+}
+
+func ExampleDeleteBuilder_limit() {
+	sql, _, _ := deletes.New(nil).
+		From("logs").
+		Where("level = ?", "debug").
+		Limit(1000).
+		BuildFor(sqlite.New())
+	fmt.Println(sql)
+	// Output: DELETE FROM logs WHERE level = ? LIMIT 1000
+}
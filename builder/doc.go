@@ -39,6 +39,43 @@
 //	}
 //	fmt.Println(sql, args)
 //
+// # Transpiling
+//
+// Builders keep their state dialect-neutral until rendering. Builders that
+// implement Renderer can be rendered for any dialect with RenderFor, and
+// Transpile renders one builder for several dialects at once:
+//
+//	results := builder.Transpile(sb, generic.New(), oracle.New())
+//	r := results["oracle"]
+//	fmt.Println(r.SQL, r.Args, r.Emulated, r.Failed)
+//
+// Each Result carries a Report listing the features the dialect lacks:
+// Emulated ones were rewritten (ILIKE as LOWER ... LIKE, NULLS FIRST/LAST
+// as a CASE sort key); Failed ones could not be expressed and set Err.
+//
+// # Parameter Limits
+//
+// Rendering fails with a *dialect.PlaceholderLimitError (matching
+// dialect.ErrTooManyPlaceholders) when a statement binds more values than
+// the dialect's MaxPlaceholderIndex. InStrategy selects how long IN lists
+// are rendered: expanded (the default), bound as one array (InArray),
+// which avoids the limit, or bound in a VALUES derived table (InValues).
+// Chunk splits the rows of a multi-row insert into batches that fit the
+// limit.
+//
+// # Fingerprints
+//
+// Fingerprint hashes the shape of a statement, as returned by Normalize:
+// literals and placeholders become "?", IN lists of placeholders a single
+// one, and comments and spacing are dropped, so statements differing only
+// by their values share it. Builders implementing Fingerprinter compute a
+// hash of the same format from their tokens, whatever their dialect,
+// without rendering SQL; it is not comparable with Fingerprint of their
+// statement:
+//
+//	builder.Normalize("select * from users where id in ($1, $2)")
+//	// SELECT * FROM USERS WHERE ID IN (?)
+//
 // # See also
 //
 // For detailed usage and examples, refer to each builder’s documentation:
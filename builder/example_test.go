@@ -0,0 +1,60 @@
+// File: db/builder/example_test.go
+
+package builder_test
+
+import (
+	"fmt"
+
+	"github.com/entiqon/db/builder"
+	"github.com/entiqon/db/builder/selects"
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/generic"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/dialect/snowflake"
+	"github.com/entiqon/db/token/types/operator"
+)
+
+func ExampleTranspile() {
+	sb := selects.New(nil).
+		Fields("id, name").
+		From("users").
+		Where("name", operator.ILike, "jo%").
+		OrderBy("name").
+		Take(10)
+
+	results := builder.Transpile(sb, generic.New(), oracle.New(), snowflake.New())
+	for _, name := range []string{"generic", "oracle", "snowflake"} {
+		r := results[name]
+		fmt.Printf("%s: %s %v emulated=%v\n", name, r.SQL, r.Args, r.Emulated)
+	}
+
+	// Output:
+	// generic: SELECT id, name FROM users WHERE LOWER(name) LIKE LOWER(?) ORDER BY name LIMIT 10 [jo%] emulated=[ILIKE]
+	// oracle: SELECT id, name FROM users WHERE LOWER(name) LIKE LOWER(:1) ORDER BY name FETCH FIRST 10 ROWS ONLY [jo%] emulated=[ILIKE]
+	// snowflake: SELECT id, name FROM users WHERE name ILIKE ? ORDER BY name LIMIT 10 [jo%] emulated=[]
+}
+
+func ExampleChunk() {
+	d := generic.NewWithOptions(dialect.Options{Name: "capped", MaxPlaceholderIndex: 4})
+	rows := [][]any{{1, "a"}, {2, "b"}, {3, "c"}}
+
+	for _, batch := range builder.Chunk(d, 2, rows) {
+		fmt.Println(batch)
+	}
+
+	// Output:
+	// [[1 a] [2 b]]
+	// [[3 c]]
+}
+
+func ExampleNormalize() {
+	fmt.Println(builder.Normalize("select id from users /* app='billing' */ where name = 'bob' and id in ($1, $2, $3)"))
+	// Output: SELECT ID FROM USERS WHERE NAME = ? AND ID IN (?)
+}
+
+func ExampleFingerprint() {
+	a := builder.Fingerprint("SELECT * FROM users WHERE id IN (?, ?, ?)")
+	b := builder.Fingerprint("SELECT * FROM users WHERE id IN (?, ?)")
+	fmt.Println(a == b)
+	// Output: true
+}
@@ -0,0 +1,184 @@
+// File: db/builder/fingerprint.go
+
+package builder
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"github.com/entiqon/db/dialect"
+)
+
+// Fingerprinter is implemented by builders that compute the fingerprint
+// of their statement from their tokens, whatever the dialect and values.
+type Fingerprinter interface {
+	// Fingerprint returns the fingerprint of the statement shape.
+	Fingerprint() string
+}
+
+// Fingerprint returns a short, stable hash of the shape of sql: the hash
+// of Normalize(sql). Statements differing only by their values, the
+// number of elements of their IN lists, their placeholder style, their
+// comments or their spacing share it, so it can key latency histograms
+// and slow-query reports.
+//
+// Example:
+//
+//	builder.Fingerprint("SELECT * FROM users WHERE id IN (1, 2, 3)") ==
+//	    builder.Fingerprint("select * from users where id in ($1, $2)") // true
+func Fingerprint(sql string) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(Normalize(sql)))
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+// Normalize returns the shape of sql, for raw statements that do not go
+// through a builder:
+//
+//   - literals (strings, numbers) and placeholders (?, $1, :1, :name, @p1)
+//     are replaced with "?"
+//   - IN lists of "?" are reduced to a single one: IN (?)
+//   - comments, trailing semicolons and redundant spacing are removed
+//   - unquoted words are uppercased; quoted identifiers are kept
+//
+// Literals are read as standard SQL (see dialect.Sanitize).
+//
+// Example:
+//
+//	builder.Normalize("select id from users /* app='billing' */ where name = 'bob' and id in (:1, :2)")
+//	// SELECT ID FROM USERS WHERE NAME = ? AND ID IN (?)
+func Normalize(sql string) string {
+	tokens := collapseIn(shapeTokens(dialect.Sanitize(nil, sql)))
+	for len(tokens) > 0 && tokens[len(tokens)-1] == ";" {
+		tokens = tokens[:len(tokens)-1]
+	}
+
+	var sb strings.Builder
+	for i, t := range tokens {
+		if i > 0 && spaced(tokens[i-1], t) {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(t)
+	}
+	return sb.String()
+}
+
+// operators are the two-byte operators read as one token by shapeTokens.
+var operators = []string{"<=", ">=", "<>", "!=", "||", "::", "->"}
+
+// shapeTokens splits a sanitized statement into tokens, dropping comments
+// and spacing, uppercasing words and replacing placeholders with "?".
+func shapeTokens(s string) []string {
+	var tokens []string
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+		case strings.HasPrefix(s[i:], "--"):
+			if j := strings.IndexByte(s[i:], '\n'); j > 0 {
+				i += j
+			} else {
+				i = len(s)
+			}
+		case strings.HasPrefix(s[i:], "/*"):
+			if j := strings.Index(s[i+2:], "*/"); j >= 0 {
+				i += 2 + j + 2
+			} else {
+				i = len(s)
+			}
+		case c == '"' || c == '`':
+			j := i + 1
+			for ; j < len(s); j++ {
+				if s[j] == c {
+					if j+1 < len(s) && s[j+1] == c {
+						j++
+						continue
+					}
+					break
+				}
+			}
+			j = min(j+1, len(s))
+			tokens = append(tokens, s[i:j])
+			i = j
+		case c == '?' || c == '$' && i+1 < len(s) && isDigit(s[i+1]):
+			i = wordEnd(s, i+1)
+			tokens = append(tokens, "?")
+		case (c == ':' || c == '@') && i+1 < len(s) && s[i+1] != c && isWordByte(s[i+1]):
+			i = wordEnd(s, i+1)
+			tokens = append(tokens, "?")
+		case isWordByte(c) || c == '@':
+			j := i + 1
+			for j < len(s) && s[j] == '@' {
+				j++ // system variables, such as @@version
+			}
+			j = wordEnd(s, j)
+			tokens = append(tokens, strings.ToUpper(s[i:j]))
+			i = j
+		default:
+			t := s[i : i+1]
+			for _, op := range operators {
+				if strings.HasPrefix(s[i:], op) {
+					t = op
+					break
+				}
+			}
+			tokens = append(tokens, t)
+			i += len(t)
+		}
+	}
+	return tokens
+}
+
+// collapseIn reduces the lists of placeholders following IN to one
+// placeholder.
+func collapseIn(tokens []string) []string {
+	out := make([]string, 0, len(tokens))
+	for i := 0; i < len(tokens); i++ {
+		out = append(out, tokens[i])
+		if tokens[i] != "IN" || i+2 >= len(tokens) || tokens[i+1] != "(" || tokens[i+2] != "?" {
+			continue
+		}
+		j := i + 3
+		for j+1 < len(tokens) && tokens[j] == "," && tokens[j+1] == "?" {
+			j += 2
+		}
+		if j < len(tokens) && tokens[j] == ")" {
+			out = append(out, "(", "?", ")")
+			i = j
+		}
+	}
+	return out
+}
+
+// spaced reports whether Normalize separates token t from the token prev
+// before it.
+func spaced(prev, t string) bool {
+	switch {
+	case t == ")" || t == "," || t == "." || t == "]" || t == "::":
+		return false
+	case prev == "(" || prev == "." || prev == "[" || prev == "::":
+		return false
+	}
+	return true
+}
+
+// wordEnd returns the end of the word continuing at s[i].
+func wordEnd(s string, i int) int {
+	for i < len(s) && isWordByte(s[i]) {
+		i++
+	}
+	return i
+}
+
+// isWordByte reports whether c can be part of an unquoted word.
+func isWordByte(c byte) bool {
+	return c == '_' || c == '$' || c == '#' || isDigit(c) ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+// isDigit reports whether c is an ASCII digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,83 @@
+// File: db/builder/fingerprint_test.go
+
+package builder_test
+
+import (
+	"testing"
+
+	"github.com/entiqon/db/builder"
+)
+
+func TestFingerprint(t *testing.T) {
+	t.Run("Normalize", func(t *testing.T) {
+		tests := []struct {
+			sql, want string
+		}{
+			{
+				"select id from users where name = 'bob' and age > 30",
+				"SELECT ID FROM USERS WHERE NAME = ? AND AGE > ?",
+			},
+			{
+				"SELECT * FROM t WHERE a = ? AND b = $2 AND c = :3 AND d = :name AND e = @p5 AND f = ?1",
+				"SELECT * FROM T WHERE A = ? AND B = ? AND C = ? AND D = ? AND E = ? AND F = ?",
+			},
+			{
+				"SELECT * FROM t WHERE id IN (1, 2, 3) AND k NOT IN (:1,:2) AND s IN (SELECT id FROM u)",
+				"SELECT * FROM T WHERE ID IN (?) AND K NOT IN (?) AND S IN (SELECT ID FROM U)",
+			},
+			{
+				"SELECT  id -- first\n FROM t /* app='billing' */ ;",
+				"SELECT ID FROM T",
+			},
+			{
+				`SELECT "Mixed""Case", t.id, x::text FROM t WHERE a <> -5 OR b >= 1.5e-3`,
+				`SELECT "Mixed""Case", T.ID, X::TEXT FROM T WHERE A <> - ? OR B >= ?`,
+			},
+			{
+				"SELECT @@version, N'x', E'\\n' FROM dual",
+				"SELECT @@VERSION, ?, ? FROM DUAL",
+			},
+		}
+		for _, tt := range tests {
+			if got := builder.Normalize(tt.sql); got != tt.want {
+				t.Errorf("Normalize(%q):\nexpected %q\n     got %q", tt.sql, tt.want, got)
+			}
+		}
+	})
+
+	t.Run("Shared", func(t *testing.T) {
+		same := []string{
+			"SELECT * FROM users WHERE id IN (?, ?, ?)",
+			"SELECT * FROM users WHERE id IN (?, ?)",
+			"select * from users where id in ($1, $2, $3, $4)",
+			"SELECT *\nFROM users\nWHERE id IN (7) /*route='%2Fusers'*/",
+		}
+		want := builder.Fingerprint(same[0])
+		if len(want) != 16 {
+			t.Errorf("expected a 16 digit fingerprint, got %q", want)
+		}
+		for _, sql := range same[1:] {
+			if got := builder.Fingerprint(sql); got != want {
+				t.Errorf("expected %q to share fingerprint %s, got %s", sql, want, got)
+			}
+		}
+	})
+
+	t.Run("Distinct", func(t *testing.T) {
+		distinct := []string{
+			"SELECT * FROM users WHERE id IN (?)",
+			"SELECT * FROM users WHERE id NOT IN (?)",
+			"SELECT * FROM users WHERE id = ?",
+			"SELECT * FROM orders WHERE id = ?",
+			`SELECT * FROM "Users" WHERE id = ?`,
+		}
+		seen := map[string]string{}
+		for _, sql := range distinct {
+			f := builder.Fingerprint(sql)
+			if prev, ok := seen[f]; ok {
+				t.Errorf("expected %q and %q to differ, both got %s", prev, sql, f)
+			}
+			seen[f] = sql
+		}
+	})
+}
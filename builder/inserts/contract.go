@@ -0,0 +1,57 @@
+package inserts
+
+import (
+	"github.com/entiqon/db/contract"
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/internal/builder"
+)
+
+// Statement is one statement of a batched build, with its bound
+// arguments.
+type Statement = builder.Statement
+
+// InsertBuilder defines the contract for constructing SQL INSERT queries.
+//
+// Methods:
+//   - Into / Columns / Values: set the table, columns and rows
+//   - FromStruct: derive columns, rows and RETURNING from tagged structs
+//   - Returning: add RETURNING columns
+//   - Build / BuildFor / BuildBatches: construct the final SQL, optionally for another dialect
+//   - Dialect / Debug: return the dialect given to New and a diagnostic view
+type InsertBuilder interface {
+	contract.Debuggable
+
+	// Into sets the target table.
+	Into(table string) InsertBuilder
+
+	// Columns sets the column names, replacing existing ones.
+	//
+	// Notes:
+	//   • Aliased columns ("name AS n") are rejected at Build.
+	Columns(names ...string) InsertBuilder
+
+	// Values appends a row of values, one per column.
+	Values(row ...any) InsertBuilder
+
+	// FromStruct sets the columns and rows from a struct, a pointer to
+	// one, or a slice of either, tagged with
+	// `db:"name,pk,omitempty,readonly,default"`. Generated fields are
+	// returned when the dialect supports RETURNING.
+	FromStruct(v any) InsertBuilder
+
+	// Returning appends columns to the RETURNING clause.
+	Returning(fields ...string) InsertBuilder
+
+	// Build renders the statement for the dialect given to New.
+	Build() (string, []any, error)
+
+	// BuildFor renders the statement for d.
+	BuildFor(d dialect.SQLDialect) (string, []any, error)
+
+	// BuildBatches renders one statement per batch of rows, each binding
+	// no more placeholders than the dialect accepts.
+	BuildBatches() ([]Statement, error)
+
+	// Dialect returns the dialect given to New, or nil.
+	Dialect() dialect.SQLDialect
+}
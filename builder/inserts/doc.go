@@ -0,0 +1,29 @@
+// Package inserts provides a builder for SQL INSERT statements.
+//
+// # Overview
+//
+// InsertBuilder constructs INSERT queries with support for:
+//
+//   - Target table (INTO) and column lists
+//   - One or more rows of bound values
+//   - Rows derived from tagged structs (FromStruct)
+//   - RETURNING, rendered by the dialect (RETURNING ... INTO on Oracle)
+//   - Batches split under the dialect placeholder limit (BuildBatches)
+//
+// # Example
+//
+//	ib := inserts.New(postgres.New()).
+//	    Into("users").
+//	    Columns("id", "name").
+//	    Values(1, "Ada")
+//
+//	sql, args, err := ib.Build()
+//	// INSERT INTO users (id, name) VALUES ($1, $2)  [1 Ada]
+//
+// # Notes
+//
+//   - Mutators return the builder for chaining.
+//   - Passing nil as dialect renders with "?" placeholders.
+//   - BuildFor renders the same builder for another dialect, so it can be
+//     run with exec.Exec on a runner bound to any dialect.
+package inserts
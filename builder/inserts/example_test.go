@@ -0,0 +1,55 @@
+// File: db/builder/inserts/example_test.go
+
+package inserts_test
+
+import (
+	"fmt"
+
+	"github.com/entiqon/db/builder/inserts"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/dialect/postgres"
+)
+
+func ExampleInsertBuilder() {
+	ib := inserts.New(postgres.New()).
+		Into("users").
+		Columns("id", "name").
+		Values(1, "Ada").
+		Values(2, "Grace")
+
+	sql, args, _ := ib.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+	// Output:
+	// INSERT INTO users (id, name) VALUES ($1, $2), ($3, $4)
+	// [1 Ada 2 Grace]
+}
+
+func ExampleInsertBuilder_fromStruct() {
+	type User struct {
+		ID    int64  `db:"id,pk,default"`
+		Email string `db:"email"`
+	}
+
+	sql, args, _ := inserts.New(postgres.New()).
+		Into("users").
+		FromStruct(User{Email: "ada@example.com"}).
+		Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+	// Output:
+	// INSERT INTO users (email) VALUES ($1) RETURNING id
+	// [ada@example.com]
+}
+
+func ExampleInsertBuilder_buildFor() {
+	ib := inserts.New(nil).
+		Into("users").
+		Columns("id", "name").
+		Values(1, "Ada").
+		Returning("id")
+
+	sql, _, _ := ib.BuildFor(oracle.New())
+	fmt.Println(sql)
+	// Output: INSERT INTO users (id, name) VALUES (:1, :2) RETURNING id INTO :3
+}
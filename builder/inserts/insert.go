@@ -0,0 +1,94 @@
+// File: db/builder/inserts/insert.go
+
+package inserts
+
+import (
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/internal/builder"
+)
+
+// insertBuilder implements InsertBuilder on top of the internal INSERT
+// builder.
+type insertBuilder struct {
+	dialect dialect.SQLDialect
+	inner   *builder.InsertBuilder
+}
+
+// New creates a new InsertBuilder rendering for d. If nil is passed, the
+// statement is rendered with "?" placeholders.
+//
+// Example:
+//
+//	sql, args, err := inserts.New(postgres.New()).
+//	    Into("users").
+//	    Columns("id", "name").
+//	    Values(1, "Ada").
+//	    Build()
+//	// INSERT INTO users (id, name) VALUES ($1, $2)
+func New(d dialect.SQLDialect) InsertBuilder {
+	return &insertBuilder{dialect: d, inner: builder.NewInsert(builder.FromDialect(d))}
+}
+
+// Into sets the target table.
+func (b *insertBuilder) Into(table string) InsertBuilder {
+	b.inner.Into(table)
+	return b
+}
+
+// Columns sets the column names, replacing existing ones.
+func (b *insertBuilder) Columns(names ...string) InsertBuilder {
+	b.inner.Columns(names...)
+	return b
+}
+
+// Values appends a row of values, one per column.
+func (b *insertBuilder) Values(row ...any) InsertBuilder {
+	b.inner.Values(row...)
+	return b
+}
+
+// FromStruct sets the columns and rows from tagged structs.
+//
+// Example:
+//
+//	type User struct {
+//	    ID    int64  `db:"id,pk,default"`
+//	    Email string `db:"email"`
+//	}
+//	inserts.New(postgres.New()).Into("users").FromStruct(User{Email: "a@b.c"})
+//	// INSERT INTO users (email) VALUES ($1) RETURNING id
+func (b *insertBuilder) FromStruct(v any) InsertBuilder {
+	b.inner.FromStruct(v)
+	return b
+}
+
+// Returning appends columns to the RETURNING clause.
+func (b *insertBuilder) Returning(fields ...string) InsertBuilder {
+	b.inner.Returning(fields...)
+	return b
+}
+
+// Build renders the statement for the dialect given to New.
+func (b *insertBuilder) Build() (string, []any, error) {
+	return b.inner.Build()
+}
+
+// BuildFor renders the statement for d; a nil d renders as Build.
+func (b *insertBuilder) BuildFor(d dialect.SQLDialect) (string, []any, error) {
+	return b.inner.BuildFor(d)
+}
+
+// BuildBatches renders one statement per batch of rows.
+func (b *insertBuilder) BuildBatches() ([]Statement, error) {
+	return b.inner.BuildBatches()
+}
+
+// Dialect returns the dialect given to New, or nil.
+func (b *insertBuilder) Dialect() dialect.SQLDialect {
+	return b.dialect
+}
+
+// Debug returns a developer-facing summary of the builder state.
+func (b *insertBuilder) Debug() string {
+	return b.inner.Debug()
+}
@@ -0,0 +1,68 @@
+// File: db/builder/limits.go
+
+package builder
+
+import (
+	"github.com/entiqon/db/dialect"
+)
+
+// InStrategy selects how builders render IN / NOT IN lists. Long lists
+// bind one placeholder per element by default and can overflow the
+// dialect's MaxPlaceholderIndex; the other strategies avoid that.
+type InStrategy uint8
+
+const (
+	// InExpand binds one placeholder per element: id IN (?, ?, ?).
+	InExpand InStrategy = iota
+
+	// InArray binds the whole list to one placeholder as an array:
+	// id = ANY(?) / id <> ALL(?). It requires SupportsArrayBinding. The
+	// array is bound in its text form ({1,2,3}), so drivers need no array
+	// support of their own.
+	InArray
+
+	// InValues binds the elements in a VALUES derived table:
+	// id IN (SELECT v FROM (VALUES (?), (?)) AS t (v)). It requires
+	// SupportsValuesList.
+	InValues
+)
+
+// String returns the name of the strategy.
+func (s InStrategy) String() string {
+	switch s {
+	case InExpand:
+		return "expand"
+	case InArray:
+		return "array"
+	case InValues:
+		return "values"
+	default:
+		return "unknown"
+	}
+}
+
+// Chunk splits rows into batches small enough for each statement to stay
+// within d's placeholder limit, given the number of parameters bound per
+// row. Without a limit, rows are returned as a single batch. It is meant
+// for multi-row INSERT statements, built once per batch.
+//
+// Example:
+//
+//	for _, batch := range builder.Chunk(db2.New(), 3, rows) {
+//	    // INSERT INTO t (a, b, c) VALUES (?, ?, ?), ... — at most 10922 rows
+//	}
+func Chunk[T any](d dialect.SQLDialect, perRow int, rows []T) [][]T {
+	if len(rows) == 0 {
+		return nil
+	}
+	size := dialect.RowsPerStatement(d, perRow)
+	if size <= 0 || size >= len(rows) {
+		return [][]T{rows}
+	}
+	out := make([][]T, 0, (len(rows)+size-1)/size)
+	for start := 0; start < len(rows); start += size {
+		end := min(start+size, len(rows))
+		out = append(out, rows[start:end:end])
+	}
+	return out
+}
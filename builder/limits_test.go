@@ -0,0 +1,48 @@
+// File: db/builder/limits_test.go
+
+package builder_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/entiqon/db/builder"
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/generic"
+)
+
+func TestLimits(t *testing.T) {
+	t.Run("InStrategy", func(t *testing.T) {
+		for s, want := range map[builder.InStrategy]string{
+			builder.InExpand: "expand",
+			builder.InArray:  "array",
+			builder.InValues: "values",
+			9:                "unknown",
+		} {
+			if s.String() != want {
+				t.Errorf("expected %q, got %q", want, s.String())
+			}
+		}
+	})
+
+	t.Run("Chunk", func(t *testing.T) {
+		capped := generic.NewWithOptions(dialect.Options{Name: "capped", MaxPlaceholderIndex: 6})
+		rows := []int{1, 2, 3, 4, 5, 6, 7}
+
+		if got := fmt.Sprint(builder.Chunk(capped, 2, rows)); got != "[[1 2 3] [4 5 6] [7]]" {
+			t.Errorf("unexpected batches %s", got)
+		}
+		if got := fmt.Sprint(builder.Chunk(generic.New(), 2, rows)); got != "[[1 2 3 4 5 6 7]]" {
+			t.Errorf("expected a single batch without a limit, got %s", got)
+		}
+		if got := builder.Chunk(capped, 2, []int{}); got != nil {
+			t.Errorf("expected no batch for no rows, got %v", got)
+		}
+
+		batches := builder.Chunk(capped, 2, rows)
+		batches[0] = append(batches[0], 99)
+		if rows[3] != 4 {
+			t.Error("expected appending to a batch not to overwrite the next one")
+		}
+	})
+}
@@ -0,0 +1,47 @@
+package selects
+
+import "github.com/entiqon/db/contract"
+
+// ClauseKind names the clause a Clause node belongs to.
+type ClauseKind string
+
+const (
+	// GroupBy marks a GROUP BY expression.
+	GroupBy ClauseKind = "GROUP BY"
+	// Having marks a HAVING condition.
+	Having ClauseKind = "HAVING"
+	// Qualify marks the QUALIFY condition.
+	Qualify ClauseKind = "QUALIFY"
+	// OrderBy marks an ORDER BY expression, with its direction.
+	OrderBy ClauseKind = "ORDER BY"
+)
+
+// Clause is a GROUP BY, HAVING, QUALIFY or ORDER BY item of a
+// SelectBuilder, exposed by Children as a leaf node. These items are kept
+// as SQL text: SQL holds the expression as given to GroupBy, Having,
+// Qualify or OrderBy, and HAVING conditions after the first start with
+// their AND or OR connector.
+//
+// Example:
+//
+//	token.Walk(sb, func(n contract.Node) bool {
+//	    if c, ok := n.(selects.Clause); ok && c.Kind == selects.OrderBy {
+//	        fmt.Println(c.SQL) // created_at DESC
+//	    }
+//	    return true
+//	})
+type Clause struct {
+	Kind ClauseKind
+	SQL  string
+}
+
+// Children returns nil: a Clause is a leaf.
+func (c Clause) Children() []contract.Node {
+	return nil
+}
+
+// String returns the clause keyword followed by its SQL, for example
+// "ORDER BY created_at DESC".
+func (c Clause) String() string {
+	return string(c.Kind) + " " + c.SQL
+}
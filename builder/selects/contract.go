@@ -1,7 +1,9 @@
 package selects
 
 import (
+	"github.com/entiqon/db/builder"
 	"github.com/entiqon/db/contract"
+	"github.com/entiqon/db/dialect"
 	"github.com/entiqon/db/token/condition"
 	"github.com/entiqon/db/token/field"
 	"github.com/entiqon/db/token/join"
@@ -15,20 +17,32 @@ import (
 // Each mutator returns the builder for chaining; accessors return the current state.
 //
 // Methods:
-//   - Fields / AppendFields / GetFields: define and retrieve the SELECT list
+//   - Fields / AppendFields / GetFields / RemoveField / ReplaceField: define and retrieve the SELECT list
 //   - From / Table: set or get the source table
-//   - InnerJoin / LeftJoin / RightJoin / FullJoin / CrossJoin / NaturalJoin / Joins: manage JOIN clauses
-//   - Where / AndWhere / OrWhere / Conditions: manage WHERE conditions
+//   - InnerJoin / LeftJoin / RightJoin / FullJoin / CrossJoin / NaturalJoin / Joins / RemoveJoin / ClearJoins: manage JOIN clauses
+//   - Where / AndWhere / OrWhere / Conditions / RemoveWhere: manage WHERE conditions
 //   - GroupBy / ThenGroupBy / Groupings: manage GROUP BY expressions
-//   - OrderBy / ThenOrderBy / Sorting: manage ORDER BY expressions
+//   - OrderBy / ThenOrderBy / Sorting / RemoveOrderBy: manage ORDER BY expressions
 //   - Having / AndHaving / OrHaving / HavingConditions: manage HAVING conditions
-//   - Take / Limit / Skip / Offset / Pagination: manage LIMIT and OFFSET
-//   - Build: construct the final SQL string
+//   - Qualify / Sample / LimitBy: add QUALIFY, table sampling and per-group limits
+//   - Take / Limit / Skip / Offset / Pagination / ClearPagination: manage LIMIT and OFFSET
+//   - InLists: choose how long IN lists are rendered
+//   - Tag / Tags: attach sqlcommenter metadata
+//   - Fingerprint: hash the query shape for metrics
+//   - Clone / Immutable: copy the builder, or share it with copy-on-write mutators
+//   - Children / WithChildren: expose the tokens as a tree for token.Walk and token.Rewrite
+//   - Build / BuildFor / RenderFor: construct the final SQL string, optionally for another dialect
+//   - BuildInlined: construct the SQL string with values inlined as literals
 //   - Debug / String: return diagnostic or human-readable views
 type SelectBuilder interface {
 	contract.Debuggable
 	contract.Stringable
 
+	// Parent exposes the fields, table, joins, conditions and the GROUP
+	// BY, HAVING, QUALIFY and ORDER BY items (as Clause) as the children
+	// of the builder, in that order.
+	contract.Parent
+
 	// Fields sets the SELECT list, replacing existing fields.
 	//
 	// Notes:
@@ -46,6 +60,14 @@ type SelectBuilder interface {
 	//   • Returns nil if no fields are defined.
 	GetFields() []field.Token
 
+	// RemoveField removes the fields aliased name, or unaliased with the
+	// expression name.
+	RemoveField(name string) SelectBuilder
+
+	// ReplaceField replaces the first field named name with the field
+	// built from args, keeping its position.
+	ReplaceField(name string, args ...any) SelectBuilder
+
 	// From sets the source table.
 	//
 	// Notes:
@@ -84,6 +106,12 @@ type SelectBuilder interface {
 	//   • Returns nil if no joins are defined.
 	Joins() []join.Token
 
+	// RemoveJoin removes the joins of the table with the alias or name name.
+	RemoveJoin(name string) SelectBuilder
+
+	// ClearJoins removes every join.
+	ClearJoins() SelectBuilder
+
 	// Where sets the WHERE conditions, replacing existing ones.
 	//
 	// Notes:
@@ -104,6 +132,10 @@ type SelectBuilder interface {
 	//   • Returns nil if none defined.
 	Conditions() []condition.Token
 
+	// RemoveWhere removes the WHERE conditions matching remove. The new
+	// first condition loses its AND / OR.
+	RemoveWhere(remove func(condition.Token) bool) SelectBuilder
+
 	// GroupBy replaces the GROUP BY clause.
 	//
 	// Notes:
@@ -130,6 +162,9 @@ type SelectBuilder interface {
 	// Sorting returns all ORDER BY expressions.
 	Sorting() []string
 
+	// RemoveOrderBy removes the ORDER BY items sorting by key.
+	RemoveOrderBy(key string) SelectBuilder
+
 	// Having sets the HAVING clause, replacing existing conditions.
 	//
 	// Notes:
@@ -146,6 +181,27 @@ type SelectBuilder interface {
 	// HavingConditions returns all HAVING conditions.
 	HavingConditions() []string
 
+	// Qualify sets the QUALIFY condition, filtering rows on window
+	// function results. An empty condition removes it.
+	//
+	// Notes:
+	//   • Rendered by dialects implementing dialect.Qualifier; others fail.
+	Qualify(condition string) SelectBuilder
+
+	// Sample reads a random sample of percent (0 to 100) of the FROM
+	// table rows. Zero removes it.
+	//
+	// Notes:
+	//   • Rendered by dialects implementing dialect.Sampler; others fail.
+	Sample(percent float64) SelectBuilder
+
+	// LimitBy keeps the first limit rows, after offset, of every distinct
+	// combination of columns. A non-positive limit removes it.
+	//
+	// Notes:
+	//   • Rendered by dialects implementing dialect.GroupLimiter; others fail.
+	LimitBy(limit, offset int, columns ...string) SelectBuilder
+
 	// Take sets LIMIT.
 	//
 	// Notes:
@@ -170,6 +226,48 @@ type SelectBuilder interface {
 	// Pagination returns LIMIT and OFFSET values.
 	Pagination() (int, int)
 
+	// ClearPagination removes LIMIT and OFFSET.
+	ClearPagination() SelectBuilder
+
+	// InLists sets how IN / NOT IN lists longer than threshold are rendered:
+	// expanded, bound as one array, or inlined in a VALUES derived table.
+	InLists(strategy builder.InStrategy, threshold int) SelectBuilder
+
+	// Tag attaches key/value metadata, rendered as a trailing sqlcommenter
+	// comment (/*key='value'*/) so the statement can be traced back to its
+	// origin. Tagging a key again replaces its value.
+	Tag(key, value string) SelectBuilder
+
+	// Tags returns a copy of the metadata attached with Tag.
+	Tags() map[string]string
+
+	// Fingerprint returns a hash of the query shape, the same whatever
+	// the values, IN list lengths, tags and dialect. It implements
+	// builder.Fingerprinter.
+	Fingerprint() string
+
+	// Clone returns an independent, mutable deep copy of the builder.
+	Clone() SelectBuilder
+
+	// Immutable returns a copy whose mutators return modified copies and
+	// leave it unchanged, so it can be shared across goroutines.
+	Immutable() SelectBuilder
+
+	// Dialect returns the dialect given to New, or nil.
+	Dialect() dialect.SQLDialect
+
+	// BuildFor constructs the SQL string for dialect d, emulating
+	// features it lacks. A nil d renders the dialect-neutral form.
+	BuildFor(d dialect.SQLDialect) (string, []any, error)
+
+	// BuildInlined constructs the SQL string with bound values inlined as
+	// dialect-escaped literals. For logging and EXPLAIN only.
+	BuildInlined() (string, error)
+
+	// RenderFor renders the query for d and reports emulated and
+	// failed features. It implements builder.Renderer.
+	RenderFor(d dialect.SQLDialect) builder.Result
+
 	// Build constructs the final SQL string.
 	//
 	// Returns:
@@ -179,4 +277,7 @@ type SelectBuilder interface {
 	Build() (string, []interface{}, error)
 }
 
-var _ SelectBuilder = (*selectBuilder)(nil)
+var (
+	_ SelectBuilder    = (*selectBuilder)(nil)
+	_ builder.Renderer = (*selectBuilder)(nil)
+)
@@ -12,6 +12,9 @@
 //   - Filtering (HAVING)
 //   - Sorting (ORDER BY)
 //   - Pagination (LIMIT and OFFSET)
+//   - Warehouse clauses (QUALIFY, SAMPLE, ClickHouse LIMIT ... BY),
+//     rendered by dialects implementing dialect.Qualifier,
+//     dialect.Sampler and dialect.GroupLimiter
 //
 // # Example
 //
@@ -33,5 +36,24 @@
 //   - Mutators return the builder for chaining.
 //   - Accessors expose the current state (fields, joins, etc.).
 //   - Invalid tokens are carried forward and surfaced at Build.
-//   - Passing nil as dialect defaults to BaseDialect.
+//   - Passing nil as dialect renders dialect-neutral SQL (:name placeholders).
+//   - BuildFor renders the same builder for another dialect, emulating
+//     ILIKE and NULLS FIRST/LAST where the dialect lacks them.
+//   - Dialect returns the dialect given to New, so executors can render
+//     derived statements for it.
+//   - Tag attaches key/value metadata, rendered as a trailing sqlcommenter
+//     comment so DBAs can trace statements back to their origin.
+//   - Fingerprint hashes the query shape from the kinds and names of its
+//     tokens, with raw fragments (ON, GROUP BY, HAVING, ORDER BY) passed
+//     through builder.Normalize, leaving out values, IN list lengths, tags
+//     and the dialect, to key metrics.
+//   - RemoveField, ReplaceField, RemoveJoin, ClearJoins, RemoveWhere,
+//     RemoveOrderBy and ClearPagination edit an existing query.
+//   - Builders mutate themselves. Clone returns a deep copy, and
+//     Immutable a copy-on-write builder that can be shared across
+//     goroutines, each mutator returning a modified copy.
+//   - Children and WithChildren expose the fields, table, joins,
+//     conditions and GROUP BY, HAVING, QUALIFY and ORDER BY items
+//     (Clause) as a tree, walked and rewritten by token.Walk and
+//     token.Rewrite.
 package selects
@@ -5,7 +5,11 @@ package selects_test
 import (
 	"fmt"
 
+	"github.com/entiqon/db/builder"
 	"github.com/entiqon/db/builder/selects"
+	"github.com/entiqon/db/dialect/generic"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/token/condition"
 	"github.com/entiqon/db/token/types/operator"
 )
 
@@ -249,3 +253,85 @@ func ExampleSelectBuilder_pagination() {
 	fmt.Println(sql)
 	// Output: SELECT * FROM users LIMIT 10 OFFSET 20
 }
+
+func ExampleSelectBuilder_buildFor() {
+	sb := selects.New(nil).
+		From("users").
+		Where("age", operator.GreaterThan, 18).
+		Take(10)
+
+	sql, args, _ := sb.BuildFor(oracle.New())
+	fmt.Println(sql, args)
+	// Output: SELECT * FROM users WHERE age > :1 FETCH FIRST 10 ROWS ONLY [18]
+}
+
+func ExampleSelectBuilder_buildInlined() {
+	sb := selects.New(nil).
+		From("users").
+		Where("name", operator.Equal, "O'Reilly")
+
+	sql, _ := sb.BuildInlined()
+	fmt.Println(sql)
+	// Output: SELECT * FROM users WHERE name = 'O''Reilly'
+}
+
+func ExampleSelectBuilder_inLists() {
+	sb := selects.New(generic.New()).
+		From("users").
+		Where("id", operator.In, []int{1, 2, 3}).
+		InLists(builder.InValues, 2)
+
+	sql, args, _ := sb.Build()
+	fmt.Println(sql, args)
+	// Output: SELECT * FROM users WHERE id IN (SELECT v FROM (VALUES (?), (?), (?)) AS t (v)) [1 2 3]
+}
+
+func ExampleSelectBuilder_tag() {
+	sb := selects.New(oracle.New()).
+		From("orders").
+		Where("id", operator.Equal, 7).
+		Tag("app", "billing")
+
+	sql, _, _ := sb.Build()
+	fmt.Println(sql)
+	// Output: SELECT * FROM orders WHERE id = :1 /*app='billing'*/
+}
+
+func ExampleSelectBuilder_fingerprint() {
+	a := selects.New(nil).From("users").Where("id", operator.In, []int{1, 2, 3})
+	b := selects.New(oracle.New()).From("users").Where("id", operator.In, []int{4, 5})
+	c := selects.New(nil).From("users").Where("id", operator.NotIn, []int{1, 2, 3})
+
+	fmt.Println(a.Fingerprint() == b.Fingerprint(), a.Fingerprint() == c.Fingerprint())
+	// Output: true false
+}
+
+func ExampleSelectBuilder_immutable() {
+	active := selects.New(nil).From("users").Where("active", operator.Equal, true).Immutable()
+	admins := active.AndWhere("role", operator.Equal, "admin")
+
+	sql, _, _ := active.Build()
+	fmt.Println(sql)
+	sql, _, _ = admins.Build()
+	fmt.Println(sql)
+	// Output:
+	// SELECT * FROM users WHERE active = :active
+	// SELECT * FROM users WHERE active = :active AND role = :role
+}
+
+func ExampleSelectBuilder_removeWhere() {
+	sb := selects.New(nil).
+		Fields("id, name, email").
+		From("users").
+		Where("status", operator.Equal, "active").
+		AndWhere("age", operator.GreaterThan, 18).
+		Take(10)
+
+	sb.RemoveField("email").
+		RemoveWhere(func(c condition.Token) bool { return c.Field() == "status" }).
+		ClearPagination()
+
+	sql, args, _ := sb.Build()
+	fmt.Println(sql, args)
+	// Output: SELECT id, name FROM users WHERE age > :age [18]
+}
@@ -4,10 +4,16 @@ package selects
 
 import (
 	"fmt"
+	"hash/fnv"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/entiqon/common/extension/collection"
+	"github.com/entiqon/db/builder"
+	"github.com/entiqon/db/contract"
 	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/generic"
 	"github.com/entiqon/db/token/condition"
 	"github.com/entiqon/db/token/field"
 	"github.com/entiqon/db/token/join"
@@ -26,8 +32,15 @@ type selectBuilder struct {
 	groupings  *collection.Collection[string]
 	sorting    *collection.Collection[string]
 	having     *collection.Collection[string]
+	qualify    string
+	sample     float64
+	limitBy    limitBy
 	take       int
 	skip       int
+	inLists    inLists
+	tags       map[string]string
+	immutable  bool
+	err        error
 }
 
 // New creates a new SelectBuilder with the provided dialect.
@@ -39,6 +52,81 @@ func New(d dialect.SQLDialect) SelectBuilder {
 	return &selectBuilder{dialect: d}
 }
 
+// Clone returns an independent, mutable copy of the builder: fields, table,
+// joins, conditions, groupings, sorting, HAVING and tags are deep-copied,
+// so changing one builder never affects the other. Bound values are
+// shared, not copied.
+//
+// Example:
+//
+//	active := selects.New(nil).From("users").Where("active", operator.Equal, true)
+//	admins := active.Clone().AndWhere("role", operator.Equal, "admin")
+//	// active is still SELECT * FROM users WHERE active = :active
+func (b *selectBuilder) Clone() SelectBuilder {
+	return b.clone()
+}
+
+// Immutable returns a copy of the builder in immutable mode: every
+// mutator (Fields, From, Where, OrderBy, Take, Tag, ...) leaves the
+// builder unchanged and returns a modified copy, itself immutable. An
+// immutable builder can be shared across goroutines, for instance as the
+// base query of request handlers. Clone returns a mutable copy of it.
+//
+// Example:
+//
+//	var activeUsers = selects.New(nil).From("users").Where("active", operator.Equal, true).Immutable()
+//
+//	func handler(w http.ResponseWriter, r *http.Request) {
+//	    q := activeUsers.AndWhere("team", operator.Equal, r.FormValue("team"))
+//	    // activeUsers is unchanged
+//	}
+func (b *selectBuilder) Immutable() SelectBuilder {
+	cp := b.clone()
+	cp.immutable = true
+	return cp
+}
+
+// clone returns a mutable deep copy of b.
+func (b *selectBuilder) clone() *selectBuilder {
+	cp := *b
+	cp.immutable = false
+	if b.fields != nil {
+		cp.fields = collection.Map(b.fields, field.Token.Clone)
+	}
+	if b.table != nil {
+		cp.table = b.table.Clone()
+	}
+	if b.joins != nil {
+		cp.joins = collection.Map(b.joins, join.Token.Clone)
+	}
+	if b.conditions != nil {
+		cp.conditions = collection.Map(b.conditions, condition.Token.Clone)
+	}
+	if b.groupings != nil {
+		cp.groupings = b.groupings.Clone()
+	}
+	if b.sorting != nil {
+		cp.sorting = b.sorting.Clone()
+	}
+	if b.having != nil {
+		cp.having = b.having.Clone()
+	}
+	cp.limitBy.columns = slices.Clone(b.limitBy.columns)
+	cp.tags = maps.Clone(b.tags)
+	return &cp
+}
+
+// mutable returns the builder mutators change: b itself, or an immutable
+// copy of it when b is immutable.
+func (b *selectBuilder) mutable() *selectBuilder {
+	if !b.immutable {
+		return b
+	}
+	cp := b.clone()
+	cp.immutable = true
+	return cp
+}
+
 // Fields sets the SELECT list, replacing existing fields.
 //
 // Usage:
@@ -49,6 +137,7 @@ func New(d dialect.SQLDialect) SelectBuilder {
 //   - Accepts strings, field.Token, or *field.Token.
 //   - Comma-separated strings are split into multiple fields.
 //   - Aliases are parsed with "AS" or space.
+//   - With a dialect, aliases reserved by it are quoted rather than rejected.
 func (b *selectBuilder) Fields(fields ...any) SelectBuilder {
 	return b.appendFields(true, fields...)
 }
@@ -75,6 +164,53 @@ func (b *selectBuilder) GetFields() []field.Token {
 	return b.fields.Items()
 }
 
+// RemoveField removes the fields named name: those aliased name, and the
+// unaliased ones whose expression is name. Names are compared under the
+// dialect's case folding rule.
+//
+// Example:
+//
+//	sb := selects.New(nil).Fields("id, name AS username, email").From("users")
+//	sb.RemoveField("username").RemoveField("email")
+//	// SELECT id FROM users
+func (b *selectBuilder) RemoveField(name string) SelectBuilder {
+	b = b.mutable()
+	if b.fields != nil {
+		folding := dialect.FoldingOf(b.dialect)
+		b.fields = collection.Filter(b.fields, func(f field.Token) bool {
+			return !fieldNamed(folding, f, name)
+		})
+	}
+	return b
+}
+
+// ReplaceField replaces the first field named name, as matched by
+// RemoveField, with the field built from args, keeping its position. args
+// follow the rules of AppendFields for a single field. Without a match,
+// the builder is unchanged.
+//
+// Example:
+//
+//	sb := selects.New(nil).Fields("id, created_at").From("users")
+//	sb.ReplaceField("created_at", "DATE(created_at)", "created_on")
+//	// SELECT id, DATE(created_at) AS created_on FROM users
+func (b *selectBuilder) ReplaceField(name string, args ...any) SelectBuilder {
+	b = b.mutable()
+	if b.fields == nil {
+		return b
+	}
+	folding := dialect.FoldingOf(b.dialect)
+	for i, f := range b.fields.Items() {
+		if fieldNamed(folding, f, name) {
+			if nf := b.newField(args...); nf != nil {
+				b.fields.RemoveAt(i).InsertAt(i, nf)
+			}
+			break
+		}
+	}
+	return b
+}
+
 // From sets the source table for the query.
 //
 // Usage:
@@ -85,7 +221,10 @@ func (b *selectBuilder) GetFields() []field.Token {
 // Notes:
 //   - Accepts strings or table.Token.
 //   - Returns an errored token if invalid.
+//   - Strings are validated against the builder dialect, if any; aliases
+//     reserved by it are quoted rather than rejected.
 func (b *selectBuilder) From(args ...any) SelectBuilder {
+	b = b.mutable()
 	if len(args) == 1 {
 		switch v := args[0].(type) {
 		case table.Token:
@@ -98,7 +237,7 @@ func (b *selectBuilder) From(args ...any) SelectBuilder {
 			}
 		}
 	}
-	b.table = table.New(args...)
+	b.table = table.NewWithDialect(b.dialect, args...)
 	return b
 }
 
@@ -231,6 +370,38 @@ func (b *selectBuilder) Joins() []join.Token {
 	return b.joins.Items()
 }
 
+// RemoveJoin removes the joins of the table named name, matched on the
+// alias or the name of the joined table under the dialect's case folding
+// rule.
+//
+// Example:
+//
+//	sb := selects.New(nil).From("users u").
+//	    LeftJoin("users u", "orders o", "o.user_id = u.id").
+//	    LeftJoin("users u", "teams t", "t.id = u.team_id")
+//	sb.RemoveJoin("o")
+//	// SELECT * FROM users AS u LEFT JOIN teams AS t ON t.id = u.team_id
+func (b *selectBuilder) RemoveJoin(name string) SelectBuilder {
+	b = b.mutable()
+	if b.joins != nil {
+		folding := dialect.FoldingOf(b.dialect)
+		b.joins = collection.Filter(b.joins, func(j join.Token) bool {
+			t := j.Right()
+			return t == nil || !(folding.Equal(t.Name(), name) || t.IsAliased() && folding.Equal(t.Alias(), name))
+		})
+	}
+	return b
+}
+
+// ClearJoins removes every join.
+func (b *selectBuilder) ClearJoins() SelectBuilder {
+	b = b.mutable()
+	if b.joins != nil {
+		b.joins.Clear()
+	}
+	return b
+}
+
 // Where adds one or more conditions to the builder.
 //
 // Behavior:
@@ -341,6 +512,33 @@ func (b *selectBuilder) Conditions() []condition.Token {
 	return b.conditions.Items()
 }
 
+// RemoveWhere removes the WHERE conditions for which remove returns true.
+// When the first condition is removed, the next one becomes the first and
+// loses its AND / OR.
+//
+// Example:
+//
+//	sb := selects.New(nil).From("users").
+//	    Where("status", operator.Equal, "active").
+//	    AndWhere("age", operator.GreaterThan, 18)
+//	sb.RemoveWhere(func(c condition.Token) bool { return c.Field() == "status" })
+//	// SELECT * FROM users WHERE age > :age
+func (b *selectBuilder) RemoveWhere(remove func(condition.Token) bool) SelectBuilder {
+	b = b.mutable()
+	if b.conditions == nil {
+		return b
+	}
+	b.conditions = collection.Filter(b.conditions, func(c condition.Token) bool {
+		return !remove(c)
+	})
+	if first, ok := b.conditions.At(0); ok && first.Kind() != ct.Single {
+		first = first.Clone()
+		first.SetKind(ct.Single)
+		b.conditions.RemoveAt(0).InsertAt(0, first)
+	}
+	return b
+}
+
 // GroupBy replaces any existing GROUP BY expressions with the given fields.
 //
 // Each argument is treated as a raw SQL expression and added in order to
@@ -473,6 +671,28 @@ func (b *selectBuilder) Sorting() []string {
 	return b.sorting.Items()
 }
 
+// RemoveOrderBy removes the ORDER BY items sorting by key, whatever their
+// direction and NULLS placement.
+//
+// Example:
+//
+//	sb := selects.New(nil).From("users").OrderBy("name ASC", "created_at DESC")
+//	sb.RemoveOrderBy("created_at")
+//	// SELECT * FROM users ORDER BY name ASC
+func (b *selectBuilder) RemoveOrderBy(key string) SelectBuilder {
+	b = b.mutable()
+	if b.sorting != nil {
+		folding := dialect.FoldingOf(b.dialect)
+		b.sorting = collection.Filter(b.sorting, func(item string) bool {
+			if m := nullsOrdering.FindStringSubmatch(item); m != nil {
+				item = m[1]
+			}
+			return !folding.Equal(sortDirection.ReplaceAllString(item, ""), key)
+		})
+	}
+	return b
+}
+
 // Having sets the HAVING clause, replacing any existing conditions.
 func (b *selectBuilder) Having(conditions ...string) SelectBuilder {
 	return b.appendHaving("", true, conditions...)
@@ -520,6 +740,56 @@ func (b *selectBuilder) HavingConditions() []string {
 	return b.having.Items()
 }
 
+// Qualify sets the QUALIFY clause, which filters rows on the result of
+// window functions after HAVING. An empty condition removes it. Only
+// dialects implementing dialect.Qualifier render it; others fail.
+//
+// Example:
+//
+//	sb.Fields("id, ROW_NUMBER() OVER (PARTITION BY team ORDER BY score DESC) AS rn").
+//	    From("players").
+//	    Qualify("rn = 1")
+//	// SELECT ... FROM players QUALIFY rn = 1   — Snowflake, BigQuery, Redshift, ClickHouse
+func (b *selectBuilder) Qualify(condition string) SelectBuilder {
+	b = b.mutable()
+	b.qualify = strings.TrimSpace(condition)
+	return b
+}
+
+// Sample reads a random sample of percent (0 to 100) of the rows of the
+// FROM table, rendered after it by dialects implementing dialect.Sampler;
+// others fail. Zero removes it.
+//
+// Example:
+//
+//	sb.From("events").Sample(10)
+//	// Snowflake:  SELECT * FROM events SAMPLE (10)
+//	// BigQuery:   SELECT * FROM events TABLESAMPLE SYSTEM (10 PERCENT)
+//	// ClickHouse: SELECT * FROM events SAMPLE 0.1
+func (b *selectBuilder) Sample(percent float64) SelectBuilder {
+	b = b.mutable()
+	b.sample = percent
+	return b
+}
+
+// LimitBy keeps the first limit rows, after skipping offset, of every
+// distinct combination of columns, rendered after ORDER BY by dialects
+// implementing dialect.GroupLimiter (ClickHouse); others fail. A
+// non-positive limit or no columns removes it.
+//
+// Example:
+//
+//	sb.From("hits").OrderBy("views DESC").LimitBy(3, 0, "domain")
+//	// SELECT * FROM hits ORDER BY views DESC LIMIT 3 BY domain
+func (b *selectBuilder) LimitBy(limit, offset int, columns ...string) SelectBuilder {
+	b = b.mutable()
+	b.limitBy = limitBy{}
+	if limit > 0 && len(columns) > 0 {
+		b.limitBy = limitBy{limit: limit, offset: offset, columns: slices.Clone(columns)}
+	}
+	return b
+}
+
 // Take sets LIMIT.
 //
 // Usage:
@@ -530,6 +800,7 @@ func (b *selectBuilder) HavingConditions() []string {
 //   - Negative values are invalid.
 //   - Equivalent to SQL LIMIT.
 func (b *selectBuilder) Take(value int) SelectBuilder {
+	b = b.mutable()
 	b.take = value
 	return b
 }
@@ -548,6 +819,7 @@ func (b *selectBuilder) Limit() int {
 //   - Negative values are invalid.
 //   - Equivalent to SQL OFFSET.
 func (b *selectBuilder) Skip(value int) SelectBuilder {
+	b = b.mutable()
 	b.skip = value
 	return b
 }
@@ -560,6 +832,308 @@ func (b *selectBuilder) Pagination() (int, int) {
 	return b.take, b.skip
 }
 
+// ClearPagination removes LIMIT and OFFSET.
+func (b *selectBuilder) ClearPagination() SelectBuilder {
+	b = b.mutable()
+	b.take, b.skip = 0, 0
+	return b
+}
+
+// InLists sets how IN / NOT IN lists with more than threshold elements are
+// rendered; shorter lists keep one placeholder per element. A threshold of
+// zero applies strategy to every list. The rule is applied when rendering
+// for a dialect, and fails for dialects lacking the required support.
+//
+// Example:
+//
+//	sb.Where("id", operator.In, ids).InLists(builder.InArray, 100)
+//	// WHERE id = ANY($1)   — on dialects with SupportsArrayBinding
+//
+//	sb.Where("id", operator.In, ids).InLists(builder.InValues, 100)
+//	// WHERE id IN (SELECT v FROM (VALUES (?), (?), ...) AS t (v))
+func (b *selectBuilder) InLists(strategy builder.InStrategy, threshold int) SelectBuilder {
+	b = b.mutable()
+	b.inLists = inLists{strategy: strategy, threshold: max(threshold, 0)}
+	return b
+}
+
+// Tag attaches key/value metadata to the query, rendered as a trailing
+// comment in the sqlcommenter format by dialect.Comment. Keys and values
+// are URL-encoded, so they cannot break out of the comment.
+//
+// Example:
+//
+//	sb := selects.New(nil).From("orders").Tag("route", "/orders").Tag("app", "billing")
+//	// SELECT * FROM orders /*app='billing',route='%2Forders'*/
+func (b *selectBuilder) Tag(key, value string) SelectBuilder {
+	b = b.mutable()
+	if b.tags == nil {
+		b.tags = make(map[string]string)
+	}
+	b.tags[key] = value
+	return b
+}
+
+// Tags returns a copy of the metadata attached with Tag.
+func (b *selectBuilder) Tags() map[string]string {
+	return maps.Clone(b.tags)
+}
+
+// Fingerprint returns a short hash of the query shape, built from its
+// tokens: the kind, expression and alias of each field and of the table,
+// the kind and right table of each join, the kind, field and operator of
+// each condition, and whether it is paginated. Raw fragments (join ON
+// conditions, groupings, HAVING and sorting) go through builder.Normalize.
+// Values, the number of elements of IN lists, tags and the dialect are
+// left out, so the same query run with other values, or for another
+// dialect, shares it. It implements builder.Fingerprinter; the hash has
+// the format of builder.Fingerprint, but is computed from tokens rather
+// than from SQL.
+//
+// Example:
+//
+//	a := selects.New(nil).From("users").Where("id", operator.In, []int{1, 2, 3})
+//	b := selects.New(oracle.New()).From("users").Where("id", operator.In, []int{4, 5})
+//	a.Fingerprint() == b.Fingerprint() // true
+func (b *selectBuilder) Fingerprint() string {
+	shape := []string{"fields"}
+	for _, f := range b.GetFields() {
+		shape = append(shape, fieldShape(f))
+	}
+	shape = append(shape, "from", tableShape(b.table))
+	if b.joins != nil {
+		for _, j := range b.joins.Items() {
+			shape = append(shape, "join", joinShape(j))
+		}
+	}
+	if b.conditions != nil {
+		for _, c := range b.conditions.Items() {
+			shape = append(shape, "where", conditionShape(c))
+		}
+	}
+	if b.groupings != nil && b.groupings.Length() > 0 {
+		shape = append(shape, "group", builder.Normalize(strings.Join(b.groupings.Items(), ", ")))
+	}
+	if b.having != nil {
+		for _, h := range b.having.Items() {
+			shape = append(shape, "having", builder.Normalize(h))
+		}
+	}
+	if b.sorting != nil {
+		for _, s := range b.sorting.Items() {
+			shape = append(shape, "order", builder.Normalize(s))
+		}
+	}
+	if b.qualify != "" {
+		shape = append(shape, "qualify", builder.Normalize(b.qualify))
+	}
+	if b.limitBy.limit > 0 {
+		shape = append(shape, "limit by|"+strings.Join(b.limitBy.columns, ","))
+	}
+	shape = append(shape, fmt.Sprintf("paged|%t|%t|%t", b.take > 0, b.skip > 0, b.sample != 0))
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(strings.Join(shape, "\n")))
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+// Children returns the tokens of the builder as nodes, in rendering
+// order: its fields, its table (when set), its joins, its WHERE
+// conditions, then its GROUP BY, HAVING, QUALIFY and ORDER BY items as
+// Clause leaves. The left table of a join is usually the FROM table itself, so
+// walking a builder may visit it twice.
+func (b *selectBuilder) Children() []contract.Node {
+	var children []contract.Node
+	for _, f := range b.GetFields() {
+		children = append(children, f)
+	}
+	if b.table != nil {
+		children = append(children, b.table)
+	}
+	for _, j := range b.Joins() {
+		children = append(children, j)
+	}
+	for _, c := range b.Conditions() {
+		children = append(children, c)
+	}
+	for _, g := range b.Groupings() {
+		children = append(children, Clause{Kind: GroupBy, SQL: g})
+	}
+	for _, h := range b.HavingConditions() {
+		children = append(children, Clause{Kind: Having, SQL: h})
+	}
+	if b.qualify != "" {
+		children = append(children, Clause{Kind: Qualify, SQL: b.qualify})
+	}
+	for _, s := range b.Sorting() {
+		children = append(children, Clause{Kind: OrderBy, SQL: s})
+	}
+	return children
+}
+
+// WithChildren returns a copy of the builder whose fields, table, joins,
+// conditions and GROUP BY, HAVING, QUALIFY and ORDER BY items are
+// replaced by children, matched one to one with Children(). The builder
+// itself is unchanged, and the copy is immutable when it is. A nil child
+// removes its token or item; a child of the wrong type is kept as an
+// errored token, reported by Build. A Clause only replaces a GROUP BY,
+// HAVING, QUALIFY or ORDER BY item, whatever its Kind, and any other node
+// there is reported by Build, as is a number of children that differs
+// from Children().
+//
+// Example:
+//
+//	children := sb.Children()
+//	children[0] = field.New("id")
+//	sb = sb.WithChildren(children).(selects.SelectBuilder)
+func (b *selectBuilder) WithChildren(children []contract.Node) contract.Node {
+	fields, joins, conditions := b.GetFields(), b.Joins(), b.Conditions()
+	groupings, having, sorting := b.Groupings(), b.HavingConditions(), b.Sorting()
+	nTable, nQualify := 0, 0
+	if b.table != nil {
+		nTable = 1
+	}
+	if b.qualify != "" {
+		nQualify = 1
+	}
+
+	cp := b.clone()
+	cp.immutable = b.immutable
+
+	want := len(fields) + nTable + len(joins) + len(conditions) +
+		len(groupings) + len(having) + nQualify + len(sorting)
+	if len(children) != want {
+		cp.err = fmt.Errorf("WithChildren expects %d children, got %d", want, len(children))
+		return cp
+	}
+
+	if b.fields != nil {
+		cp.fields = collection.New[field.Token]()
+		for _, n := range children[:len(fields)] {
+			switch v := n.(type) {
+			case nil:
+			case field.Token:
+				cp.fields.Add(v)
+			default:
+				cp.fields.Add(field.New(v))
+			}
+		}
+	}
+	children = children[len(fields):]
+
+	if b.table != nil {
+		switch v := children[0].(type) {
+		case nil:
+			cp.table = nil
+		case table.Token:
+			cp.table = v
+		default:
+			cp.table = table.New(v)
+		}
+	}
+	children = children[nTable:]
+
+	if b.joins != nil {
+		cp.joins = collection.New[join.Token]()
+		for i, n := range children[:len(joins)] {
+			switch v := n.(type) {
+			case nil:
+			case join.Token:
+				cp.joins.Add(v)
+			default:
+				cp.joins.Add(joins[i].Clone().SetError(fmt.Errorf("unsupported join type %T", v)))
+			}
+		}
+	}
+	children = children[len(joins):]
+
+	if b.conditions != nil {
+		cp.conditions = collection.New[condition.Token]()
+		for _, n := range children[:len(conditions)] {
+			var c condition.Token
+			switch v := n.(type) {
+			case nil:
+				continue
+			case condition.Token:
+				c = v
+			default:
+				c = condition.New(ct.And, v)
+			}
+			// The first condition is Single, the next ones AND unless
+			// they are OR.
+			if first := cp.conditions.Length() == 0; first != (c.Kind() == ct.Single) {
+				kind := ct.And
+				if first {
+					kind = ct.Single
+				}
+				c = c.Clone()
+				c.SetKind(kind)
+			}
+			cp.conditions.Add(c)
+		}
+	}
+	children = children[len(conditions):]
+
+	var err error
+	if b.groupings != nil {
+		var items []string
+		items, err = clauseItems(GroupBy, children[:len(groupings)])
+		cp.groupings = collection.FromSlice(items)
+	}
+	children = children[len(groupings):]
+	if b.having != nil && err == nil {
+		var items []string
+		items, err = clauseItems(Having, children[:len(having)])
+		// The first HAVING condition has no connector, the next ones
+		// AND unless they already have one.
+		for i, h := range items {
+			bare := havingConnector.ReplaceAllString(h, "")
+			if i == 0 {
+				items[i] = bare
+			} else if h == bare {
+				items[i] = "AND " + h
+			}
+		}
+		cp.having = collection.FromSlice(items)
+	}
+	children = children[len(having):]
+	if b.qualify != "" && err == nil {
+		var items []string
+		items, err = clauseItems(Qualify, children[:1])
+		cp.qualify = strings.Join(items, "")
+	}
+	children = children[nQualify:]
+	if b.sorting != nil && err == nil {
+		var items []string
+		items, err = clauseItems(OrderBy, children)
+		cp.sorting = collection.FromSlice(items)
+	}
+	cp.err = err
+	return cp
+}
+
+// limitBy holds the per-group limit set by LimitBy.
+type limitBy struct {
+	limit, offset int
+	columns       []string
+}
+
+// clauseItems returns the SQL of the Clause nodes given for the kind
+// clause, skipping nil ones; any other node is an error.
+func clauseItems(kind ClauseKind, nodes []contract.Node) ([]string, error) {
+	var items []string
+	for _, n := range nodes {
+		switch v := n.(type) {
+		case nil:
+		case Clause:
+			items = append(items, v.SQL)
+		default:
+			return nil, fmt.Errorf("%s expects a selects.Clause, got %T", kind, v)
+		}
+	}
+	return items, nil
+}
+
 // Debug returns a developer-facing representation of the SelectBuilder.
 //
 // The output is verbose and intended for diagnostics, showing the
@@ -668,19 +1242,101 @@ func (b *selectBuilder) String() string {
 	)
 }
 
-// Build constructs the SQL query string.
+// Dialect returns the dialect given to New, or nil for a dialect-neutral
+// builder.
+func (b *selectBuilder) Dialect() dialect.SQLDialect {
+	return b.dialect
+}
+
+// Build constructs the SQL query string for the dialect given to New.
+//
+// It is equivalent to BuildFor with that dialect. Without a dialect the
+// output is dialect-neutral: named placeholders (:name) and LIMIT/OFFSET.
 func (b *selectBuilder) Build() (string, []any, error) {
+	return b.BuildFor(b.dialect)
+}
+
+// BuildFor constructs the SQL query string for dialect d, regardless of
+// the dialect the builder was created with.
+//
+// Placeholders, pagination and aliases follow d, and features d lacks are
+// emulated where possible (see RenderFor). Features that cannot be
+// emulated make BuildFor fail. A nil d renders the dialect-neutral form.
+//
+// Example:
+//
+//	sb := selects.New(nil).From("users").Where("age", operator.GreaterThan, 18).Take(10)
+//	sql, args, err := sb.BuildFor(oracle.New())
+//	// SELECT * FROM users WHERE age > :1 FETCH FIRST 10 ROWS ONLY  [18]
+func (b *selectBuilder) BuildFor(d dialect.SQLDialect) (string, []any, error) {
+	r := b.RenderFor(d)
+	return r.SQL, r.Args, r.Err
+}
+
+// BuildInlined constructs the SQL query with every bound value replaced by
+// its literal, for logs, EXPLAIN and DDL contexts. Values are rendered by
+// dialect.Interpolate, so they are escaped for the builder's dialect and
+// values without a safe literal form make BuildInlined fail. Without a
+// dialect, the generic dialect is used.
+//
+// ⚠️ The result is for inspection only — execute the output of Build.
+//
+// Example:
+//
+//	sb := selects.New(nil).From("users").Where("name", operator.Equal, "O'Reilly")
+//	sql, err := sb.BuildInlined()
+//	// SELECT * FROM users WHERE name = 'O''Reilly'
+func (b *selectBuilder) BuildInlined() (string, error) {
+	d := b.dialect
+	if d == nil {
+		d = generic.New()
+	}
+	sql, args, err := b.BuildFor(d)
+	if err != nil {
+		return "", err
+	}
+	return dialect.Interpolate(d, sql, args)
+}
+
+// RenderFor renders the query for dialect d and reports the emulated and
+// failed features. It implements builder.Renderer, so a SelectBuilder can
+// be passed to builder.Transpile.
+//
+// Emulations:
+//   - ILIKE / NOT ILIKE → LOWER(field) LIKE / NOT LIKE LOWER(?)
+//   - NULLS FIRST / NULLS LAST → a leading CASE WHEN ... IS NULL sort key
+//
+// Failures:
+//   - window functions (OVER) on dialects without SupportsWindowFunctions
+//   - canonical functions (token/fn) without a translation for d
+//   - InLists strategies the dialect cannot express (array binding,
+//     VALUES lists)
+//   - more bound values than d's MaxPlaceholderIndex, reported as a
+//     *dialect.PlaceholderLimitError
+func (b *selectBuilder) RenderFor(d dialect.SQLDialect) builder.Result {
+	var res builder.Result
+	if d != nil {
+		res.Dialect = d.Name()
+	}
+
+	if b.err != nil {
+		res.Err = fmt.Errorf("[Select] - Children:\n\t%v", b.err)
+		return res
+	}
+
 	if b.table == nil {
-		return "", nil, fmt.Errorf(
+		res.Err = fmt.Errorf(
 			"[Select] – Errors:\n  From:\n    no table specified",
 		)
+		return res
 	}
 
 	if b.table.IsErrored() {
-		return "", nil, fmt.Errorf(
+		res.Err = fmt.Errorf(
 			"[Select] – Errors:\n  From:\n    %v",
 			b.table.Error(),
 		)
+		return res
 	}
 
 	var fields string
@@ -693,10 +1349,24 @@ func (b *selectBuilder) Build() (string, []any, error) {
 					fmt.Sprintf("Field(%q): %v", f.Input(), f.Error()))
 				continue
 			}
-			parts = append(parts, f.Render())
+			if d == nil {
+				parts = append(parts, f.Render())
+				continue
+			}
+			if !d.Options().SupportsWindowFunctions && windowCall.MatchString(f.Expr()) {
+				res.Fail(builder.FeatureWindowFunctions)
+			}
+			if call := f.Func(); call != nil {
+				if _, err := call.RenderFor(d); err != nil {
+					bad = append(bad, fmt.Sprintf("Field(%q): %v", f.Input(), err))
+					continue
+				}
+			}
+			parts = append(parts, f.RenderFor(d))
 		}
 		if len(bad) > 0 {
-			return "", nil, fmt.Errorf("[Select] - Fields:\n\t%s", strings.Join(bad, "\n\t"))
+			res.Err = fmt.Errorf("[Select] - Fields:\n\t%s", strings.Join(bad, "\n\t"))
+			return res
 		}
 
 		fields = strings.Join(parts, ", ")
@@ -704,11 +1374,27 @@ func (b *selectBuilder) Build() (string, []any, error) {
 		fields = field.New("*").Render()
 	}
 
+	source := b.table.Render()
+	if d != nil {
+		source = b.table.RenderFor(d)
+	}
+
+	if b.sample != 0 {
+		if s, ok := d.(dialect.Sampler); !ok {
+			res.Fail(builder.FeatureSample)
+		} else if clause := s.SampleSyntax(b.sample); clause == "" {
+			res.Err = fmt.Errorf("[Select] - Sample:\n\tpercent %v out of range", b.sample)
+			return res
+		} else {
+			source += " " + clause
+		}
+	}
+
 	tokens := []string{
 		"SELECT",
 		fields,
 		"FROM",
-		b.table.Render(),
+		source,
 	}
 
 	sql := strings.Join(tokens, " ")
@@ -721,10 +1407,15 @@ func (b *selectBuilder) Build() (string, []any, error) {
 				bad = append(bad, fmt.Sprintf("Join(%q): %v", j.Left(), j.Error()))
 				continue
 			}
-			parts = append(parts, j.Render())
+			if d != nil {
+				parts = append(parts, j.RenderFor(d))
+			} else {
+				parts = append(parts, j.Render())
+			}
 		}
 		if len(bad) > 0 {
-			return "", nil, fmt.Errorf("[Select] - Join:\n\t%s", strings.Join(bad, "\n\t"))
+			res.Err = fmt.Errorf("[Select] - Join:\n\t%s", strings.Join(bad, "\n\t"))
+			return res
 		}
 
 		sql += " " + strings.Join(parts, " ")
@@ -741,6 +1432,12 @@ func (b *selectBuilder) Build() (string, []any, error) {
 					fmt.Sprintf("Condition(%q): %v", c.Input(), c.Error()))
 				continue
 			}
+			if d != nil {
+				var part string
+				part, values = renderCondition(d, c, values, b.inLists, &res.Report)
+				parts = append(parts, part)
+				continue
+			}
 			parts = append(parts, c.Render())
 			if v := c.Value(); v != nil {
 				values = append(values, v)
@@ -748,10 +1445,11 @@ func (b *selectBuilder) Build() (string, []any, error) {
 		}
 
 		if len(bad) > 0 {
-			return "", nil, fmt.Errorf(
+			res.Err = fmt.Errorf(
 				"[Select] - Where:\n\t%s",
 				strings.Join(bad, "\n\t"),
 			)
+			return res
 		}
 
 		if len(parts) > 0 {
@@ -763,26 +1461,62 @@ func (b *selectBuilder) Build() (string, []any, error) {
 		sql += " GROUP BY " + strings.Join(b.groupings.Items(), ", ")
 	}
 
+	if b.having != nil && b.having.Length() > 0 {
+		sql += " HAVING " + strings.Join(b.having.Items(), " ")
+	}
+
+	if b.qualify != "" {
+		if q, ok := d.(dialect.Qualifier); ok {
+			sql += " " + q.QualifySyntax(b.qualify)
+		} else {
+			res.Fail(builder.FeatureQualify)
+		}
+	}
+
 	if b.sorting != nil && b.sorting.Length() > 0 {
-		sql += " ORDER BY " + strings.Join(b.sorting.Items(), ", ")
+		sql += " ORDER BY " + renderSorting(d, b.sorting.Items(), &res.Report)
 	}
 
-	if b.having != nil && b.having.Length() > 0 {
-		sql += " HAVING " + strings.Join(b.having.Items(), " ")
+	if b.limitBy.limit > 0 {
+		if g, ok := d.(dialect.GroupLimiter); ok {
+			sql += " " + g.LimitBySyntax(b.limitBy.limit, b.limitBy.offset, b.limitBy.columns)
+		} else {
+			res.Fail(builder.FeatureLimitBy)
+		}
+	}
+
+	if d != nil {
+		sql = dialect.Paginate(d, sql, b.take, b.skip)
+	} else {
+		if b.take > 0 {
+			sql += fmt.Sprintf(" LIMIT %d", b.take)
+		}
+		if b.skip > 0 {
+			sql += fmt.Sprintf(" OFFSET %d", b.skip)
+		}
 	}
 
-	if b.take > 0 {
-		sql += fmt.Sprintf(" LIMIT %d", b.take)
+	if res.HasFailures() {
+		res.Err = fmt.Errorf(
+			"[Select] - Dialect(%q):\n\tunsupported: %s",
+			res.Dialect, strings.Join(res.Failed, ", "),
+		)
+		return res
 	}
-	if b.skip > 0 {
-		sql += fmt.Sprintf(" OFFSET %d", b.skip)
+
+	if err := dialect.CheckPlaceholders(d, len(values)); err != nil {
+		res.Err = fmt.Errorf("[Select] - Args:\n\t%w", err)
+		return res
 	}
 
-	return strings.TrimSpace(sql), values, nil
+	res.SQL = dialect.Comment(d, strings.TrimSpace(sql), b.tags)
+	res.Args = values
+	return res
 }
 
 // appendFields is the shared logic for parsing/adding fields.
 func (b *selectBuilder) appendFields(reset bool, fields ...any) SelectBuilder {
+	b = b.mutable()
 	if b.fields == nil {
 		b.fields = collection.New[field.Token]()
 	} else if reset {
@@ -793,39 +1527,47 @@ func (b *selectBuilder) appendFields(reset bool, fields ...any) SelectBuilder {
 		return b
 	}
 
-	switch len(fields) {
-	case 1:
-		switch v := fields[0].(type) {
-		case string:
-			s := strings.TrimSpace(v)
-			if strings.Contains(s, ",") {
-				for _, part := range splitAndTrim(s, ",") {
-					b.fields.Add(field.New(part))
-				}
-			} else {
-				b.fields.Add(field.New(s))
-			}
+	if s, ok := fields[0].(string); ok && len(fields) == 1 && strings.Contains(s, ",") {
+		for _, part := range splitAndTrim(s, ",") {
+			b.fields.Add(field.NewWithDialect(b.dialect, part))
+		}
+		return b
+	}
+	if f := b.newField(fields...); f != nil {
+		b.fields.Add(f)
+	}
+	return b
+}
 
+// newField builds a single field from args:
+//   - field.Token → used as is.
+//   - *field.Token → dereferenced; nil yields no field.
+//   - string → trimmed and parsed by field.NewWithDialect.
+//   - 2 args → expr + alias; more are rejected by field.NewWithDialect.
+func (b *selectBuilder) newField(args ...any) field.Token {
+	if len(args) == 1 {
+		switch v := args[0].(type) {
+		case string:
+			return field.NewWithDialect(b.dialect, strings.TrimSpace(v))
 		case field.Token:
-			b.fields.Add(v)
-
+			return v
 		case *field.Token:
-			if v != nil {
-				b.fields.Add(*v)
+			if v == nil {
+				return nil
 			}
-
-		default:
-			// fallback to generic constructor
-			b.fields.Add(field.New(v))
+			return *v
 		}
-
-	default:
-		// 2 args → expr + alias
-		// >2 args → will be rejected by field.New
-		b.fields.Add(field.New(fields...))
 	}
+	return field.NewWithDialect(b.dialect, args...)
+}
 
-	return b
+// fieldNamed reports whether f is aliased name or, unaliased, has the
+// expression name.
+func fieldNamed(folding dialect.CaseFolding, f field.Token, name string) bool {
+	if f.IsAliased() {
+		return folding.Equal(f.Alias(), name)
+	}
+	return folding.Equal(f.Expr(), name)
 }
 
 // appendJoin is an internal helper that constructs and appends a JOIN clause
@@ -849,11 +1591,12 @@ func (b *selectBuilder) appendJoin(
 	right any,
 	on string,
 ) *selectBuilder {
+	b = b.mutable()
 	if b.joins == nil {
 		b.joins = collection.New[join.Token]()
 	}
 
-	leftTable := resolveBase(left, b.table)
+	leftTable := resolveBase(dialect.FoldingOf(b.dialect), left, b.table)
 
 	if kind == jt.Inner {
 		b.joins.Add(join.NewInner(leftTable, right, on))
@@ -871,22 +1614,26 @@ func (b *selectBuilder) appendJoin(
 	return b
 }
 
-func resolveBase(left any, src table.Token) table.Token {
+// resolveBase returns src when left denotes the FROM table, and left as a
+// table token otherwise. Names are compared under the dialect's case
+// folding rule.
+func resolveBase(folding dialect.CaseFolding, left any, src table.Token) table.Token {
 	switch v := left.(type) {
 	case table.Token:
 		// if semantically equal, reuse src
-		if sameFromTable(v, src) {
+		if sameFromTable(folding, v, src) {
 			return src
 		}
 		return v
 	case *table.Token:
-		if sameFromTable(*v, src) {
+		if sameFromTable(folding, *v, src) {
 			return src
 		}
 		return *v
 	case string:
 		// compare with source string forms
-		if v == src.Input() || v == src.Render() || v == src.Name() || v == src.String() {
+		if v == src.Input() || v == src.Render() || v == src.String() ||
+			folding.Equal(v, src.Name()) || (src.Alias() != "" && folding.Equal(v, src.Alias())) {
 			return src
 		}
 		return table.New(v)
@@ -895,11 +1642,14 @@ func resolveBase(left any, src table.Token) table.Token {
 	}
 }
 
-func sameFromTable(a, b table.Token) bool {
+// sameFromTable reports whether a and b name the same table with the same
+// alias. Unquoted names match case-insensitively when the dialect folds
+// them; quoted names must match exactly.
+func sameFromTable(folding dialect.CaseFolding, a, b table.Token) bool {
 	if a == nil || b == nil {
 		return false
 	}
-	return a.Name() == b.Name() && a.Alias() == b.Alias()
+	return folding.Equal(a.Name(), b.Name()) && folding.Equal(a.Alias(), b.Alias())
 }
 
 // addConditions adds new conditions. If reset=true, clears first.
@@ -917,6 +1667,7 @@ func (b *selectBuilder) addConditions(
 	kind ct.Type,
 	args ...any,
 ) SelectBuilder {
+	b = b.mutable()
 	if b.conditions == nil {
 		b.conditions = collection.New[condition.Token]()
 	} else if reset {
@@ -963,6 +1714,7 @@ func (b *selectBuilder) addConditions(
 
 // appendGroupBy ensures init and handles reset
 func (b *selectBuilder) appendGroupBy(reset bool, fields ...string) SelectBuilder {
+	b = b.mutable()
 	if b.groupings == nil {
 		b.groupings = collection.New[string]()
 	} else if reset {
@@ -980,6 +1732,7 @@ func (b *selectBuilder) appendGroupBy(reset bool, fields ...string) SelectBuilde
 
 // appendOrderBy ensures init and handles reset
 func (b *selectBuilder) appendOrderBy(reset bool, fields ...string) SelectBuilder {
+	b = b.mutable()
 	if b.sorting == nil {
 		b.sorting = collection.New[string]()
 	} else if reset {
@@ -1003,6 +1756,7 @@ func (b *selectBuilder) appendHaving(
 	reset bool,
 	conditions ...string,
 ) *selectBuilder {
+	b = b.mutable()
 	if b.having == nil {
 		b.having = collection.New[string]()
 	} else if reset {
@@ -3,13 +3,31 @@
 package selects_test
 
 import (
+	"database/sql/driver"
+	"errors"
 	"fmt"
+	"reflect"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/entiqon/db/builder"
 	"github.com/entiqon/db/builder/selects"
+	"github.com/entiqon/db/contract"
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/bigquery"
+	"github.com/entiqon/db/dialect/clickhouse"
+	"github.com/entiqon/db/dialect/generic"
+	"github.com/entiqon/db/dialect/mssql"
+	"github.com/entiqon/db/dialect/mysql"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/dialect/postgres"
+	"github.com/entiqon/db/dialect/redshift"
+	"github.com/entiqon/db/dialect/snowflake"
+	"github.com/entiqon/db/dialect/sqlite"
 	"github.com/entiqon/db/token/condition"
 	"github.com/entiqon/db/token/field"
+	"github.com/entiqon/db/token/fn"
 	"github.com/entiqon/db/token/table"
 	ct "github.com/entiqon/db/token/types/condition"
 	"github.com/entiqon/db/token/types/operator"
@@ -22,6 +40,15 @@ func TestSelectBuilder(t *testing.T) {
 			if sb == nil {
 				t.Fatal("expected a Select, got nil")
 			}
+			if sb.Dialect() != nil {
+				t.Errorf("expected nil dialect, got %v", sb.Dialect())
+			}
+		})
+		t.Run("Dialect", func(t *testing.T) {
+			d := oracle.New()
+			if got := selects.New(d).Dialect(); got != d {
+				t.Errorf("expected %v, got %v", d, got)
+			}
 		})
 	})
 
@@ -213,6 +240,21 @@ func TestSelectBuilder(t *testing.T) {
 					}
 				})
 
+				t.Run("Folding", func(t *testing.T) {
+					sb := selects.New(generic.New()).From("users", "u").
+						InnerJoin(table.New("Users", "U"), "accounts a", "a.user_id = u.id")
+					joins := sb.Joins()
+					if len(joins) != 1 || joins[0].Left().Name() != "users" {
+						t.Fatalf("expected folded base to reuse the FROM table, got %v", joins)
+					}
+
+					sb = selects.New(generic.New()).From("users", "u").
+						InnerJoin(table.New(`"Users"`, "u"), "accounts a", "a.user_id = u.id")
+					if joins = sb.Joins(); len(joins) != 1 || joins[0].Left().Name() != `"Users"` {
+						t.Fatalf("expected quoted base to stay distinct, got %v", joins)
+					}
+				})
+
 				t.Run("Table", func(t *testing.T) {
 					tbl := table.New("users", "u")
 					sb := selects.New(nil).From("users", "u").
@@ -865,6 +907,784 @@ func TestSelectBuilder(t *testing.T) {
 					t.Errorf("expected `%s`, got `%s`", want, sql)
 				}
 			})
+
+			t.Run("WithDialect", func(t *testing.T) {
+				_, _, err := selects.New(nil).
+					Fields("id AS order").
+					From("users").
+					Build()
+				if err == nil || !strings.Contains(err.Error(), "invalid alias: order") {
+					t.Errorf("expected reserved alias to be rejected, got %v", err)
+				}
+
+				sql, _, err := selects.New(generic.New()).
+					Fields("id AS order, depth level").
+					From("users", "user").
+					Build()
+				want := `SELECT id AS "order", depth AS level FROM users AS "user"`
+				if err != nil || want != sql {
+					t.Errorf("expected `%s`, got `%s` (%v)", want, sql, err)
+				}
+
+				sql, _, _ = selects.New(oracle.New()).
+					Fields("depth level").
+					From("users").
+					Build()
+				want = `SELECT depth AS "LEVEL" FROM users`
+				if want != sql {
+					t.Errorf("expected `%s`, got `%s`", want, sql)
+				}
+			})
+
+			t.Run("HavingBeforeOrderBy", func(t *testing.T) {
+				sql, _, _ := selects.New(nil).
+					Fields("dept, COUNT(id) AS total").
+					From("users").
+					GroupBy("dept").
+					Having("COUNT(id) > 5").
+					OrderBy("dept").
+					Build()
+				want := "SELECT dept, COUNT(id) AS total FROM users GROUP BY dept HAVING COUNT(id) > 5 ORDER BY dept"
+				if want != sql {
+					t.Errorf("expected `%s`, got `%s`", want, sql)
+				}
+			})
+		})
+
+		t.Run("BuildFor", func(t *testing.T) {
+			sb := selects.New(nil).
+				Fields("id, depth AS level").
+				From("users u").
+				Where("name", operator.ILike, "jo%").
+				AndWhere("id IN (1, 2, 3)").
+				AndWhere("age BETWEEN 18 AND 30").
+				AndWhere("deleted_at IS NULL").
+				OrderBy("name DESC NULLS LAST").
+				Take(10)
+
+			t.Run("Neutral", func(t *testing.T) {
+				neutral, _, _ := sb.BuildFor(nil)
+				built, _, _ := sb.Build()
+				if neutral != built {
+					t.Errorf("expected BuildFor(nil) to match Build, got `%s` and `%s`", neutral, built)
+				}
+			})
+
+			t.Run("Generic", func(t *testing.T) {
+				sql, args, err := sb.BuildFor(generic.New())
+				want := "SELECT id, depth AS level FROM users AS u WHERE LOWER(name) LIKE LOWER(?) AND id IN (?, ?, ?) " +
+					"AND age BETWEEN ? AND ? AND deleted_at IS NULL ORDER BY name DESC NULLS LAST LIMIT 10"
+				if err != nil || want != sql {
+					t.Errorf("expected `%s`, got `%s` (%v)", want, sql, err)
+				}
+				if fmt.Sprint(args) != "[jo% 1 2 3 18 30]" {
+					t.Errorf("unexpected args %v", args)
+				}
+			})
+
+			t.Run("Oracle", func(t *testing.T) {
+				sql, _, err := sb.BuildFor(oracle.New())
+				want := `SELECT id, depth AS "LEVEL" FROM users u WHERE LOWER(name) LIKE LOWER(:1) AND id IN (:2, :3, :4) ` +
+					"AND age BETWEEN :5 AND :6 AND deleted_at IS NULL ORDER BY name DESC NULLS LAST FETCH FIRST 10 ROWS ONLY"
+				if err != nil || want != sql {
+					t.Errorf("expected `%s`, got `%s` (%v)", want, sql, err)
+				}
+			})
+
+			t.Run("OracleJoin", func(t *testing.T) {
+				joined := selects.New(nil).Fields("u.id, o.total").From("users u").
+					LeftJoin("users u", "orders o", "o.user_id = u.id").
+					CrossJoin("regions r")
+				sql, _, err := joined.BuildFor(oracle.New())
+				want := "SELECT u.id, o.total FROM users u LEFT JOIN orders o ON o.user_id = u.id CROSS JOIN regions r"
+				if err != nil || want != sql {
+					t.Errorf("expected `%s`, got `%s` (%v)", want, sql, err)
+				}
+				sql, _, _ = joined.BuildFor(generic.New())
+				want = "SELECT u.id, o.total FROM users AS u LEFT JOIN orders AS o ON o.user_id = u.id CROSS JOIN regions AS r"
+				if want != sql {
+					t.Errorf("expected `%s`, got `%s`", want, sql)
+				}
+			})
+
+			t.Run("Snowflake", func(t *testing.T) {
+				r := sb.RenderFor(snowflake.New())
+				if !strings.Contains(r.SQL, "WHERE name ILIKE ?") || len(r.Emulated) != 0 {
+					t.Errorf("expected native ILIKE, got `%s` (emulated %v)", r.SQL, r.Emulated)
+				}
+			})
+
+			t.Run("Emulated", func(t *testing.T) {
+				d := generic.NewWithOptions(dialect.Options{Name: "legacy", PlaceholderStyle: "?", SupportsWindowFunctions: true})
+				r := sb.RenderFor(d)
+				if !strings.Contains(r.SQL, "ORDER BY CASE WHEN name IS NULL THEN 1 ELSE 0 END, name DESC LIMIT 10") {
+					t.Errorf("expected NULLS LAST emulation, got `%s`", r.SQL)
+				}
+				if fmt.Sprint(r.Emulated) != "[ILIKE NULLS LAST]" || r.Err != nil {
+					t.Errorf("unexpected report %v (%v)", r.Emulated, r.Err)
+				}
+
+				r = selects.New(nil).
+					From("users").
+					Where("name", operator.NotILike, "jo%").
+					OrderBy("name NULLS FIRST").
+					RenderFor(d)
+				want := "SELECT * FROM users WHERE LOWER(name) NOT LIKE LOWER(?) ORDER BY CASE WHEN name IS NULL THEN 0 ELSE 1 END, name"
+				if want != r.SQL {
+					t.Errorf("expected `%s`, got `%s`", want, r.SQL)
+				}
+			})
+
+			t.Run("Failed", func(t *testing.T) {
+				d := generic.NewWithOptions(dialect.Options{Name: "legacy", PlaceholderStyle: "?"})
+				r := selects.New(nil).
+					Fields("ROW_NUMBER() OVER (PARTITION BY dept) AS rn").
+					From("users").
+					RenderFor(d)
+				if r.Err == nil || r.SQL != "" || fmt.Sprint(r.Failed) != "[window functions]" {
+					t.Errorf("expected window functions to fail, got `%s` %v (%v)", r.SQL, r.Failed, r.Err)
+				}
+				if !strings.Contains(r.Err.Error(), `Dialect("legacy")`) {
+					t.Errorf("unexpected error %v", r.Err)
+				}
+			})
+
+			t.Run("Warehouse", func(t *testing.T) {
+				ranked := selects.New(nil).
+					Fields("id, ROW_NUMBER() OVER (PARTITION BY team ORDER BY score DESC) AS rn").
+					From("players").
+					Where("active", operator.Equal, true).
+					Qualify("rn = 1").
+					OrderBy("id")
+				cases := []struct {
+					name string
+					sb   selects.SelectBuilder
+					d    dialect.SQLDialect
+					want string
+				}{
+					{"qualify snowflake", ranked, snowflake.New(),
+						"SELECT id, ROW_NUMBER() OVER (PARTITION BY team ORDER BY score DESC) AS rn FROM players " +
+							"WHERE active = ? QUALIFY rn = 1 ORDER BY id"},
+					{"qualify bigquery", ranked, bigquery.New(),
+						"SELECT id, ROW_NUMBER() OVER (PARTITION BY team ORDER BY score DESC) AS rn FROM players " +
+							"WHERE active = ? QUALIFY rn = 1 ORDER BY id"},
+					{"qualify redshift", ranked, redshift.New(),
+						"SELECT id, ROW_NUMBER() OVER (PARTITION BY team ORDER BY score DESC) AS rn FROM players " +
+							"WHERE active = $1 QUALIFY rn = 1 ORDER BY id"},
+					{"qualify clickhouse", ranked, clickhouse.New(),
+						"SELECT id, ROW_NUMBER() OVER (PARTITION BY team ORDER BY score DESC) AS rn FROM players " +
+							"WHERE active = ? QUALIFY rn = 1 ORDER BY id"},
+					{"sample clickhouse", selects.New(nil).Fields("domain").From("hits h").Sample(10).Take(5), clickhouse.New(),
+						"SELECT domain FROM hits AS h SAMPLE 0.1 LIMIT 5"},
+					{"sample snowflake", selects.New(nil).From("events").Sample(10), snowflake.New(),
+						"SELECT * FROM events SAMPLE (10)"},
+					{"sample bigquery", selects.New(nil).From("events").Sample(10), bigquery.New(),
+						"SELECT * FROM events TABLESAMPLE SYSTEM (10 PERCENT)"},
+					{"limit by clickhouse", selects.New(nil).Fields("domain, url").From("hits").
+						OrderBy("views DESC").LimitBy(3, 1, "domain").Take(100), clickhouse.New(),
+						"SELECT domain, url FROM hits ORDER BY views DESC LIMIT 3 OFFSET 1 BY domain LIMIT 100"},
+				}
+				for _, c := range cases {
+					if sql, _, err := c.sb.BuildFor(c.d); err != nil || sql != c.want {
+						t.Errorf("%s:\n got `%s` (%v)\nwant `%s`", c.name, sql, err, c.want)
+					}
+				}
+
+				for name, r := range map[string]builder.Result{
+					"[QUALIFY]":  ranked.RenderFor(postgres.New()),
+					"[SAMPLE]":   selects.New(nil).From("events").Sample(10).RenderFor(redshift.New()),
+					"[LIMIT BY]": selects.New(nil).From("hits").LimitBy(3, 0, "domain").RenderFor(snowflake.New()),
+				} {
+					if r.Err == nil || fmt.Sprint(r.Failed) != name {
+						t.Errorf("expected %s to fail, got `%s` %v (%v)", name, r.SQL, r.Failed, r.Err)
+					}
+				}
+				if _, _, err := selects.New(nil).From("events").Sample(150).BuildFor(snowflake.New()); err == nil {
+					t.Error("expected an error for a sample over 100 percent")
+				}
+				if sql, _, _ := ranked.Qualify("").BuildFor(postgres.New()); strings.Contains(sql, "QUALIFY") {
+					t.Errorf("expected an empty condition to remove QUALIFY, got `%s`", sql)
+				}
+			})
+
+			t.Run("Errors", func(t *testing.T) {
+				if _, _, err := selects.New(nil).BuildFor(generic.New()); err == nil {
+					t.Error("expected missing table error")
+				}
+				r := selects.New(nil).From("users").Where("").RenderFor(generic.New())
+				if r.Err == nil || r.Dialect != "generic" {
+					t.Errorf("expected where error for generic, got %+v", r)
+				}
+			})
+		})
+
+		t.Run("Functions", func(t *testing.T) {
+			sb := selects.New(nil).
+				Fields(field.New(fn.Substring("name", 1, 3), "short")).
+				AppendFields(fn.Now(), "at").
+				From("users")
+
+			sql, _, err := sb.BuildFor(oracle.New())
+			want := "SELECT SUBSTR(name, 1, 3) AS short, CURRENT_TIMESTAMP AS at FROM users"
+			if err != nil || sql != want {
+				t.Errorf("expected `%s`, got `%s` (%v)", want, sql, err)
+			}
+
+			neutral, _, _ := sb.Build()
+			if neutral != "SELECT SUBSTRING(name FROM 1 FOR 3) AS short, CURRENT_TIMESTAMP AS at FROM users" {
+				t.Errorf("unexpected neutral SQL `%s`", neutral)
+			}
+
+			_, _, err = selects.New(generic.New()).
+				Fields(field.New(fn.DateTrunc(fn.Month, "created_at"), "month")).
+				From("orders").
+				Build()
+			if err == nil || !strings.Contains(err.Error(), "no function translation: DATE_TRUNC for generic") {
+				t.Errorf("expected missing translation error, got %v", err)
+			}
+		})
+
+		t.Run("BuildInlined", func(t *testing.T) {
+			t.Run("Generic", func(t *testing.T) {
+				sql, err := selects.New(nil).
+					From("users").
+					Where("name", operator.Equal, "O'Reilly").
+					AndWhere("id", operator.In, []int{1, 2}).
+					AndWhere("token", operator.Equal, []byte{0xCA, 0xFE}).
+					BuildInlined()
+				want := "SELECT * FROM users WHERE name = 'O''Reilly' AND id IN (1, 2) AND token = X'CAFE'"
+				if err != nil || sql != want {
+					t.Errorf("expected `%s`, got `%s` (%v)", want, sql, err)
+				}
+			})
+
+			t.Run("Dialect", func(t *testing.T) {
+				sql, err := selects.New(oracle.New()).
+					From("users").
+					Where("active", operator.Equal, true).
+					Take(5).
+					BuildInlined()
+				want := "SELECT * FROM users WHERE active = 1 FETCH FIRST 5 ROWS ONLY"
+				if err != nil || sql != want {
+					t.Errorf("expected `%s`, got `%s` (%v)", want, sql, err)
+				}
+			})
+
+			t.Run("Backslashes", func(t *testing.T) {
+				sql, err := selects.New(mysql.New()).
+					From("files").
+					Where("path", operator.Equal, `C:\temp\`).
+					BuildInlined()
+				want := `SELECT * FROM files WHERE path = 'C:\\temp\\'`
+				if err != nil || sql != want {
+					t.Errorf("expected `%s`, got `%s` (%v)", want, sql, err)
+				}
+			})
+
+			t.Run("Errors", func(t *testing.T) {
+				if _, err := selects.New(nil).BuildInlined(); err == nil {
+					t.Error("expected missing table error")
+				}
+				_, err := selects.New(nil).From("users").Where("meta", operator.Equal, struct{}{}).BuildInlined()
+				if !errors.Is(err, dialect.ErrUnsupportedLiteral) {
+					t.Errorf("expected unsupported literal error, got %v", err)
+				}
+			})
+		})
+
+		t.Run("Tag", func(t *testing.T) {
+			sb := selects.New(nil).
+				From("orders").
+				Where("id", operator.Equal, 7).
+				Tag("route", "/orders/{id}").
+				Tag("app", "web").
+				Tag("app", "billing")
+			if got := sb.Tags(); !reflect.DeepEqual(got, map[string]string{"app": "billing", "route": "/orders/{id}"}) {
+				t.Errorf("unexpected tags %v", got)
+			}
+			sb.Tags()["app"] = "changed"
+
+			sql, _, err := sb.BuildFor(oracle.New())
+			want := "SELECT * FROM orders WHERE id = :1 /*app='billing',route='%2Forders%2F%7Bid%7D'*/"
+			if err != nil || sql != want {
+				t.Errorf("expected `%s`, got `%s` (%v)", want, sql, err)
+			}
+			inlined, err := sb.BuildInlined()
+			want = "SELECT * FROM orders WHERE id = 7 /*app='billing',route='%2Forders%2F%7Bid%7D'*/"
+			if err != nil || inlined != want {
+				t.Errorf("expected `%s`, got `%s` (%v)", want, inlined, err)
+			}
+			if tags := selects.New(nil).Tags(); tags != nil {
+				t.Errorf("expected no tags, got %v", tags)
+			}
+		})
+
+		t.Run("Remove", func(t *testing.T) {
+			build := func(t *testing.T, sb selects.SelectBuilder, want string) {
+				t.Helper()
+				if sql, _, err := sb.Build(); err != nil || sql != want {
+					t.Errorf("expected `%s`, got `%s` (%v)", want, sql, err)
+				}
+			}
+
+			t.Run("Field", func(t *testing.T) {
+				sb := selects.New(generic.New()).Fields("id, name AS username, email, created_at").From("users")
+				sb.RemoveField("USERNAME").RemoveField("email").RemoveField("missing")
+				build(t, sb, "SELECT id, created_at FROM users")
+
+				sb.ReplaceField("created_at", "DATE(created_at)", "created_on").ReplaceField("missing", "x")
+				build(t, sb, "SELECT id, DATE(created_at) AS created_on FROM users")
+
+				f := field.New("uuid")
+				sb.ReplaceField("id", &f).ReplaceField("uuid", (*field.Token)(nil))
+				build(t, sb, "SELECT uuid, DATE(created_at) AS created_on FROM users")
+
+				if got := selects.New(nil).RemoveField("id").ReplaceField("id", "x").GetFields(); got != nil {
+					t.Errorf("expected no fields, got %v", got)
+				}
+			})
+
+			t.Run("Join", func(t *testing.T) {
+				sb := selects.New(nil).From("users u").
+					LeftJoin("users u", "orders o", "o.user_id = u.id").
+					LeftJoin("users u", "teams", "teams.id = u.team_id").
+					CrossJoin("regions r")
+				sb.RemoveJoin("o").RemoveJoin("teams")
+				build(t, sb, "SELECT * FROM users AS u CROSS JOIN regions AS r")
+
+				sb.ClearJoins()
+				build(t, sb, "SELECT * FROM users AS u")
+				if got := selects.New(nil).RemoveJoin("o").ClearJoins().Joins(); got != nil {
+					t.Errorf("expected no joins, got %v", got)
+				}
+			})
+
+			t.Run("Where", func(t *testing.T) {
+				byField := func(name string) func(condition.Token) bool {
+					return func(c condition.Token) bool { return c.Field() == name }
+				}
+				first := condition.New(ct.Single, "status", operator.Equal, "active")
+				sb := selects.New(nil).From("users").
+					Where(first).
+					OrWhere("age", operator.GreaterThan, 18).
+					AndWhere("team", operator.Equal, 3)
+				sb.RemoveWhere(byField("status"))
+				build(t, sb, "SELECT * FROM users WHERE age > :age AND team = :team")
+
+				sb.RemoveWhere(byField("team"))
+				build(t, sb, "SELECT * FROM users WHERE age > :age")
+				sb.RemoveWhere(func(condition.Token) bool { return true })
+				build(t, sb, "SELECT * FROM users")
+
+				if first.Kind() != ct.Single || len(selects.New(nil).RemoveWhere(byField("x")).Conditions()) != 0 {
+					t.Error("expected RemoveWhere to leave removed and absent conditions alone")
+				}
+			})
+
+			t.Run("OrderBy", func(t *testing.T) {
+				sb := selects.New(generic.New()).From("users").
+					OrderBy("name ASC", "created_at DESC NULLS LAST", "id")
+				sb.RemoveOrderBy("Created_At").RemoveOrderBy("id")
+				build(t, sb, "SELECT * FROM users ORDER BY name ASC")
+			})
+
+			t.Run("Pagination", func(t *testing.T) {
+				sb := selects.New(nil).From("users").Take(10).Skip(20).ClearPagination()
+				if take, skip := sb.Pagination(); take != 0 || skip != 0 {
+					t.Errorf("expected no pagination, got %d and %d", take, skip)
+				}
+			})
+
+			t.Run("Immutable", func(t *testing.T) {
+				base := selects.New(nil).Fields("id, name").From("users u").
+					LeftJoin("users u", "orders o", "o.user_id = u.id").
+					Where("id", operator.Equal, 1).
+					OrderBy("id").
+					Take(5).
+					Immutable()
+				want, _, _ := base.Build()
+				base.RemoveField("name")
+				base.ReplaceField("id", "uuid")
+				base.RemoveJoin("o")
+				base.ClearJoins()
+				base.RemoveWhere(func(condition.Token) bool { return true })
+				base.RemoveOrderBy("id")
+				base.ClearPagination()
+				build(t, base, want)
+			})
+		})
+
+		t.Run("Clone", func(t *testing.T) {
+			base := selects.New(oracle.New()).
+				Fields("u.id").
+				From("users u").
+				InnerJoin("users u", "orders o", "o.user_id = u.id").
+				Where("u.active", operator.Equal, true).
+				GroupBy("u.id").
+				Having("COUNT(o.id) > 1").
+				OrderBy("u.id").
+				Take(10).
+				Tag("app", "billing")
+			want, _, err := base.Build()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cp := base.Clone()
+			if sql, _, _ := cp.Build(); sql != want {
+				t.Errorf("expected clone to build `%s`, got `%s`", want, sql)
+			}
+			cp.AppendFields("u.name").
+				LeftJoin("users u", "teams t", "t.id = u.team_id").
+				AndWhere("u.role", operator.Equal, "admin").
+				ThenGroupBy("u.name").
+				AndHaving("SUM(o.total) > 100").
+				ThenOrderBy("u.name").
+				Skip(20).
+				Tag("route", "/admins")
+			cp.Conditions()[0].SetKind(ct.Or)
+			cp.GetFields()[0].SetOwner(nil)
+
+			if sql, _, _ := base.Build(); sql != want {
+				t.Errorf("expected the original to be unchanged:\nexpected `%s`\n     got `%s`", want, sql)
+			}
+			if cp.Table() == base.Table() || cp.Joins()[0] == base.Joins()[0] || cp.Conditions()[0] == base.Conditions()[0] {
+				t.Error("expected clone to copy its tokens")
+			}
+			if cp.Dialect() != base.Dialect() {
+				t.Error("expected clone to keep the dialect")
+			}
+		})
+
+		t.Run("Immutable", func(t *testing.T) {
+			base := selects.New(nil).From("users").Where("active", operator.Equal, true).Immutable()
+			want, _, _ := base.Build()
+
+			admins := base.AndWhere("role", operator.Equal, "admin")
+			admins.Take(5)
+			if sql, _, _ := base.Build(); sql != want {
+				t.Errorf("expected the immutable builder to be unchanged, got `%s`", sql)
+			}
+			if sql, _, _ := admins.Build(); sql != "SELECT * FROM users WHERE active = :active AND role = :role" {
+				t.Errorf("expected mutators to return modified immutable copies, got `%s`", sql)
+			}
+
+			m := base.Clone()
+			m.Take(5)
+			if m.Limit() != 5 || base.Limit() != 0 {
+				t.Errorf("expected Clone to return a mutable copy, got limits %d and %d", m.Limit(), base.Limit())
+			}
+		})
+
+		t.Run("Concurrent", func(t *testing.T) {
+			// Meant to be run with -race.
+			base := selects.New(oracle.New()).From("users u").Where("u.active", operator.Equal, true).Immutable()
+			want, _, _ := base.Build()
+
+			var wg sync.WaitGroup
+			for i := range 16 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					q := base.AndWhere("u.team", operator.Equal, i).OrderBy("u.id").Take(i + 1)
+					if _, args, err := q.Build(); err != nil || !reflect.DeepEqual(args, []any{true, i}) {
+						t.Errorf("goroutine %d: unexpected args %v (%v)", i, args, err)
+					}
+					if sql, _, _ := base.Build(); sql != want {
+						t.Errorf("goroutine %d: expected the shared builder to be unchanged, got `%s`", i, sql)
+					}
+					cp := base.Clone()
+					cp.AndWhere("u.id", operator.Equal, i)
+				}()
+			}
+			wg.Wait()
+		})
+
+		t.Run("Fingerprint", func(t *testing.T) {
+			query := func(d dialect.SQLDialect, ids []int, name string) selects.SelectBuilder {
+				return selects.New(d).
+					Fields("u.id").
+					AppendFields("'vip'", "tier").
+					From("users u").
+					LeftJoin("users u", "orders o", "o.user_id = u.id").
+					Where("u.id", operator.In, ids).
+					AndWhere("u.name", operator.Equal, name).
+					OrWhere("u.age", operator.Between, []int{18, 65}).
+					AndWhere("u.deleted_at", operator.IsNull, nil).
+					GroupBy("u.id").
+					Having("COUNT(o.id) > 1").
+					OrderBy("u.id DESC").
+					Take(10)
+			}
+			want := query(nil, []int{1, 2, 3}, "ada").Fingerprint()
+			if got := query(oracle.New(), []int{4, 5}, "bob").Tag("app", "billing").Fingerprint(); got != want {
+				t.Errorf("expected values, IN list length, tags and dialect not to matter, got %s and %s", want, got)
+			}
+
+			spaced := query(nil, nil, "").
+				ClearJoins().LeftJoin("users u", "orders o", "o.user_id  =  u.id").
+				Having("COUNT(o.id)  > 1").
+				OrderBy("u.id  DESC")
+			if got := spaced.Fingerprint(); got != want {
+				t.Errorf("expected spacing in raw expressions not to matter, got %s and %s", want, got)
+			}
+			if a, b := query(nil, nil, "").Having("COUNT(o.id) > 1"), query(nil, nil, "").Having("COUNT(o.id) > 5"); a.Fingerprint() != b.Fingerprint() {
+				t.Errorf("expected HAVING literals not to matter, got %s and %s", a.Fingerprint(), b.Fingerprint())
+			}
+
+			for name, other := range map[string]selects.SelectBuilder{
+				"field":       query(nil, nil, "").AppendFields("u.email"),
+				"field alias": query(nil, nil, "").Fields("u.id", "uid").AppendFields("'vip'", "tier"),
+				"grouping":    query(nil, nil, "").GroupBy("u.id", "u.name"),
+				"operator":    query(nil, nil, "").AndWhere("u.id", operator.NotIn, []int{1}),
+				"kind":        query(nil, nil, "").OrWhere("u.active", operator.Equal, true),
+				"table":       query(nil, nil, "").From("customers u"),
+				"alias":       query(nil, nil, "").From("users v"),
+				"join kind":   query(nil, nil, "").ClearJoins().InnerJoin("users u", "orders o", "o.user_id = u.id"),
+				"join table":  query(nil, nil, "").ClearJoins().LeftJoin("users u", "invoices o", "o.user_id = u.id"),
+				"join alias":  query(nil, nil, "").ClearJoins().LeftJoin("users u", "orders x", "x.user_id = u.id"),
+				"join on":     query(nil, nil, "").ClearJoins().LeftJoin("users u", "orders o", "o.buyer_id = u.id"),
+				"having":      query(nil, nil, "").Having("COUNT(o.id) < 1"),
+				"sorting":     query(nil, nil, "").OrderBy("u.id ASC"),
+				"paging":      query(nil, nil, "").Skip(20),
+				"qualify":     query(nil, nil, "").Qualify("rn = 1"),
+				"sample":      query(nil, nil, "").Sample(10),
+				"limit by":    query(nil, nil, "").LimitBy(3, 0, "u.id"),
+			} {
+				if other.Fingerprint() == want {
+					t.Errorf("%s: expected the fingerprint to change", name)
+				}
+			}
+		})
+
+		t.Run("Tree", func(t *testing.T) {
+			sb := selects.New(generic.New()).Fields("id, name").From("users u").
+				LeftJoin("users u", "orders o", "o.user_id = u.id").
+				Where("active", operator.Equal, true).
+				OrWhere("role", operator.Equal, "admin").
+				AndWhere("age", operator.GreaterThan, 18)
+
+			children := sb.Children()
+			if len(children) != 7 {
+				t.Fatalf("expected 7 children, got %d", len(children))
+			}
+			if children[2] != sb.Table() || children[3] != sb.Joins()[0] || children[4] != sb.Conditions()[0] {
+				t.Errorf("expected fields, table, joins then conditions, got %v", children)
+			}
+
+			children[0] = nil
+			children[4] = nil
+			children[6] = condition.New(ct.Single, "age", operator.LessThan, 65)
+			cp := sb.WithChildren(children).(selects.SelectBuilder)
+			sql, _, err := cp.Build()
+			want := "SELECT name FROM users AS u LEFT JOIN orders AS o ON o.user_id = u.id " +
+				"WHERE role = ? AND age < ?"
+			if err != nil || sql != want {
+				t.Errorf("expected `%s`, got `%s` (%v)", want, sql, err)
+			}
+			if len(sb.GetFields()) != 2 || len(sb.Conditions()) != 3 {
+				t.Errorf("expected the original to be unchanged, got %s", sb.Debug())
+			}
+
+			children = sb.Children()
+			children[3] = field.New("x")
+			if _, _, err := sb.WithChildren(children).(selects.SelectBuilder).Build(); err == nil {
+				t.Error("expected an error for a field in place of a join")
+			}
+
+			frozen := sb.Immutable()
+			cp = frozen.WithChildren(frozen.Children()).(selects.SelectBuilder)
+			if cp.Take(5); cp.Limit() != 0 {
+				t.Error("expected the copy of an immutable builder to be immutable")
+			}
+
+			bad := sb.WithChildren(nil).(selects.SelectBuilder)
+			if _, _, err := bad.Build(); err == nil || !strings.Contains(err.Error(), "expects 7 children, got 0") {
+				t.Errorf("expected a child count error, got %v", err)
+			}
+			if len(bad.Conditions()) != 3 {
+				t.Errorf("expected the copy to keep its tokens, got %s", bad.Debug())
+			}
+
+			t.Run("Clauses", func(t *testing.T) {
+				sb := selects.New(generic.New()).Fields("status, COUNT(*) AS n").From("orders").
+					GroupBy("status", "region").
+					Having("COUNT(*) > 1").
+					OrHaving("SUM(total) > 100").
+					OrderBy("n DESC", "status")
+
+				children := sb.Children()
+				want := []contract.Node{
+					selects.Clause{Kind: selects.GroupBy, SQL: "status"},
+					selects.Clause{Kind: selects.GroupBy, SQL: "region"},
+					selects.Clause{Kind: selects.Having, SQL: "COUNT(*) > 1"},
+					selects.Clause{Kind: selects.Having, SQL: "OR SUM(total) > 100"},
+					selects.Clause{Kind: selects.OrderBy, SQL: "n DESC"},
+					selects.Clause{Kind: selects.OrderBy, SQL: "status"},
+				}
+				if len(children) != 9 || !reflect.DeepEqual(children[3:], want) {
+					t.Fatalf("expected the clauses after the table, got %v", children)
+				}
+
+				children[4] = nil
+				children[5] = nil
+				children[8] = nil
+				sql, _, err := sb.WithChildren(children).(selects.SelectBuilder).Build()
+				wantSQL := "SELECT status, COUNT(*) AS n FROM orders GROUP BY status " +
+					"HAVING SUM(total) > 100 ORDER BY n DESC"
+				if err != nil || sql != wantSQL {
+					t.Errorf("expected `%s`, got `%s` (%v)", wantSQL, sql, err)
+				}
+
+				children = sb.Children()
+				children[6] = selects.Clause{Kind: selects.Having, SQL: "MAX(total) > 50"}
+				sql, _, err = sb.WithChildren(children).(selects.SelectBuilder).Build()
+				wantSQL = "SELECT status, COUNT(*) AS n FROM orders GROUP BY status, region " +
+					"HAVING COUNT(*) > 1 AND MAX(total) > 50 ORDER BY n DESC, status"
+				if err != nil || sql != wantSQL {
+					t.Errorf("expected `%s`, got `%s` (%v)", wantSQL, sql, err)
+				}
+
+				children = sb.Children()
+				children[7] = field.New("n")
+				if _, _, err := sb.WithChildren(children).(selects.SelectBuilder).Build(); err == nil || !strings.Contains(err.Error(), "ORDER BY expects a selects.Clause") {
+					t.Errorf("expected an error for a field in place of an ORDER BY item, got %v", err)
+				}
+
+				qualified := sb.Clone().Qualify("rn = 1")
+				children = qualified.Children()
+				if c := children[7]; c != (selects.Clause{Kind: selects.Qualify, SQL: "rn = 1"}) {
+					t.Errorf("expected QUALIFY after HAVING, got %v", c)
+				}
+				children[7] = nil
+				if sql, _, err := qualified.WithChildren(children).(selects.SelectBuilder).BuildFor(snowflake.New()); err != nil || strings.Contains(sql, "QUALIFY") {
+					t.Errorf("expected QUALIFY to be removed, got `%s` (%v)", sql, err)
+				}
+			})
+		})
+
+		t.Run("InLists", func(t *testing.T) {
+			ids := []int{1, 2, 3}
+			arrays := postgres.New()
+
+			t.Run("Array", func(t *testing.T) {
+				sb := selects.New(nil).From("users").
+					Where("id", operator.In, ids).
+					AndWhere("role", operator.NotIn, []string{"a", "b"}).
+					InLists(builder.InArray, 0)
+				sql, args, err := sb.BuildFor(arrays)
+				want := "SELECT * FROM users WHERE id = ANY($1) AND role <> ALL($2)"
+				if err != nil || sql != want || len(args) != 2 {
+					t.Errorf("expected `%s`, got `%s` %v (%v)", want, sql, args, err)
+				}
+				for i, want := range []string{`{1,2,3}`, `{"a","b"}`} {
+					valuer, ok := args[i].(driver.Valuer)
+					if !ok {
+						t.Fatalf("expected arg %d to be a driver.Valuer, got %T", i, args[i])
+					}
+					if got, err := valuer.Value(); err != nil || got != want {
+						t.Errorf("arg %d = %v (%v), want %s", i, got, err, want)
+					}
+				}
+
+				_, args, _ = selects.New(nil).From("users").
+					Where("note", operator.In, []any{`say "hi"\`, nil, []byte("hi"), true, 2.5}).
+					InLists(builder.InArray, 0).
+					BuildFor(arrays)
+				got, err := args[0].(driver.Valuer).Value()
+				if want := `{"say \"hi\"\\",NULL,"\\x6869",true,2.5}`; err != nil || got != want {
+					t.Errorf("array = %v (%v), want %s", got, err, want)
+				}
+				_, args, _ = selects.New(nil).From("users").
+					Where("meta", operator.In, []any{struct{}{}}).
+					InLists(builder.InArray, 0).
+					BuildFor(arrays)
+				if _, err := args[0].(driver.Valuer).Value(); err == nil {
+					t.Error("expected an unsupported array element to fail")
+				}
+
+				_, _, err = sb.BuildFor(generic.New())
+				if err == nil || !strings.Contains(err.Error(), builder.FeatureArrayBinding) {
+					t.Errorf("expected array binding failure, got %v", err)
+				}
+			})
+
+			t.Run("Values", func(t *testing.T) {
+				sql, args, err := selects.New(nil).From("users").
+					Where("name", operator.In, []string{"x", "O'Reilly"}).
+					InLists(builder.InValues, 1).
+					BuildFor(generic.New())
+				want := "SELECT * FROM users WHERE name IN (SELECT v FROM (VALUES (?), (?)) AS t (v))"
+				if err != nil || sql != want || !reflect.DeepEqual(args, []any{"x", "O'Reilly"}) {
+					t.Errorf("expected `%s`, got `%s` %v (%v)", want, sql, args, err)
+				}
+
+				_, _, err = selects.New(nil).From("users").
+					Where("id", operator.In, ids).
+					InLists(builder.InValues, 0).
+					BuildFor(oracle.New())
+				if err == nil || !strings.Contains(err.Error(), builder.FeatureValuesList) {
+					t.Errorf("expected VALUES list failure, got %v", err)
+				}
+			})
+
+			t.Run("Empty", func(t *testing.T) {
+				for _, strategy := range []builder.InStrategy{builder.InExpand, builder.InArray, builder.InValues} {
+					sql, args, err := selects.New(nil).From("users").
+						Where("id IN ?", []int{}).
+						AndWhere("role NOT IN ?", []string{}).
+						InLists(strategy, 0).
+						BuildFor(arrays)
+					want := "SELECT * FROM users WHERE 1=0 AND 1=1"
+					if err != nil || sql != want || len(args) != 0 {
+						t.Errorf("%s: expected `%s`, got `%s` %v (%v)", strategy, want, sql, args, err)
+					}
+				}
+			})
+
+			t.Run("Threshold", func(t *testing.T) {
+				sql, _, err := selects.New(nil).From("users").
+					Where("id", operator.In, ids).
+					InLists(builder.InArray, 3).
+					BuildFor(arrays)
+				if err != nil || sql != "SELECT * FROM users WHERE id IN ($1, $2, $3)" {
+					t.Errorf("expected short list to be expanded, got `%s` (%v)", sql, err)
+				}
+			})
+		})
+
+		t.Run("PlaceholderLimit", func(t *testing.T) {
+			capped := generic.NewWithOptions(dialect.Options{
+				Name: "capped", PlaceholderStyle: "?", MaxPlaceholderIndex: 2, SupportsArrayBinding: true,
+			})
+			sb := selects.New(nil).From("users").Where("id", operator.In, []int{1, 2, 3})
+
+			_, _, err := sb.BuildFor(capped)
+			var limit *dialect.PlaceholderLimitError
+			if !errors.As(err, &limit) || limit.Count != 3 || limit.Max != 2 {
+				t.Fatalf("expected PlaceholderLimitError, got %v", err)
+			}
+			if !errors.Is(err, dialect.ErrTooManyPlaceholders) {
+				t.Errorf("expected ErrTooManyPlaceholders, got %v", err)
+			}
+
+			if _, args, err := sb.InLists(builder.InArray, 0).BuildFor(capped); err != nil || len(args) != 1 {
+				t.Errorf("expected array binding to fit the limit, got %v (%v)", args, err)
+			}
+			if _, _, err := sb.BuildFor(nil); err != nil {
+				t.Errorf("expected no limit for the neutral form, got %v", err)
+			}
+
+			ids := make([]int, 2101)
+			long := selects.New(nil).From("users").Where("id", operator.In, ids)
+			for _, d := range []dialect.SQLDialect{sqlite.New(), mssql.New()} {
+				if _, _, err := long.BuildFor(d); !errors.Is(err, dialect.ErrTooManyPlaceholders) {
+					t.Errorf("%s: expected ErrTooManyPlaceholders, got %v", d.Name(), err)
+				}
+			}
+			if _, _, err := long.BuildFor(postgres.New()); err != nil {
+				t.Errorf("postgres: unexpected error: %v", err)
+			}
 		})
 	})
 }
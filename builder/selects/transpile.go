@@ -0,0 +1,265 @@
+// File: db/builder/selects/transpile.go
+
+package selects
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/entiqon/db/builder"
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/token/condition"
+	"github.com/entiqon/db/token/field"
+	"github.com/entiqon/db/token/join"
+	"github.com/entiqon/db/token/table"
+	ct "github.com/entiqon/db/token/types/condition"
+	"github.com/entiqon/db/token/types/identifier"
+	"github.com/entiqon/db/token/types/operator"
+)
+
+// windowCall matches window function calls (… OVER (…)).
+var windowCall = regexp.MustCompile(`(?i)\bOVER\s*\(`)
+
+// nullsOrdering splits an ORDER BY item ending with NULLS FIRST/LAST into
+// the sort key (with its direction) and the NULLS placement.
+var nullsOrdering = regexp.MustCompile(`(?i)^(.+?)\s+NULLS\s+(FIRST|LAST)$`)
+
+// sortDirection matches a trailing ASC/DESC on a sort key.
+var sortDirection = regexp.MustCompile(`(?i)\s+(ASC|DESC)$`)
+
+// havingConnector matches the AND / OR connector leading a HAVING
+// condition.
+var havingConnector = regexp.MustCompile(`(?i)^(AND|OR)\s+`)
+
+// inLists is the rendering rule for IN / NOT IN lists set with InLists.
+// Lists longer than threshold use strategy; the others are expanded.
+type inLists struct {
+	strategy  builder.InStrategy
+	threshold int
+}
+
+// renderCondition renders c for dialect d, binding its values after args
+// with d's positional placeholders. IN / NOT IN lists get one placeholder
+// per element, unless in selects another strategy, and BETWEEN one per
+// bound; an empty IN list is always false (1=0) and an empty NOT IN list
+// always true (1=1). ILIKE is emulated with LOWER on dialects without
+// SupportsILike, and recorded in r.
+func renderCondition(d dialect.SQLDialect, c condition.Token, args []any, in inLists, r *builder.Report) (string, []any) {
+	bind := func(v any) string {
+		args = append(args, v)
+		return d.Placeholder(len(args))
+	}
+
+	f, op := c.Field(), c.Operator()
+	var expr string
+	switch op {
+	case operator.IsNull, operator.IsNotNull:
+		expr = fmt.Sprintf("%s %s", f, op)
+	case operator.In, operator.NotIn:
+		values := flatten(c.Value())
+		if len(values) == 0 {
+			expr = "1=0"
+			if op == operator.NotIn {
+				expr = "1=1"
+			}
+			break
+		}
+		strategy := in.strategy
+		if len(values) <= in.threshold {
+			strategy = builder.InExpand
+		}
+		switch strategy {
+		case builder.InArray:
+			if !d.Options().SupportsArrayBinding {
+				r.Fail(builder.FeatureArrayBinding)
+				break
+			}
+			if op == operator.In {
+				expr = fmt.Sprintf("%s = ANY(%s)", f, bind(arrayValue(values)))
+			} else {
+				expr = fmt.Sprintf("%s <> ALL(%s)", f, bind(arrayValue(values)))
+			}
+		case builder.InValues:
+			if !d.Options().SupportsValuesList {
+				r.Fail(builder.FeatureValuesList)
+				break
+			}
+			rows := make([]string, len(values))
+			for i, v := range values {
+				rows[i] = "(" + bind(v) + ")"
+			}
+			expr = fmt.Sprintf("%s %s (SELECT v FROM (VALUES %s) AS t (v))", f, op, strings.Join(rows, ", "))
+		}
+		if expr != "" {
+			break
+		}
+		marks := make([]string, len(values))
+		for i, v := range values {
+			marks[i] = bind(v)
+		}
+		expr = fmt.Sprintf("%s %s (%s)", f, op, strings.Join(marks, ", "))
+	case operator.Between:
+		values := flatten(c.Value())
+		if len(values) != 2 {
+			expr = fmt.Sprintf("%s %s %s", f, op, bind(c.Value()))
+			break
+		}
+		lo, hi := bind(values[0]), bind(values[1])
+		expr = fmt.Sprintf("%s %s %s AND %s", f, op, lo, hi)
+	case operator.ILike, operator.NotILike:
+		mark := bind(c.Value())
+		if d.Options().SupportsILike {
+			expr = fmt.Sprintf("%s %s %s", f, op, mark)
+			break
+		}
+		like := operator.Like
+		if op == operator.NotILike {
+			like = operator.NotLike
+		}
+		expr = fmt.Sprintf("LOWER(%s) %s LOWER(%s)", f, like, mark)
+		r.Emulate(op.String())
+	default:
+		expr = fmt.Sprintf("%s %s %s", f, op, bind(c.Value()))
+	}
+
+	if c.Kind() != ct.Single {
+		expr = fmt.Sprintf("%s %s", c.Kind(), expr)
+	}
+	return expr, args
+}
+
+// fieldShape returns the kind, expression and alias of f, for
+// Fingerprint.
+func fieldShape(f field.Token) string {
+	return fmt.Sprintf("%s|%s|%s", f.ExpressionKind(), f.Expr(), f.Alias())
+}
+
+// conditionShape returns the kind, field and operator of c, for
+// Fingerprint. Its value, and so the number of elements of IN lists, is
+// left out.
+func conditionShape(c condition.Token) string {
+	return fmt.Sprintf("%s|%s|%s", c.Kind(), c.Field(), c.Operator())
+}
+
+// joinShape returns the kind and right table of j, and its raw ON
+// condition through builder.Normalize, for Fingerprint.
+func joinShape(j join.Token) string {
+	if !j.IsValid() {
+		return ""
+	}
+	return fmt.Sprintf("%s|%s|%s", j.Kind(), tableShape(j.Right()), builder.Normalize(j.Condition()))
+}
+
+// tableShape returns the kind, name and alias of t, for Fingerprint. The
+// name of a subquery is a raw fragment and goes through builder.Normalize.
+func tableShape(t table.Token) string {
+	if t == nil || !t.IsValid() {
+		return ""
+	}
+	name := t.Name()
+	if t.ExpressionKind() == identifier.TypeSubquery {
+		name = builder.Normalize(name)
+	}
+	return fmt.Sprintf("%s|%s|%s", t.ExpressionKind(), name, t.Alias())
+}
+
+// renderSorting joins ORDER BY items. Without a dialect, or when d supports
+// NULLS FIRST/LAST, items are kept as written; otherwise the NULLS clause
+// is replaced by a leading CASE sort key and recorded in r.
+//
+// Example:
+//
+//	"name DESC NULLS LAST" → "CASE WHEN name IS NULL THEN 1 ELSE 0 END, name DESC"
+func renderSorting(d dialect.SQLDialect, items []string, r *builder.Report) string {
+	if d == nil || d.Options().SupportsNullsOrdering {
+		return strings.Join(items, ", ")
+	}
+
+	out := make([]string, len(items))
+	for i, item := range items {
+		m := nullsOrdering.FindStringSubmatch(item)
+		if m == nil {
+			out[i] = item
+			continue
+		}
+
+		key := m[1]
+		col := sortDirection.ReplaceAllString(key, "")
+		placement := "NULLS " + strings.ToUpper(m[2])
+		first, rest := 1, 0
+		if placement == builder.FeatureNullsFirst {
+			first, rest = 0, 1
+		}
+		out[i] = fmt.Sprintf("CASE WHEN %s IS NULL THEN %d ELSE %d END, %s", col, first, rest, key)
+		r.Emulate(placement)
+	}
+	return strings.Join(out, ", ")
+}
+
+// flatten returns the elements of a slice or array value, or v itself as
+// a single element. []byte is treated as a scalar.
+func flatten(v any) []any {
+	if _, ok := v.([]byte); ok {
+		return []any{v}
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return []any{v}
+	}
+	out := make([]any, rv.Len())
+	for i := range out {
+		out[i] = rv.Index(i).Interface()
+	}
+	return out
+}
+
+// arrayValue binds an IN list to a single placeholder as a PostgreSQL
+// array. It renders the text form of the array ({1,2,"O'Reilly"}), which
+// the server casts to the type of the compared column, so the list binds
+// with any driver, including those that do not encode Go slices as arrays
+// (lib/pq without pq.Array).
+type arrayValue []any
+
+// Value implements driver.Valuer. Elements are converted as database/sql
+// converts arguments (driver.Valuer, pointers, named types) and must be
+// numbers, booleans, strings, []byte, time.Time or nil.
+func (a arrayValue) Value() (driver.Value, error) {
+	elems := make([]string, len(a))
+	for i, v := range a {
+		value, err := driver.DefaultParameterConverter.ConvertValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("array element %d: %w", i+1, err)
+		}
+		switch x := value.(type) {
+		case nil:
+			elems[i] = "NULL"
+		case bool:
+			elems[i] = strconv.FormatBool(x)
+		case int64:
+			elems[i] = strconv.FormatInt(x, 10)
+		case float64:
+			elems[i] = strconv.FormatFloat(x, 'g', -1, 64)
+		case string:
+			elems[i] = quoteArrayElement(x)
+		case []byte:
+			elems[i] = quoteArrayElement(`\x` + hex.EncodeToString(x))
+		case time.Time:
+			elems[i] = quoteArrayElement(x.Format(time.RFC3339Nano))
+		default:
+			return nil, fmt.Errorf("array element %d: unsupported type %T", i+1, v)
+		}
+	}
+	return "{" + strings.Join(elems, ",") + "}", nil
+}
+
+// quoteArrayElement double-quotes s for an array literal, escaping
+// backslashes and double quotes.
+func quoteArrayElement(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
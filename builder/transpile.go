@@ -0,0 +1,109 @@
+// File: db/builder/transpile.go
+
+package builder
+
+import (
+	"github.com/entiqon/db/dialect"
+)
+
+// Renderer is implemented by builders that can render their statement for
+// any dialect. Builder state is dialect-neutral until RenderFor is called,
+// so one builder can be rendered for several dialects.
+type Renderer interface {
+	// RenderFor renders the statement for d and reports the features
+	// that had to be emulated or could not be expressed.
+	RenderFor(d dialect.SQLDialect) Result
+}
+
+// Features reported in Report.Emulated and Report.Failed.
+const (
+	FeatureILike           = "ILIKE"
+	FeatureNotILike        = "NOT ILIKE"
+	FeatureNullsFirst      = "NULLS FIRST"
+	FeatureNullsLast       = "NULLS LAST"
+	FeatureWindowFunctions = "window functions"
+	FeatureArrayBinding    = "array binding"
+	FeatureValuesList      = "VALUES lists"
+	FeatureQualify         = "QUALIFY"
+	FeatureSample          = "SAMPLE"
+	FeatureLimitBy         = "LIMIT BY"
+)
+
+// Report lists the features of a statement that a dialect does not
+// support natively.
+//
+//   - Emulated — rewritten into an equivalent form (e.g. ILIKE → LOWER LIKE)
+//   - Failed   — impossible to express; the render carries an error
+//
+// Each feature is listed once, in the order it was first encountered.
+type Report struct {
+	Emulated []string
+	Failed   []string
+}
+
+// Emulate records feature as emulated.
+func (r *Report) Emulate(feature string) {
+	r.Emulated = appendUnique(r.Emulated, feature)
+}
+
+// Fail records feature as unsupported.
+func (r *Report) Fail(feature string) {
+	r.Failed = appendUnique(r.Failed, feature)
+}
+
+// HasFailures reports whether any feature could not be rendered.
+func (r Report) HasFailures() bool {
+	return len(r.Failed) > 0
+}
+
+// Result is the outcome of rendering a builder for one dialect.
+//
+// Err is set when the builder is invalid or when Failed is not empty;
+// SQL and Args are empty in that case.
+type Result struct {
+	// Dialect is the name of the dialect the statement was rendered for.
+	Dialect string
+
+	// SQL is the rendered statement.
+	SQL string
+
+	// Args are the bound values, in placeholder order.
+	Args []any
+
+	Report
+
+	// Err holds the build error, if any.
+	Err error
+}
+
+// Transpile renders r once per dialect and returns the results keyed by
+// dialect name. Nil dialects are skipped; when two dialects share a name,
+// the last one wins.
+//
+// Example:
+//
+//	sb := selects.New(nil).From("users").Where("name", operator.ILike, "jo%")
+//	results := builder.Transpile(sb, generic.New(), snowflake.New())
+//	results["generic"].SQL       // SELECT * FROM users WHERE LOWER(name) LIKE LOWER(?)
+//	results["generic"].Emulated  // [ILIKE]
+//	results["snowflake"].SQL     // SELECT * FROM users WHERE name ILIKE ?
+func Transpile(r Renderer, dialects ...dialect.SQLDialect) map[string]Result {
+	out := make(map[string]Result, len(dialects))
+	for _, d := range dialects {
+		if d == nil {
+			continue
+		}
+		out[d.Name()] = r.RenderFor(d)
+	}
+	return out
+}
+
+// appendUnique appends s to list unless it is already present.
+func appendUnique(list []string, s string) []string {
+	for _, v := range list {
+		if v == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
@@ -0,0 +1,72 @@
+// File: db/builder/transpile_test.go
+
+package builder_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/entiqon/db/builder"
+	"github.com/entiqon/db/builder/selects"
+	"github.com/entiqon/db/dialect/generic"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/dialect/snowflake"
+	"github.com/entiqon/db/token/types/operator"
+)
+
+func TestTranspile(t *testing.T) {
+	t.Run("Report", func(t *testing.T) {
+		var r builder.Report
+		r.Emulate(builder.FeatureILike)
+		r.Emulate(builder.FeatureNullsLast)
+		r.Emulate(builder.FeatureILike)
+		if fmt.Sprint(r.Emulated) != "[ILIKE NULLS LAST]" {
+			t.Errorf("expected deduplicated emulations, got %v", r.Emulated)
+		}
+		if r.HasFailures() {
+			t.Error("expected no failures")
+		}
+		r.Fail(builder.FeatureWindowFunctions)
+		r.Fail(builder.FeatureWindowFunctions)
+		if !r.HasFailures() || len(r.Failed) != 1 {
+			t.Errorf("expected one failure, got %v", r.Failed)
+		}
+	})
+
+	t.Run("PerDialect", func(t *testing.T) {
+		sb := selects.New(nil).
+			From("users").
+			Where("name", operator.ILike, "jo%").
+			Take(5)
+
+		got := builder.Transpile(sb, generic.New(), nil, oracle.New(), snowflake.New())
+		if len(got) != 3 {
+			t.Fatalf("expected 3 results, got %d", len(got))
+		}
+
+		want := map[string]string{
+			"generic":   "SELECT * FROM users WHERE LOWER(name) LIKE LOWER(?) LIMIT 5",
+			"oracle":    "SELECT * FROM users WHERE LOWER(name) LIKE LOWER(:1) FETCH FIRST 5 ROWS ONLY",
+			"snowflake": "SELECT * FROM users WHERE name ILIKE ? LIMIT 5",
+		}
+		for name, sql := range want {
+			r := got[name]
+			if r.Err != nil || r.SQL != sql || r.Dialect != name {
+				t.Errorf("%s: expected `%s`, got `%s` (%v)", name, sql, r.SQL, r.Err)
+			}
+			if len(r.Args) != 1 || r.Args[0] != "jo%" {
+				t.Errorf("%s: unexpected args %v", name, r.Args)
+			}
+		}
+		if len(got["snowflake"].Emulated) != 0 || len(got["oracle"].Emulated) != 1 {
+			t.Errorf("unexpected emulations: snowflake %v, oracle %v",
+				got["snowflake"].Emulated, got["oracle"].Emulated)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		if got := builder.Transpile(selects.New(nil).From("users")); len(got) != 0 {
+			t.Errorf("expected no results, got %v", got)
+		}
+	})
+}
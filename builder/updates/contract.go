@@ -0,0 +1,51 @@
+package updates
+
+import (
+	"github.com/entiqon/db/contract"
+	"github.com/entiqon/db/dialect"
+)
+
+// UpdateBuilder defines the contract for constructing SQL UPDATE queries.
+//
+// Methods:
+//   - Table / Set: set the table and column assignments
+//   - Where / AndWhere / OrWhere: manage WHERE conditions
+//   - FromStruct: derive assignments and the pk match from a tagged struct
+//   - Build / BuildFor: construct the final SQL, optionally for another dialect
+//   - Dialect / Debug: return the dialect given to New and a diagnostic view
+type UpdateBuilder interface {
+	contract.Debuggable
+
+	// Table sets the table to update.
+	Table(name string) UpdateBuilder
+
+	// Set appends a column = value assignment; the value is bound.
+	Set(column string, value any) UpdateBuilder
+
+	// Where sets the WHERE clause, replacing existing conditions.
+	//
+	// Notes:
+	//   • A bare column ("id") with a value renders "id = ?".
+	//   • "?" markers in the condition are bound to values in order.
+	Where(condition string, values ...any) UpdateBuilder
+
+	// AndWhere appends a condition with AND.
+	AndWhere(condition string, values ...any) UpdateBuilder
+
+	// OrWhere appends a condition with OR.
+	OrWhere(condition string, values ...any) UpdateBuilder
+
+	// FromStruct assigns the columns of a struct, or a pointer to one,
+	// tagged with `db:"name,pk,omitempty,readonly,default"`, and matches
+	// the row by its pk fields.
+	FromStruct(v any) UpdateBuilder
+
+	// Build renders the statement for the dialect given to New.
+	Build() (string, []any, error)
+
+	// BuildFor renders the statement for d.
+	BuildFor(d dialect.SQLDialect) (string, []any, error)
+
+	// Dialect returns the dialect given to New, or nil.
+	Dialect() dialect.SQLDialect
+}
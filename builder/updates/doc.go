@@ -0,0 +1,28 @@
+// Package updates provides a builder for SQL UPDATE statements.
+//
+// # Overview
+//
+// UpdateBuilder constructs UPDATE queries with support for:
+//
+//   - Target table and column assignments (SET)
+//   - Conditions (WHERE, AND, OR) with bound values
+//   - Assignments and the primary key match derived from a tagged
+//     struct (FromStruct)
+//
+// # Example
+//
+//	ub := updates.New(postgres.New()).
+//	    Table("users").
+//	    Set("name", "Ada").
+//	    Where("id = ?", 7)
+//
+//	sql, args, err := ub.Build()
+//	// UPDATE users SET name = $1 WHERE id = $2  [Ada 7]
+//
+// # Notes
+//
+//   - Mutators return the builder for chaining.
+//   - Passing nil as dialect renders with "?" placeholders.
+//   - BuildFor renders the same builder for another dialect, so it can be
+//     run with exec.Exec on a runner bound to any dialect.
+package updates
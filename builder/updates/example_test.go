@@ -0,0 +1,42 @@
+// File: db/builder/updates/example_test.go
+
+package updates_test
+
+import (
+	"fmt"
+
+	"github.com/entiqon/db/builder/updates"
+	"github.com/entiqon/db/dialect/mssql"
+	"github.com/entiqon/db/dialect/postgres"
+)
+
+func ExampleUpdateBuilder() {
+	ub := updates.New(postgres.New()).
+		Table("users").
+		Set("name", "Ada").
+		Where("id = ?", 7)
+
+	sql, args, _ := ub.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+	// Output:
+	// UPDATE users SET name = $1 WHERE id = $2
+	// [Ada 7]
+}
+
+func ExampleUpdateBuilder_fromStruct() {
+	type User struct {
+		ID    int64  `db:"id,pk"`
+		Email string `db:"email"`
+	}
+
+	sql, args, _ := updates.New(nil).
+		Table("users").
+		FromStruct(User{ID: 7, Email: "ada@example.com"}).
+		BuildFor(mssql.New())
+	fmt.Println(sql)
+	fmt.Println(args)
+	// Output:
+	// UPDATE users SET email = @p1 WHERE id = @p2
+	// [ada@example.com 7]
+}
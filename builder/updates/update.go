@@ -0,0 +1,92 @@
+// File: db/builder/updates/update.go
+
+package updates
+
+import (
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/internal/builder"
+)
+
+// updateBuilder implements UpdateBuilder on top of the internal UPDATE
+// builder.
+type updateBuilder struct {
+	dialect dialect.SQLDialect
+	inner   *builder.UpdateBuilder
+}
+
+// New creates a new UpdateBuilder rendering for d. If nil is passed, the
+// statement is rendered with "?" placeholders.
+//
+// Example:
+//
+//	sql, args, err := updates.New(oracle.New()).
+//	    Table("users").
+//	    Set("name", "Ada").
+//	    Where("id = ?", 7).
+//	    Build()
+//	// UPDATE users SET name = :1 WHERE id = :2
+func New(d dialect.SQLDialect) UpdateBuilder {
+	return &updateBuilder{dialect: d, inner: builder.NewUpdate(builder.FromDialect(d))}
+}
+
+// Table sets the table to update.
+func (b *updateBuilder) Table(name string) UpdateBuilder {
+	b.inner.Table(name)
+	return b
+}
+
+// Set appends a column = value assignment.
+func (b *updateBuilder) Set(column string, value any) UpdateBuilder {
+	b.inner.Set(column, value)
+	return b
+}
+
+// Where sets the WHERE clause, replacing existing conditions.
+func (b *updateBuilder) Where(condition string, values ...any) UpdateBuilder {
+	b.inner.Where(condition, values...)
+	return b
+}
+
+// AndWhere appends a condition with AND.
+func (b *updateBuilder) AndWhere(condition string, values ...any) UpdateBuilder {
+	b.inner.AndWhere(condition, values...)
+	return b
+}
+
+// OrWhere appends a condition with OR.
+func (b *updateBuilder) OrWhere(condition string, values ...any) UpdateBuilder {
+	b.inner.OrWhere(condition, values...)
+	return b
+}
+
+// FromStruct assigns the columns of a tagged struct and matches the row
+// by its pk fields.
+//
+// Example:
+//
+//	updates.New(nil).Table("users").FromStruct(User{ID: 7, Email: "a@b.c"})
+//	// UPDATE users SET email = ? WHERE id = ?
+func (b *updateBuilder) FromStruct(v any) UpdateBuilder {
+	b.inner.FromStruct(v)
+	return b
+}
+
+// Build renders the statement for the dialect given to New.
+func (b *updateBuilder) Build() (string, []any, error) {
+	return b.inner.Build()
+}
+
+// BuildFor renders the statement for d; a nil d renders as Build.
+func (b *updateBuilder) BuildFor(d dialect.SQLDialect) (string, []any, error) {
+	return b.inner.BuildFor(d)
+}
+
+// Dialect returns the dialect given to New, or nil.
+func (b *updateBuilder) Dialect() dialect.SQLDialect {
+	return b.dialect
+}
+
+// Debug returns a developer-facing summary of the builder state.
+func (b *updateBuilder) Debug() string {
+	return b.inner.Debug()
+}
@@ -0,0 +1,67 @@
+package upserts
+
+import (
+	"github.com/entiqon/db/contract"
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/internal/builder"
+)
+
+// Assignment is a column update applied on conflict, like
+// name = EXCLUDED.name. Assignments render in an ON CONFLICT ... DO
+// UPDATE SET clause.
+type Assignment = builder.Assignment
+
+// Statement is one statement of a batched build, with its bound
+// arguments.
+type Statement = builder.Statement
+
+// UpsertBuilder defines the contract for constructing SQL upserts.
+//
+// Methods:
+//   - Into / Columns / Values: set the table, columns and rows
+//   - OnConflict / DoUpdateSet: set the conflict target and the assignments
+//   - FromStruct: derive all of the above from tagged structs
+//   - Returning: add RETURNING columns
+//   - Build / BuildFor / BuildBatches: construct the final SQL, optionally for another dialect
+//   - Dialect / Debug: return the dialect given to New and a diagnostic view
+type UpsertBuilder interface {
+	contract.Debuggable
+
+	// Into sets the target table.
+	Into(table string) UpsertBuilder
+
+	// Columns sets the column names, replacing existing ones.
+	Columns(cols ...string) UpsertBuilder
+
+	// Values appends a row of values, one per column.
+	Values(values ...any) UpsertBuilder
+
+	// OnConflict appends columns to the conflict target.
+	OnConflict(columns ...string) UpsertBuilder
+
+	// DoUpdateSet appends assignments applied on conflict. Without
+	// assignments, conflicting rows are left unchanged.
+	DoUpdateSet(assignments ...Assignment) UpsertBuilder
+
+	// FromStruct sets the rows from tagged structs, the conflict target
+	// from their pk fields, and updates the other written columns from
+	// the inserted row as the dialect spells it (EXCLUDED, VALUES() or
+	// MERGE).
+	FromStruct(v any) UpsertBuilder
+
+	// Returning appends columns to the RETURNING clause.
+	Returning(columns ...string) UpsertBuilder
+
+	// Build renders the statement for the dialect given to New.
+	Build() (string, []any, error)
+
+	// BuildFor renders the statement for d.
+	BuildFor(d dialect.SQLDialect) (string, []any, error)
+
+	// BuildBatches renders one statement per batch of rows, each binding
+	// no more placeholders than the dialect accepts.
+	BuildBatches() ([]Statement, error)
+
+	// Dialect returns the dialect given to New, or nil.
+	Dialect() dialect.SQLDialect
+}
@@ -0,0 +1,37 @@
+// Package upserts provides a builder for SQL upserts: inserts that update
+// the existing row when it conflicts on a key.
+//
+// # Overview
+//
+// UpsertBuilder constructs upserts with support for:
+//
+//   - Target table, columns and rows of bound values
+//   - Conflict target (OnConflict) and update assignments (DoUpdateSet)
+//   - Rows, conflict target and assignments derived from tagged structs
+//     (FromStruct), rendered by the dialect: EXCLUDED.col on PostgreSQL
+//     and SQLite, VALUES(col) on MySQL, MERGE on Oracle and SQL Server
+//   - RETURNING on dialects supporting it
+//   - Batches split under the dialect placeholder limit (BuildBatches)
+//
+// # Example
+//
+//	ub := upserts.New(postgres.New()).
+//	    Into("users").
+//	    Columns("id", "name").
+//	    Values(1, "Ada").
+//	    OnConflict("id").
+//	    DoUpdateSet(upserts.Assignment{Column: "name", Expr: "EXCLUDED.name"})
+//
+//	sql, args, err := ub.Build()
+//	// INSERT INTO users (id, name) VALUES ($1, $2)
+//	//   ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name
+//
+// # Notes
+//
+//   - Mutators return the builder for chaining.
+//   - Passing nil as dialect renders with "?" placeholders.
+//   - MERGE takes one row per statement: Build fails on several rows
+//     derived by FromStruct, and BuildBatches renders one per row.
+//   - BuildFor renders the same builder for another dialect, so it can be
+//     run with exec.Exec on a runner bound to any dialect.
+package upserts
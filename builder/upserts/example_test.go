@@ -0,0 +1,45 @@
+// File: db/builder/upserts/example_test.go
+
+package upserts_test
+
+import (
+	"fmt"
+
+	"github.com/entiqon/db/builder/upserts"
+	"github.com/entiqon/db/dialect/mysql"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/dialect/postgres"
+)
+
+func ExampleUpsertBuilder() {
+	ub := upserts.New(postgres.New()).
+		Into("users").
+		Columns("id", "name").
+		Values(1, "Ada").
+		OnConflict("id").
+		DoUpdateSet(upserts.Assignment{Column: "name", Expr: "EXCLUDED.name"})
+
+	sql, args, _ := ub.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+	// Output:
+	// INSERT INTO users (id, name) VALUES ($1, $2) ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name
+	// [1 Ada]
+}
+
+func ExampleUpsertBuilder_FromStruct() {
+	type User struct {
+		ID   int64  `db:"id,pk"`
+		Name string `db:"name"`
+	}
+
+	ub := upserts.New(mysql.New()).Into("users").FromStruct(User{ID: 1, Name: "Ada"})
+	sql, _, _ := ub.Build()
+	fmt.Println(sql)
+
+	sql, _, _ = ub.BuildFor(oracle.New())
+	fmt.Println(sql)
+	// Output:
+	// INSERT INTO users (id, name) VALUES (?, ?) ON DUPLICATE KEY UPDATE name = VALUES(name)
+	// MERGE INTO users tgt USING (SELECT :1 AS id, :2 AS name FROM dual) src ON (tgt.id = src.id) WHEN MATCHED THEN UPDATE SET tgt.name = src.name WHEN NOT MATCHED THEN INSERT (id, name) VALUES (src.id, src.name)
+}
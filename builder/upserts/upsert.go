@@ -0,0 +1,99 @@
+// File: db/builder/upserts/upsert.go
+
+package upserts
+
+import (
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/internal/builder"
+)
+
+// upsertBuilder implements UpsertBuilder on top of the internal UPSERT
+// builder.
+type upsertBuilder struct {
+	dialect dialect.SQLDialect
+	inner   *builder.UpsertBuilder
+}
+
+// New creates a new UpsertBuilder rendering for d. If nil is passed, the
+// statement is rendered with "?" placeholders.
+//
+// Example:
+//
+//	sql, args, err := upserts.New(postgres.New()).
+//	    Into("users").
+//	    Columns("id", "name").
+//	    Values(1, "Ada").
+//	    OnConflict("id").
+//	    Build()
+//	// INSERT INTO users (id, name) VALUES ($1, $2) ON CONFLICT (id) DO NOTHING
+func New(d dialect.SQLDialect) UpsertBuilder {
+	return &upsertBuilder{dialect: d, inner: builder.NewUpsert(builder.FromDialect(d))}
+}
+
+// Into sets the target table.
+func (b *upsertBuilder) Into(table string) UpsertBuilder {
+	b.inner.Into(table)
+	return b
+}
+
+// Columns sets the column names, replacing existing ones.
+func (b *upsertBuilder) Columns(cols ...string) UpsertBuilder {
+	b.inner.Columns(cols...)
+	return b
+}
+
+// Values appends a row of values, one per column.
+func (b *upsertBuilder) Values(values ...any) UpsertBuilder {
+	b.inner.Values(values...)
+	return b
+}
+
+// OnConflict appends columns to the conflict target.
+func (b *upsertBuilder) OnConflict(columns ...string) UpsertBuilder {
+	b.inner.OnConflict(columns...)
+	return b
+}
+
+// DoUpdateSet appends assignments applied on conflict.
+func (b *upsertBuilder) DoUpdateSet(assignments ...Assignment) UpsertBuilder {
+	b.inner.DoUpdateSet(assignments...)
+	return b
+}
+
+// FromStruct sets the rows, conflict target and assignments from tagged
+// structs.
+func (b *upsertBuilder) FromStruct(v any) UpsertBuilder {
+	b.inner.FromStruct(v)
+	return b
+}
+
+// Returning appends columns to the RETURNING clause.
+func (b *upsertBuilder) Returning(columns ...string) UpsertBuilder {
+	b.inner.Returning(columns...)
+	return b
+}
+
+// Build renders the statement for the dialect given to New.
+func (b *upsertBuilder) Build() (string, []any, error) {
+	return b.inner.Build()
+}
+
+// BuildFor renders the statement for d; a nil d renders as Build.
+func (b *upsertBuilder) BuildFor(d dialect.SQLDialect) (string, []any, error) {
+	return b.inner.BuildFor(d)
+}
+
+// BuildBatches renders one statement per batch of rows.
+func (b *upsertBuilder) BuildBatches() ([]Statement, error) {
+	return b.inner.BuildBatches()
+}
+
+// Dialect returns the dialect given to New, or nil.
+func (b *upsertBuilder) Dialect() dialect.SQLDialect {
+	return b.dialect
+}
+
+// Debug returns a developer-facing summary of the builder state.
+func (b *upsertBuilder) Debug() string {
+	return b.inner.Debug()
+}
@@ -16,6 +16,7 @@
 //   - Renderable: canonical SQL output, dialect-aware
 //   - Stringable: human-facing representation for logs and audits
 //   - Validable: structural validation
+//   - Node / Parent: query tree walked and rewritten by token.Walk and token.Rewrite
 //
 // Example:
 //
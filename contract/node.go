@@ -0,0 +1,41 @@
+// File: db/contract/node.go
+//
+// Node and Parent define the tree formed by builders and tokens, walked
+// and rewritten by token.Walk and token.Rewrite. See package-level
+// documentation in doc.go for an overview of all contracts.
+
+package contract
+
+// Node is a contract for tokens and builders seen as nodes of a query
+// tree: a SelectBuilder holds its fields, table, joins, conditions and
+// GROUP BY, HAVING and ORDER BY items; a join holds its tables; fields,
+// tables and conditions are leaves.
+//
+// Node pairs with Kindable: visitors switch on the type of a node, then
+// on its kind.
+//
+// Example:
+//
+//	token.Walk(sb, func(n contract.Node) bool {
+//	    if t, ok := n.(table.Token); ok {
+//	        fmt.Println(t.Name())
+//	    }
+//	    return true
+//	})
+type Node interface {
+	// Children returns the direct children of the node, in rendering
+	// order, or nil for a leaf.
+	Children() []Node
+}
+
+// Parent is a contract for nodes whose children can be replaced, as done
+// by token.Rewrite.
+type Parent interface {
+	Node
+
+	// WithChildren returns a copy of the node whose children are replaced
+	// by children, matched one to one with Children(). The node itself is
+	// unchanged. Nil children are removed where the node allows it;
+	// children of the wrong type yield errored tokens.
+	WithChildren(children []Node) Node
+}
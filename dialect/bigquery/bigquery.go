@@ -0,0 +1,293 @@
+package bigquery
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+)
+
+//
+// BigQuery Dialect
+//
+
+// dialectImpl provides the Google BigQuery (GoogleSQL) implementation of the
+// dialect.SQLDialect interface. It is unexported to prevent direct
+// instantiation; consumers should always use the New() constructor.
+//
+// Key behaviors:
+//   - Identifiers are quoted using backticks (`) only when required, with
+//     embedded backticks escaped by a backslash. Dotted paths such as
+//     project.dataset.table are quoted part by part.
+//   - Placeholders are positional "?" or named "@name".
+//   - Pagination uses LIMIT n OFFSET m; OFFSET always requires a LIMIT.
+//   - QUALIFY and TABLESAMPLE are supported.
+//   - Upserts are rendered as MERGE; RETURNING is not supported.
+type dialectImpl struct {
+	opts dialect.Options
+}
+
+// Compile-time checks: ensure dialectImpl implements SQLDialect and the
+// optional capabilities it advertises.
+var (
+	_ dialect.SQLDialect     = (*dialectImpl)(nil)
+	_ dialect.NamedBinder    = (*dialectImpl)(nil)
+	_ dialect.Qualifier      = (*dialectImpl)(nil)
+	_ dialect.Sampler        = (*dialectImpl)(nil)
+	_ dialect.Merger         = (*dialectImpl)(nil)
+	_ dialect.Keyworder      = (*dialectImpl)(nil)
+	_ dialect.FunctionMapper = (*dialectImpl)(nil)
+	_ dialect.BinaryQuoter   = (*dialectImpl)(nil)
+	_ dialect.TimeQuoter     = (*dialectImpl)(nil)
+	_ dialect.Savepointer    = (*dialectImpl)(nil)
+)
+
+// bareIdentifier matches identifiers that can be emitted without quotes.
+var bareIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+//
+// Constructors
+//
+
+// New returns a BigQuery dialect. The returned value implements the
+// dialect.SQLDialect interface.
+//
+// Example:
+//
+//	d := bigquery.New()
+//	d.QuoteIdentifier("my-project.sales.orders") // → `my-project`.sales.orders
+func New() dialect.SQLDialect {
+	return &dialectImpl{
+		opts: dialect.Options{
+			Name:                    "bigquery",
+			QuoteStyle:              "`",
+			CaseFolding:             dialect.FoldNone,
+			PlaceholderStyle:        "?",
+			AllowMerge:              true,
+			AllowUpsert:             false,
+			ForcedAliasing:          true,
+			EnableReturning:         false,
+			SupportsCTE:             true,
+			SupportsWindowFunctions: true,
+			SupportsNullsOrdering:   true,
+			SupportsILike:           false,
+			SupportsArrayBinding:    false,
+			SupportsValuesList:      false,
+			MaxPlaceholderIndex:     10000,
+			MaxIdentifierLength:     300,
+		},
+	}
+}
+
+// NewWithOptions creates a BigQuery dialect with the given static options.
+// Options are immutable once the dialect is constructed.
+func NewWithOptions(opts dialect.Options) dialect.SQLDialect {
+	return &dialectImpl{opts: opts}
+}
+
+//
+// Interface Implementation
+//
+
+// Name returns the identifier of this dialect, "bigquery" by default.
+func (d *dialectImpl) Name() string {
+	return d.opts.Name
+}
+
+// Options returns the feature capability matrix for the BigQuery dialect.
+func (d *dialectImpl) Options() dialect.Options {
+	return d.opts
+}
+
+// QuoteIdentifier returns a BigQuery identifier. Dotted paths are quoted
+// part by part. Simple names are returned as-is unless reserved; anything
+// else is wrapped in backticks, with embedded backticks and backslashes
+// escaped by a backslash. Options().QuotePolicy can force or disable
+// quoting.
+//
+// Example:
+//
+//	d.QuoteIdentifier("orders")               // → orders
+//	d.QuoteIdentifier("my-project.ds.orders") // → `my-project`.ds.orders
+func (d *dialectImpl) QuoteIdentifier(name string) string {
+	q := dialect.NewQuoter(d.opts, bareIdentifier.MatchString)
+	q.Backslash = true
+	q.Reserved = keywords.IsReserved
+	return q.Quote(name)
+}
+
+// Keywords returns the GoogleSQL reserved and non-reserved keywords.
+func (d *dialectImpl) Keywords() dialect.Keywords {
+	return keywords
+}
+
+// Functions returns the GoogleSQL spellings of canonical functions:
+// CURRENT_TIMESTAMP(), CONCAT, SUBSTR, LENGTH and TIMESTAMP_TRUNC with an
+// unquoted unit. GoogleSQL has no || on every type, so CONCAT is used.
+func (d *dialectImpl) Functions() dialect.FunctionMap {
+	return dialect.FunctionMap{
+		dialect.FuncNow:       dialect.Call("CURRENT_TIMESTAMP"),
+		dialect.FuncConcat:    dialect.Call("CONCAT"),
+		dialect.FuncSubstring: dialect.Call("SUBSTR"),
+		dialect.FuncLength:    dialect.Call("LENGTH"),
+		dialect.FuncDateTrunc: func(args []string) (string, error) {
+			return fmt.Sprintf("TIMESTAMP_TRUNC(%s, %s)", args[1], strings.ToUpper(args[0])), nil
+		},
+	}
+}
+
+// QuoteLiteral quotes a literal value for inline use in BigQuery SQL.
+//
+// Supported types:
+//   - nil       → NULL
+//   - string    → wrapped in single quotes, with quotes and backslashes
+//     escaped by a backslash
+//   - bool      → TRUE or FALSE
+//   - numbers   → rendered in decimal form
+//   - time.Time → TIMESTAMP 'YYYY-MM-DD HH:MM:SS UTC'
+//   - []byte    → FROM_HEX('..')
+//   - others    → dialect.MustLiteral
+func (d *dialectImpl) QuoteLiteral(literal any) string {
+	switch v := literal.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return quoteString(v)
+	case bool:
+		if v {
+			return "TRUE"
+		}
+		return "FALSE"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 64)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []byte:
+		return d.QuoteBinary(v)
+	case time.Time:
+		return dialect.QuoteTime(d, v)
+	default:
+		return dialect.MustLiteral(d, v)
+	}
+}
+
+// QuoteBinary renders data as BYTES decoded from hexadecimal.
+//
+// Example:
+//
+//	d.QuoteBinary([]byte("hi")) // → FROM_HEX('6869')
+func (d *dialectImpl) QuoteBinary(data []byte) string {
+	return "FROM_HEX('" + hex.EncodeToString(data) + "')"
+}
+
+// QuoteTime renders t as a TIMESTAMP literal with an explicit UTC zone.
+//
+// Example:
+//
+//	d.QuoteTime(t) // → TIMESTAMP '2025-09-19 03:30:00 UTC'
+func (d *dialectImpl) QuoteTime(t time.Time) string {
+	return fmt.Sprintf("TIMESTAMP '%s UTC'", t.UTC().Format(dialect.TimestampLayout))
+}
+
+// PaginationSyntax renders the BigQuery LIMIT/OFFSET clause. OFFSET is
+// only valid after LIMIT, so offset-only pagination uses the largest
+// INT64 as limit.
+//
+// Example:
+//
+//	d.PaginationSyntax(10, 20) // → " LIMIT 10 OFFSET 20"
+//	d.PaginationSyntax(0, 20)  // → " LIMIT 9223372036854775807 OFFSET 20"
+func (d *dialectImpl) PaginationSyntax(limit, offset int) string {
+	switch {
+	case limit > 0 && offset > 0:
+		return fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+	case limit > 0:
+		return fmt.Sprintf(" LIMIT %d", limit)
+	case offset > 0:
+		return fmt.Sprintf(" LIMIT %d OFFSET %d", int64(math.MaxInt64), offset)
+	default:
+		return ""
+	}
+}
+
+// Placeholder always returns "?" for BigQuery positional parameters. The
+// index parameter is ignored since positional markers are not numbered.
+func (d *dialectImpl) Placeholder(_ int) string {
+	return d.opts.PlaceholderStyle
+}
+
+// PlaceholderNamed returns a BigQuery named parameter.
+//
+// Example:
+//
+//	d.PlaceholderNamed("id") // → @id
+func (d *dialectImpl) PlaceholderNamed(name string) string {
+	return "@" + name
+}
+
+// QualifySyntax renders the QUALIFY clause.
+//
+// Example:
+//
+//	d.QualifySyntax("rn = 1") // → QUALIFY rn = 1
+func (d *dialectImpl) QualifySyntax(condition string) string {
+	if condition == "" {
+		return ""
+	}
+	return "QUALIFY " + condition
+}
+
+// SampleSyntax renders the block-based TABLESAMPLE clause.
+//
+// Example:
+//
+//	d.SampleSyntax(10) // → TABLESAMPLE SYSTEM (10 PERCENT)
+func (d *dialectImpl) SampleSyntax(percent float64) string {
+	if percent <= 0 || percent > 100 {
+		return ""
+	}
+	return "TABLESAMPLE SYSTEM (" + strconv.FormatFloat(percent, 'f', -1, 64) + " PERCENT)"
+}
+
+// MergeSyntax renders an upsert as a MERGE statement reading a single row
+// of parameter markers from an inline SELECT. BigQuery does not allow the
+// target alias on the left side of UPDATE SET assignments.
+//
+// Example:
+//
+//	d.MergeSyntax("users", []string{"id", "name"}, []string{"id"})
+//	// MERGE INTO users tgt USING (SELECT ? AS id, ? AS name) src
+//	//   ON (tgt.id = src.id)
+//	//   WHEN MATCHED THEN UPDATE SET name = src.name
+//	//   WHEN NOT MATCHED THEN INSERT (id, name) VALUES (src.id, src.name)
+func (d *dialectImpl) MergeSyntax(table string, columns, keys []string) string {
+	return dialect.Merge(d, table, columns, keys, dialect.MergeStyle{Source: dialect.MergeSelect(""), UnqualifiedSet: true})
+}
+
+// quoteString wraps s in single quotes, escaping backslashes and quotes
+// with a backslash as GoogleSQL string literals require.
+func quoteString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
+
+// SavepointSyntax returns an empty string: BigQuery has no savepoints.
+func (d *dialectImpl) SavepointSyntax(string) string {
+	return ""
+}
+
+// ReleaseSavepointSyntax returns an empty string: BigQuery has no savepoints.
+func (d *dialectImpl) ReleaseSavepointSyntax(string) string {
+	return ""
+}
+
+// RollbackToSavepointSyntax returns an empty string: BigQuery has no savepoints.
+func (d *dialectImpl) RollbackToSavepointSyntax(string) string {
+	return ""
+}
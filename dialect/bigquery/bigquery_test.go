@@ -0,0 +1,123 @@
+package bigquery_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/bigquery"
+)
+
+func TestBigQueryDialect(t *testing.T) {
+	d := bigquery.New()
+
+	t.Run("Name", func(t *testing.T) {
+		if got, want := d.Name(), "bigquery"; got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+		if got := bigquery.NewWithOptions(dialect.Options{Name: "googlesql"}).Name(); got != "googlesql" {
+			t.Errorf("expected %q, got %q", "googlesql", got)
+		}
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		opts := d.Options()
+		if !opts.AllowMerge {
+			t.Error("expected AllowMerge=true")
+		}
+		if opts.AllowUpsert || opts.EnableReturning {
+			t.Error("expected UPSERT and RETURNING to be disabled")
+		}
+		if opts.QuoteStyle != "`" {
+			t.Errorf("unexpected QuoteStyle = %q", opts.QuoteStyle)
+		}
+	})
+
+	t.Run("QuoteIdentifier", func(t *testing.T) {
+		cases := []struct {
+			in   string
+			want string
+		}{
+			{"orders", "orders"},
+			{"OrderItems", "OrderItems"},
+			{"my-project.sales.orders", "`my-project`.sales.orders"},
+			{"sales.select", "sales.`select`"},
+			{"odd`name", "`odd\\`name`"},
+			{"", ""},
+		}
+		for _, c := range cases {
+			if got := d.QuoteIdentifier(c.in); got != c.want {
+				t.Errorf("QuoteIdentifier(%q) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("QuoteLiteral", func(t *testing.T) {
+		now := time.Date(2025, 9, 19, 3, 30, 0, 0, time.UTC)
+		cases := []struct {
+			in   any
+			want string
+		}{
+			{nil, "NULL"},
+			{"O'Reilly", `'O\'Reilly'`},
+			{`C:\tmp`, `'C:\\tmp'`},
+			{true, "TRUE"},
+			{false, "FALSE"},
+			{int32(42), "42"},
+			{float32(2.5), "2.5"},
+			{0.25, "0.25"},
+			{now, "TIMESTAMP '2025-09-19 03:30:00 UTC'"},
+			{now.Add(500 * time.Millisecond), "TIMESTAMP '2025-09-19 03:30:00.5 UTC'"},
+			{[]byte("hi"), "FROM_HEX('6869')"},
+		}
+		for _, c := range cases {
+			if got := d.QuoteLiteral(c.in); got != c.want {
+				t.Errorf("QuoteLiteral(%v) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("Placeholder", func(t *testing.T) {
+		if got := d.Placeholder(3); got != "?" {
+			t.Errorf("Placeholder(3) = %q, want '?'", got)
+		}
+		if got := d.(dialect.NamedBinder).PlaceholderNamed("id"); got != "@id" {
+			t.Errorf("PlaceholderNamed(id) = %q, want '@id'", got)
+		}
+	})
+
+	t.Run("Golden", func(t *testing.T) {
+		q := d.(dialect.Qualifier)
+		s := d.(dialect.Sampler)
+		m := d.(dialect.Merger)
+		cases := []struct {
+			name string
+			got  string
+			want string
+		}{
+			{"paginate", dialect.Paginate(d, "SELECT id FROM orders", 10, 20),
+				"SELECT id FROM orders LIMIT 10 OFFSET 20"},
+			{"paginate offset", dialect.Paginate(d, "SELECT id FROM orders", 0, 20),
+				"SELECT id FROM orders LIMIT 9223372036854775807 OFFSET 20"},
+			{"qualify", "SELECT id FROM orders " + q.QualifySyntax("rn = 1"),
+				"SELECT id FROM orders QUALIFY rn = 1"},
+			{"qualify empty", q.QualifySyntax(""), ""},
+			{"sample", "SELECT * FROM `my-project.sales.orders` " + s.SampleSyntax(10),
+				"SELECT * FROM `my-project.sales.orders` TABLESAMPLE SYSTEM (10 PERCENT)"},
+			{"sample out of range", s.SampleSyntax(101), ""},
+			{"merge", m.MergeSyntax("users", []string{"id", "name"}, []string{"id"}),
+				"MERGE INTO users tgt USING (SELECT ? AS id, ? AS name) src ON (tgt.id = src.id)" +
+					" WHEN MATCHED THEN UPDATE SET name = src.name" +
+					" WHEN NOT MATCHED THEN INSERT (id, name) VALUES (src.id, src.name)"},
+			{"merge keys only", m.MergeSyntax("tags", []string{"id"}, []string{"id"}),
+				"MERGE INTO tags tgt USING (SELECT ? AS id) src ON (tgt.id = src.id)" +
+					" WHEN NOT MATCHED THEN INSERT (id) VALUES (src.id)"},
+			{"merge invalid", m.MergeSyntax("", []string{"id"}, []string{"id"}), ""},
+		}
+		for _, c := range cases {
+			if c.got != c.want {
+				t.Errorf("%s:\n got %q\nwant %q", c.name, c.got, c.want)
+			}
+		}
+	})
+}
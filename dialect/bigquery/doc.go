@@ -0,0 +1,31 @@
+/*
+Package bigquery provides the Google BigQuery (GoogleSQL) dialect
+implementation.
+
+# Overview
+
+The BigQuery dialect renders SQL following GoogleSQL rules:
+
+  - Identifiers are wrapped in backticks when required, with backticks
+    and backslashes escaped by a backslash. Dotted paths such as
+    project.dataset.table are quoted part by part.
+  - String literals escape quotes and backslashes with a backslash.
+  - Placeholders are positional (?) or named (@name).
+  - Pagination uses LIMIT n OFFSET m; OFFSET always requires a LIMIT.
+  - QUALIFY and TABLESAMPLE are supported.
+  - Upserts are rendered as MERGE; RETURNING is not available.
+
+# Usage
+
+	d := bigquery.New()
+	s := d.(dialect.Sampler)
+	sql := "SELECT * FROM orders " + s.SampleSyntax(10)
+	// SELECT * FROM orders TABLESAMPLE SYSTEM (10 PERCENT)
+
+# Capabilities
+
+Besides dialect.SQLDialect, the BigQuery dialect implements the optional
+capability interfaces NamedBinder, Qualifier, Sampler and Merger declared
+in the dialect package.
+*/
+package bigquery
@@ -0,0 +1,24 @@
+package bigquery_test
+
+import (
+	"fmt"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/bigquery"
+)
+
+func Example() {
+	d := bigquery.New()
+	fmt.Println(d.QuoteIdentifier("my-project.sales.orders"))
+	fmt.Println(d.(dialect.NamedBinder).PlaceholderNamed("id"))
+	// Output:
+	// `my-project`.sales.orders
+	// @id
+}
+
+func Example_sample() {
+	d := bigquery.New().(dialect.Sampler)
+	fmt.Println("SELECT * FROM orders " + d.SampleSyntax(10))
+	// Output:
+	// SELECT * FROM orders TABLESAMPLE SYSTEM (10 PERCENT)
+}
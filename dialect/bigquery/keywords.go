@@ -0,0 +1,31 @@
+package bigquery
+
+import "github.com/entiqon/db/dialect"
+
+// keywords holds the GoogleSQL reserved keywords and a selection of the
+// non-reserved keywords BigQuery accepts as identifiers.
+var keywords = dialect.NewKeywords(
+	[]string{
+		"ALL", "AND", "ANY", "ARRAY", "AS", "ASC", "ASSERT_ROWS_MODIFIED",
+		"AT", "BETWEEN", "BY", "CASE", "CAST", "COLLATE", "CONTAINS",
+		"CREATE", "CROSS", "CUBE", "CURRENT", "DEFAULT", "DEFINE", "DESC",
+		"DISTINCT", "ELSE", "END", "ENUM", "ESCAPE", "EXCEPT", "EXCLUDE",
+		"EXISTS", "EXTRACT", "FALSE", "FETCH", "FOLLOWING", "FOR", "FROM",
+		"FULL", "GROUP", "GROUPING", "GROUPS", "HASH", "HAVING", "IF",
+		"IGNORE", "IN", "INNER", "INTERSECT", "INTERVAL", "INTO", "IS", "JOIN",
+		"LATERAL", "LEFT", "LIKE", "LIMIT", "LOOKUP", "MERGE", "NATURAL",
+		"NEW", "NO", "NOT", "NULL", "NULLS", "OF", "ON", "OR", "ORDER",
+		"OUTER", "OVER", "PARTITION", "PRECEDING", "PROTO", "QUALIFY",
+		"RANGE", "RECURSIVE", "RESPECT", "RIGHT", "ROLLUP", "ROWS", "SELECT",
+		"SET", "SOME", "STRUCT", "TABLESAMPLE", "THEN", "TO", "TREAT", "TRUE",
+		"UNBOUNDED", "UNION", "UNNEST", "USING", "WHEN", "WHERE", "WINDOW",
+		"WITH", "WITHIN",
+	},
+	[]string{
+		"BEGIN", "CLUSTER", "COLUMN", "DATA", "DATE", "DELETE", "DROP",
+		"EXECUTE", "FUNCTION", "INSERT", "KEY", "LEVEL", "MATCHED", "NAME",
+		"OFFSET", "OPTIONS", "PERCENT", "PIVOT", "REPLACE", "RETURNS", "SIZE",
+		"SYSTEM", "SYSTEM_TIME", "TABLE", "TARGET", "TEMP", "TIMESTAMP",
+		"TRUNCATE", "UNPIVOT", "UPDATE", "VALUE", "VALUES", "VIEW",
+	},
+)
@@ -0,0 +1,206 @@
+package dialect
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Paginator is implemented by dialects whose pagination cannot be expressed
+// as a trailing clause appended to the statement.
+//
+// Oracle 11g (ROWNUM wrapping), Firebird (FIRST/SKIP) and Informix
+// (SKIP/FIRST) rewrite the statement itself instead of appending to it.
+// Builders should call the package-level Paginate helper, which delegates
+// to this interface when available and falls back to PaginationSyntax.
+type Paginator interface {
+	// Paginate returns query rewritten to apply limit and offset.
+	// Non-positive values mean "not set".
+	Paginate(query string, limit, offset int) string
+}
+
+// RowNumberColumn is the column that row-numbering pagination wrappers
+// (Oracle before 12c) append to the projection of the paginated query.
+// Result scanners skip it when it comes last.
+const RowNumberColumn = "rn_"
+
+// TableAliaser is implemented by dialects with non-standard table alias
+// rules, such as Oracle, which rejects the AS keyword in FROM clauses.
+type TableAliaser interface {
+	// AliasTable renders name followed by alias using the dialect rules.
+	AliasTable(name, alias string) string
+}
+
+// IdentifierValidator is implemented by dialects that enforce vendor limits
+// on identifiers, such as maximum length.
+type IdentifierValidator interface {
+	// ValidateIdentifier returns nil if name is acceptable to the dialect.
+	ValidateIdentifier(name string) error
+}
+
+// NamedBinder is implemented by dialects supporting named bind variables
+// (e.g. ":name" in Oracle).
+type NamedBinder interface {
+	// PlaceholderNamed returns the bind placeholder for the given name.
+	PlaceholderNamed(name string) string
+}
+
+// Returner is implemented by dialects that can return values produced by
+// INSERT, UPDATE or DELETE statements.
+type Returner interface {
+	// ReturningSyntax renders the clause returning the given columns.
+	//
+	// nextIndex is the index of the next free positional placeholder; it is
+	// used by dialects such as Oracle that return values through out-binds
+	// (RETURNING ... INTO :n). Dialects returning a result set ignore it.
+	ReturningSyntax(columns []string, nextIndex int) string
+}
+
+// Merger is implemented by dialects able to render an upsert as a MERGE
+// statement.
+type Merger interface {
+	// MergeSyntax renders a MERGE statement that inserts a row of columns
+	// into table, or updates the non-key columns when a row matching keys
+	// already exists. Values are bound positionally in column order.
+	MergeSyntax(table string, columns, keys []string) string
+}
+
+// Upserter is implemented by dialects offering a native single-statement
+// upsert other than MERGE, such as Firebird's UPDATE OR INSERT ... MATCHING
+// or PostgreSQL's INSERT ... ON CONFLICT.
+type Upserter interface {
+	// UpsertSyntax renders an upsert of a row of columns into table, keyed
+	// by keys. Values are bound positionally in column order.
+	UpsertSyntax(table string, columns, keys []string) string
+}
+
+// Qualifier is implemented by dialects supporting the QUALIFY clause, which
+// filters rows on the result of window functions (Snowflake, BigQuery,
+// ClickHouse, Redshift).
+type Qualifier interface {
+	// QualifySyntax renders the QUALIFY clause for the given condition.
+	QualifySyntax(condition string) string
+}
+
+// Sampler is implemented by dialects able to read a random sample of a
+// table, through SAMPLE or TABLESAMPLE clauses placed after the table
+// reference.
+type Sampler interface {
+	// SampleSyntax renders the sampling clause for the given percentage of
+	// rows, between 0 and 100. Values out of range yield an empty string.
+	SampleSyntax(percent float64) string
+}
+
+// GroupLimiter is implemented by dialects able to limit the number of rows
+// returned per group of column values, such as ClickHouse's LIMIT n BY.
+type GroupLimiter interface {
+	// LimitBySyntax renders a per-group limit over the given columns.
+	// Non-positive values mean "not set".
+	LimitBySyntax(limit, offset int, columns []string) string
+}
+
+// BinaryQuoter is implemented by dialects whose binary literals differ from
+// the SQL standard X'..' form, such as Oracle's HEXTORAW('..').
+type BinaryQuoter interface {
+	// QuoteBinary renders data as a binary literal. It returns an empty
+	// string when the dialect has no binary literal.
+	QuoteBinary(data []byte) string
+}
+
+// TimeQuoter is implemented by dialects whose timestamp literals differ
+// from the SQL standard TIMESTAMP '..' form, such as Informix DATETIME.
+type TimeQuoter interface {
+	// QuoteTime renders t as a typed timestamp literal.
+	QuoteTime(t time.Time) string
+}
+
+// FunctionMapper is implemented by dialects spelling canonical functions
+// (FuncCoalesce, FuncSubstring, ...) differently from StandardFunctions.
+// Builders should call the package-level RenderFunction helper, which also
+// honors Options.Functions.
+type FunctionMapper interface {
+	// Functions returns the dialect's translations. Functions missing
+	// from the map fall back to StandardFunctions; nil entries are
+	// unsupported.
+	Functions() FunctionMap
+}
+
+// Keyworder is implemented by dialects carrying their own reserved and
+// non-reserved keyword sets. A word reserved in one vendor, such as LEVEL in
+// Oracle, may be a valid bare identifier in another. Use KeywordsOf to fall
+// back to SQL2016 for dialects without one.
+type Keyworder interface {
+	// Keywords returns the keyword set of the dialect.
+	Keywords() Keywords
+}
+
+// Commenter is implemented by dialects that do not accept a comment at the
+// end of every statement, such as ClickHouse, which reads what follows the
+// FORMAT clause of an INSERT as data. Builders should call the
+// package-level Comment helper, which delegates to this interface.
+type Commenter interface {
+	// Comment returns query with comment placed where the dialect accepts
+	// it.
+	Comment(query, comment string) string
+}
+
+// Paginate applies limit and offset to query using the rules of d.
+//
+// If d implements Paginator, the rewrite is delegated to it; otherwise the
+// result of PaginationSyntax is appended to query. Leading whitespace
+// returned by PaginationSyntax is normalized to a single space.
+//
+// Example:
+//
+//	dialect.Paginate(generic.New(), "SELECT id FROM users", 10, 20)
+//	// SELECT id FROM users LIMIT 10 OFFSET 20
+func Paginate(d SQLDialect, query string, limit, offset int) string {
+	if p, ok := d.(Paginator); ok {
+		return p.Paginate(query, limit, offset)
+	}
+	clause := strings.TrimSpace(d.PaginationSyntax(limit, offset))
+	if clause == "" {
+		return query
+	}
+	return query + " " + clause
+}
+
+// AliasTable renders a table reference with its alias using the rules of d.
+//
+// If d implements TableAliaser, rendering is delegated to it. Otherwise
+// the alias is introduced with AS when Options().ForcedAliasing is set,
+// and separated by a single space when it is not. An empty alias returns
+// name unchanged.
+func AliasTable(d SQLDialect, name, alias string) string {
+	if alias == "" {
+		return name
+	}
+	if a, ok := d.(TableAliaser); ok {
+		return a.AliasTable(name, alias)
+	}
+	if d.Options().ForcedAliasing {
+		return fmt.Sprintf("%s AS %s", name, alias)
+	}
+	return fmt.Sprintf("%s %s", name, alias)
+}
+
+// PrefixSelect inserts clause right after the leading SELECT keyword of
+// query, as required by dialects paginating with FIRST/SKIP (Firebird,
+// Informix). Statements not starting with SELECT, such as those opening
+// with a CTE, are wrapped in a derived table first.
+//
+// Example:
+//
+//	dialect.PrefixSelect("SELECT DISTINCT id FROM users", "FIRST 10")
+//	// SELECT FIRST 10 DISTINCT id FROM users
+func PrefixSelect(query, clause string) string {
+	if clause == "" {
+		return query
+	}
+	trimmed := strings.TrimSpace(query)
+	if len(trimmed) >= 6 && strings.EqualFold(trimmed[:6], "SELECT") &&
+		(len(trimmed) == 6 || trimmed[6] == ' ' || trimmed[6] == '\n' || trimmed[6] == '\t') {
+		return trimmed[:6] + " " + clause + trimmed[6:]
+	}
+	return fmt.Sprintf("SELECT %s * FROM (%s) q_", clause, trimmed)
+}
@@ -0,0 +1,60 @@
+package dialect_test
+
+import (
+	"testing"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/generic"
+)
+
+func TestCapabilities(t *testing.T) {
+	t.Run("Paginate", func(t *testing.T) {
+		d := generic.New()
+		cases := []struct {
+			limit, offset int
+			want          string
+		}{
+			{0, 0, "SELECT id FROM users"},
+			{10, 0, "SELECT id FROM users LIMIT 10"},
+			{10, 20, "SELECT id FROM users LIMIT 10 OFFSET 20"},
+		}
+		for _, c := range cases {
+			if got := dialect.Paginate(d, "SELECT id FROM users", c.limit, c.offset); got != c.want {
+				t.Errorf("Paginate(%d,%d) = %q, want %q", c.limit, c.offset, got, c.want)
+			}
+		}
+	})
+
+	t.Run("AliasTable", func(t *testing.T) {
+		forced := generic.New()
+		if got := dialect.AliasTable(forced, "users", "u"); got != "users AS u" {
+			t.Errorf("expected %q, got %q", "users AS u", got)
+		}
+		if got := dialect.AliasTable(forced, "users", ""); got != "users" {
+			t.Errorf("expected %q, got %q", "users", got)
+		}
+
+		implicit := generic.NewWithOptions(dialect.Options{Name: "implicit"})
+		if got := dialect.AliasTable(implicit, "users", "u"); got != "users u" {
+			t.Errorf("expected %q, got %q", "users u", got)
+		}
+	})
+	t.Run("PrefixSelect", func(t *testing.T) {
+		cases := []struct {
+			query, clause string
+			want          string
+		}{
+			{"SELECT id FROM users", "FIRST 10", "SELECT FIRST 10 id FROM users"},
+			{"select\nid from users", "SKIP 5", "select SKIP 5\nid from users"},
+			{"  SELECT id FROM users  ", "FIRST 1", "SELECT FIRST 1 id FROM users"},
+			{"SELECT id FROM users", "", "SELECT id FROM users"},
+			{"WITH t AS (SELECT 1) SELECT * FROM t", "FIRST 1", "SELECT FIRST 1 * FROM (WITH t AS (SELECT 1) SELECT * FROM t) q_"},
+			{"SELECTION", "FIRST 1", "SELECT FIRST 1 * FROM (SELECTION) q_"},
+		}
+		for _, c := range cases {
+			if got := dialect.PrefixSelect(c.query, c.clause); got != c.want {
+				t.Errorf("PrefixSelect(%q, %q) = %q, want %q", c.query, c.clause, got, c.want)
+			}
+		}
+	})
+}
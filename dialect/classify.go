@@ -0,0 +1,188 @@
+package dialect
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+
+	dberrors "github.com/entiqon/db/errors"
+)
+
+// ErrorCodes maps SQLSTATE or vendor error codes to the classes of the
+// errors package (dberrors.ErrUniqueViolation, ...). Vendor codes are
+// written in decimal: "1062", "-803", "335544665".
+type ErrorCodes map[string]error
+
+// ErrorClassifier is implemented by dialects whose drivers report vendor
+// error codes, such as Oracle's ORA-00001 or Db2's SQLCODE -803. Use
+// ClassifyError, which also honors Options.ErrorCodes and falls back to
+// StandardErrorCodes.
+type ErrorClassifier interface {
+	// ErrorCodes returns the dialect's vendor codes.
+	ErrorCodes() ErrorCodes
+}
+
+// StandardErrorCodes classifies SQL standard SQLSTATE values, including
+// the PostgreSQL-specific ones also used by Redshift and CockroachDB. It
+// applies to every dialect after its own codes.
+var StandardErrorCodes = ErrorCodes{
+	"23505": dberrors.ErrUniqueViolation,
+	"23503": dberrors.ErrForeignKeyViolation,
+	"23502": dberrors.ErrNotNullViolation,
+	"40001": dberrors.ErrSerialization,
+	"40P01": dberrors.ErrDeadlock,
+	"55P03": dberrors.ErrLockTimeout,
+	"57014": dberrors.ErrQueryCanceled,
+}
+
+// MySQLErrorCodes classifies MySQL and MariaDB server error numbers. The
+// mysql dialect uses them; pass them to Options.WithErrorCodes for another
+// dialect used with a MySQL driver.
+var MySQLErrorCodes = ErrorCodes{
+	"1062": dberrors.ErrUniqueViolation,     // ER_DUP_ENTRY
+	"1451": dberrors.ErrForeignKeyViolation, // ER_ROW_IS_REFERENCED_2
+	"1452": dberrors.ErrForeignKeyViolation, // ER_NO_REFERENCED_ROW_2
+	"1048": dberrors.ErrNotNullViolation,    // ER_BAD_NULL_ERROR
+	"1213": dberrors.ErrDeadlock,            // ER_LOCK_DEADLOCK
+	"1205": dberrors.ErrLockTimeout,         // ER_LOCK_WAIT_TIMEOUT
+	"1317": dberrors.ErrQueryCanceled,       // ER_QUERY_INTERRUPTED
+	"3024": dberrors.ErrQueryCanceled,       // ER_QUERY_TIMEOUT
+}
+
+// SQLServerErrorCodes classifies SQL Server error numbers. Pass it as
+// Options.WithErrorCodes for a dialect used with a SQL Server driver.
+var SQLServerErrorCodes = ErrorCodes{
+	"2627": dberrors.ErrUniqueViolation,     // unique constraint
+	"2601": dberrors.ErrUniqueViolation,     // unique index
+	"547":  dberrors.ErrForeignKeyViolation, // constraint conflict
+	"515":  dberrors.ErrNotNullViolation,    // cannot insert NULL
+	"3960": dberrors.ErrSerialization,       // snapshot update conflict
+	"1205": dberrors.ErrDeadlock,            // deadlock victim
+	"1222": dberrors.ErrLockTimeout,         // lock request timeout
+}
+
+// ClassifyError wraps err in a *dberrors.DriverError when one of its codes
+// is known to d, so errors.Is(err, dberrors.ErrUniqueViolation) and the
+// other classes hold. Codes are looked up in Options.ErrorCodes, then in
+// the dialect's own codes (ErrorClassifier), then in StandardErrorCodes;
+// a nil d uses StandardErrorCodes only. Context cancellation is classified
+// as dberrors.ErrQueryCanceled.
+//
+// err is returned unchanged when it is nil, already classified or
+// unknown. Codes are read from the driver error without importing driver
+// packages; see DriverCodes.
+//
+// Example:
+//
+//	err = dialect.ClassifyError(oracle.New(), err) // ORA-00001
+//	errors.Is(err, dberrors.ErrUniqueViolation)   // true
+func ClassifyError(d SQLDialect, err error) error {
+	if err == nil {
+		return nil
+	}
+	var classified *dberrors.DriverError
+	if errors.As(err, &classified) {
+		return err
+	}
+
+	var tables []ErrorCodes
+	if d != nil {
+		tables = append(tables, d.Options().ErrorCodes())
+		if c, ok := d.(ErrorClassifier); ok {
+			tables = append(tables, c.ErrorCodes())
+		}
+	}
+	tables = append(tables, StandardErrorCodes)
+
+	codes := DriverCodes(err)
+	for _, table := range tables {
+		for _, code := range codes {
+			if kind := table[code]; kind != nil {
+				return &dberrors.DriverError{Kind: kind, Code: code, Err: err}
+			}
+		}
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return &dberrors.DriverError{Kind: dberrors.ErrQueryCanceled, Err: err}
+	}
+	return err
+}
+
+// DriverCodes returns the codes carried by err and the errors it wraps,
+// vendor codes first, then SQLSTATE values. They are read, without
+// importing driver packages, from:
+//   - SQLState() and SQLErrorNumber() methods (pgx, lib/pq, go-mssqldb)
+//   - Code() int methods (godror)
+//   - Number, ErrCode and SQLCode integer fields (go-sql-driver/mysql,
+//     gosnowflake, go-ora), and Code fields (clickhouse-go numbers, pgconn
+//     SQLSTATE strings)
+//   - SQLState string or [5]byte fields (go-sql-driver/mysql, gosnowflake)
+func DriverCodes(err error) []string {
+	var vendor, states []string
+	seen := map[string]bool{}
+	add := func(list *[]string, code string) {
+		code = strings.TrimSpace(code)
+		if code != "" && code != "0" && code != "00000" && !seen[code] {
+			seen[code] = true
+			*list = append(*list, code)
+		}
+	}
+
+	var walk func(err error)
+	walk = func(err error) {
+		if err == nil {
+			return
+		}
+		if e, ok := err.(interface{ SQLErrorNumber() int32 }); ok {
+			add(&vendor, strconv.Itoa(int(e.SQLErrorNumber())))
+		}
+		if e, ok := err.(interface{ Code() int }); ok {
+			add(&vendor, strconv.Itoa(e.Code()))
+		}
+		if e, ok := err.(interface{ SQLState() string }); ok {
+			add(&states, e.SQLState())
+		}
+
+		v := reflect.ValueOf(err)
+		for v.Kind() == reflect.Pointer && !v.IsNil() {
+			v = v.Elem()
+		}
+		if v.Kind() == reflect.Struct {
+			for _, name := range []string{"Number", "ErrCode", "SQLCode", "Code"} {
+				switch f := v.FieldByName(name); {
+				case !f.IsValid():
+				case f.CanInt():
+					add(&vendor, strconv.FormatInt(f.Int(), 10))
+				case f.CanUint():
+					add(&vendor, strconv.FormatUint(f.Uint(), 10))
+				case f.Kind() == reflect.String && len(f.String()) == 5:
+					add(&states, f.String())
+				}
+			}
+			switch f := v.FieldByName("SQLState"); {
+			case !f.IsValid():
+			case f.Kind() == reflect.String:
+				add(&states, f.String())
+			case f.Kind() == reflect.Array && f.Type().Elem().Kind() == reflect.Uint8:
+				b := make([]byte, f.Len())
+				for i := range b {
+					b[i] = byte(f.Index(i).Uint())
+				}
+				add(&states, strings.TrimRight(string(b), "\x00"))
+			}
+		}
+
+		switch u := err.(type) {
+		case interface{ Unwrap() error }:
+			walk(u.Unwrap())
+		case interface{ Unwrap() []error }:
+			for _, e := range u.Unwrap() {
+				walk(e)
+			}
+		}
+	}
+	walk(err)
+	return append(vendor, states...)
+}
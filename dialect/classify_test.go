@@ -0,0 +1,104 @@
+package dialect_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/db2"
+	"github.com/entiqon/db/dialect/generic"
+	"github.com/entiqon/db/dialect/oracle"
+	dberrors "github.com/entiqon/db/errors"
+)
+
+// pgError mimics pgconn.PgError: a SQLSTATE string field.
+type pgError struct {
+	Code    string
+	Message string
+}
+
+func (e *pgError) Error() string { return e.Message }
+
+// mysqlError mimics go-sql-driver/mysql.MySQLError.
+type mysqlError struct {
+	Number   uint16
+	SQLState [5]byte
+	Message  string
+}
+
+func (e *mysqlError) Error() string { return e.Message }
+
+// oraError mimics godror's OraErr.
+type oraError int
+
+func (e oraError) Error() string { return fmt.Sprintf("ORA-%05d", int(e)) }
+func (e oraError) Code() int     { return int(e) }
+
+// db2Error mimics a Db2 driver error with SQLCODE and SQLSTATE.
+type db2Error struct {
+	SQLCode  int
+	sqlState string
+}
+
+func (e db2Error) Error() string    { return "SQL0911N" }
+func (e db2Error) SQLState() string { return e.sqlState }
+
+func TestClassifyError(t *testing.T) {
+	mysql := generic.NewWithOptions(dialect.Options{Name: "mysql"}.WithErrorCodes(dialect.MySQLErrorCodes))
+
+	cases := []struct {
+		name string
+		d    dialect.SQLDialect
+		err  error
+		want error
+		code string
+	}{
+		{"Standard", nil, &pgError{Code: "23505"}, dberrors.ErrUniqueViolation, "23505"},
+		{"Wrapped", generic.New(), fmt.Errorf("insert: %w", &pgError{Code: "40P01"}), dberrors.ErrDeadlock, "40P01"},
+		{"Options", mysql, &mysqlError{Number: 1205, SQLState: [5]byte{'H', 'Y', '0', '0', '0'}}, dberrors.ErrLockTimeout, "1205"},
+		{"MySQLState", mysql, &mysqlError{Number: 9999, SQLState: [5]byte{'4', '0', '0', '0', '1'}}, dberrors.ErrSerialization, "40001"},
+		{"Vendor", oracle.New(), oraError(1), dberrors.ErrUniqueViolation, "1"},
+		{"VendorFirst", db2.New(), db2Error{SQLCode: -913, sqlState: "57033"}, dberrors.ErrLockTimeout, "-913"},
+		{"Context", nil, fmt.Errorf("query: %w", context.Canceled), dberrors.ErrQueryCanceled, ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := dialect.ClassifyError(c.d, c.err)
+			if !errors.Is(err, c.want) || !errors.Is(err, c.err) {
+				t.Fatalf("expected %v wrapping %v, got %v", c.want, c.err, err)
+			}
+			var de *dberrors.DriverError
+			if !errors.As(err, &de) || de.Code != c.code {
+				t.Errorf("expected code %q, got %+v", c.code, de)
+			}
+		})
+	}
+
+	t.Run("Unchanged", func(t *testing.T) {
+		unknown := &pgError{Code: "XX000"}
+		if err := dialect.ClassifyError(generic.New(), unknown); err != unknown {
+			t.Errorf("expected unknown error unchanged, got %v", err)
+		}
+		if err := dialect.ClassifyError(oracle.New(), nil); err != nil {
+			t.Errorf("expected nil, got %v", err)
+		}
+		classified := dialect.ClassifyError(nil, &pgError{Code: "23502"})
+		if err := dialect.ClassifyError(oracle.New(), classified); err != classified {
+			t.Errorf("expected classified error unchanged, got %v", err)
+		}
+	})
+
+	t.Run("DriverCodes", func(t *testing.T) {
+		err := fmt.Errorf("batch: %w", errors.Join(
+			&mysqlError{Number: 1062, SQLState: [5]byte{'2', '3', '0', '0', '0'}},
+			oraError(60),
+		))
+		want := []string{"1062", "60", "23000"}
+		if got := dialect.DriverCodes(err); !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+}
@@ -0,0 +1,326 @@
+package clickhouse
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+	dberrors "github.com/entiqon/db/errors"
+)
+
+//
+// ClickHouse Dialect
+//
+
+// dialectImpl provides the ClickHouse implementation of the dialect.SQLDialect
+// interface. It is unexported to prevent direct instantiation; consumers should
+// always use the New() constructor.
+//
+// Key behaviors:
+//   - Identifiers are case-sensitive and quoted using backticks (`) only when
+//     required, with embedded backticks escaped by a backslash.
+//   - Placeholders are unnumbered parameter markers "?".
+//   - Pagination uses LIMIT n OFFSET m; per-group limits use LIMIT n BY.
+//   - QUALIFY and SAMPLE are supported.
+//   - Comments tag INSERT statements after their INSERT keyword, since
+//     data may follow their FORMAT clause.
+//   - MERGE, UPSERT and RETURNING are not supported; deduplication is left
+//     to table engines such as ReplacingMergeTree.
+type dialectImpl struct {
+	opts dialect.Options
+}
+
+// Compile-time checks: ensure dialectImpl implements SQLDialect and the
+// optional capabilities it advertises.
+var (
+	_ dialect.SQLDialect      = (*dialectImpl)(nil)
+	_ dialect.Qualifier       = (*dialectImpl)(nil)
+	_ dialect.Sampler         = (*dialectImpl)(nil)
+	_ dialect.GroupLimiter    = (*dialectImpl)(nil)
+	_ dialect.Keyworder       = (*dialectImpl)(nil)
+	_ dialect.FunctionMapper  = (*dialectImpl)(nil)
+	_ dialect.BinaryQuoter    = (*dialectImpl)(nil)
+	_ dialect.TimeQuoter      = (*dialectImpl)(nil)
+	_ dialect.Savepointer     = (*dialectImpl)(nil)
+	_ dialect.ErrorClassifier = (*dialectImpl)(nil)
+	_ dialect.Commenter       = (*dialectImpl)(nil)
+)
+
+// bareIdentifier matches identifiers that can be emitted without quotes.
+var bareIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+//
+// Constructors
+//
+
+// New returns a ClickHouse dialect. The returned value implements the
+// dialect.SQLDialect interface.
+//
+// Example:
+//
+//	d := clickhouse.New()
+//	d.PaginationSyntax(10, 20) // → " LIMIT 10 OFFSET 20"
+func New() dialect.SQLDialect {
+	return &dialectImpl{
+		opts: dialect.Options{
+			Name:                    "clickhouse",
+			QuoteStyle:              "`",
+			CaseFolding:             dialect.FoldNone,
+			PlaceholderStyle:        "?",
+			AllowMerge:              false,
+			AllowUpsert:             false,
+			ForcedAliasing:          true,
+			EnableReturning:         false,
+			SupportsCTE:             true,
+			SupportsWindowFunctions: true,
+			SupportsNullsOrdering:   true,
+			SupportsILike:           true,
+			SupportsArrayBinding:    false,
+			SupportsValuesList:      false,
+			MaxPlaceholderIndex:     0,
+			MaxIdentifierLength:     0,
+		},
+	}
+}
+
+// NewWithOptions creates a ClickHouse dialect with the given static options.
+// Options are immutable once the dialect is constructed.
+func NewWithOptions(opts dialect.Options) dialect.SQLDialect {
+	return &dialectImpl{opts: opts}
+}
+
+//
+// Interface Implementation
+//
+
+// Name returns the identifier of this dialect, "clickhouse" by default.
+func (d *dialectImpl) Name() string {
+	return d.opts.Name
+}
+
+// Options returns the feature capability matrix for the ClickHouse dialect.
+func (d *dialectImpl) Options() dialect.Options {
+	return d.opts
+}
+
+// QuoteIdentifier returns a ClickHouse identifier. ClickHouse identifiers
+// are case-sensitive, so simple names are returned as-is regardless of
+// case; anything else is wrapped in backticks, with embedded backticks and
+// backslashes escaped by a backslash.
+//
+// Database-qualified names (db.table) are quoted part by part and
+// reserved words are quoted, subject to Options().QuotePolicy.
+//
+// Example:
+//
+//	d.QuoteIdentifier("UserData")  // → UserData
+//	d.QuoteIdentifier("user-data") // → `user-data`
+func (d *dialectImpl) QuoteIdentifier(name string) string {
+	q := dialect.NewQuoter(d.opts, bareIdentifier.MatchString)
+	q.Backslash = true
+	q.Reserved = keywords.IsReserved
+	return q.Quote(name)
+}
+
+// Keywords returns the ClickHouse keyword set. Clause keywords such as
+// PREWHERE, SAMPLE and SETTINGS are treated as reserved.
+func (d *dialectImpl) Keywords() dialect.Keywords {
+	return keywords
+}
+
+// Functions returns the ClickHouse spellings of canonical functions, which
+// are case-sensitive calls: now(), concat(), substringUTF8(), lengthUTF8()
+// and date_trunc() with a lowercase unit.
+func (d *dialectImpl) Functions() dialect.FunctionMap {
+	return dialect.FunctionMap{
+		dialect.FuncNow:       dialect.Call("now"),
+		dialect.FuncConcat:    dialect.Call("concat"),
+		dialect.FuncSubstring: dialect.Call("substringUTF8"),
+		dialect.FuncLength:    dialect.Call("lengthUTF8"),
+		dialect.FuncDateTrunc: func(args []string) (string, error) {
+			return fmt.Sprintf("date_trunc('%s', %s)", args[0], args[1]), nil
+		},
+	}
+}
+
+// QuoteLiteral quotes a literal value for inline use in ClickHouse SQL.
+//
+// Supported types:
+//   - nil       → NULL
+//   - string    → wrapped in single quotes, with quotes and backslashes
+//     escaped by a backslash
+//   - bool      → true or false
+//   - numbers   → rendered in decimal form
+//   - time.Time → toDateTime('YYYY-MM-DD HH:MM:SS', 'UTC')
+//   - []byte    → unhex('..')
+//   - others    → dialect.MustLiteral
+func (d *dialectImpl) QuoteLiteral(literal any) string {
+	switch v := literal.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return quoteString(v)
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 64)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []byte:
+		return d.QuoteBinary(v)
+	case time.Time:
+		return dialect.QuoteTime(d, v)
+	default:
+		return dialect.MustLiteral(d, v)
+	}
+}
+
+// QuoteBinary renders data as a String built from its hexadecimal form.
+//
+// Example:
+//
+//	d.QuoteBinary([]byte("hi")) // → unhex('6869')
+func (d *dialectImpl) QuoteBinary(data []byte) string {
+	return "unhex('" + strings.ToUpper(hex.EncodeToString(data)) + "')"
+}
+
+// QuoteTime renders t as a DateTime in UTC, or as a DateTime64 with
+// microsecond precision when t has fractional seconds.
+//
+// Example:
+//
+//	d.QuoteTime(t) // → toDateTime('2025-09-19 03:30:00', 'UTC')
+func (d *dialectImpl) QuoteTime(t time.Time) string {
+	t = t.UTC()
+	if t.Nanosecond()/1000 == 0 {
+		return fmt.Sprintf("toDateTime('%s', 'UTC')", t.Format("2006-01-02 15:04:05"))
+	}
+	return fmt.Sprintf("toDateTime64('%s', 6, 'UTC')", t.Format("2006-01-02 15:04:05.000000"))
+}
+
+// PaginationSyntax renders the ClickHouse LIMIT/OFFSET clause.
+//
+// Example:
+//
+//	d.PaginationSyntax(10, 20) // → " LIMIT 10 OFFSET 20"
+//	d.PaginationSyntax(0, 20)  // → " OFFSET 20"
+func (d *dialectImpl) PaginationSyntax(limit, offset int) string {
+	var sb strings.Builder
+	if limit > 0 {
+		sb.WriteString(fmt.Sprintf(" LIMIT %d", limit))
+	}
+	if offset > 0 {
+		sb.WriteString(fmt.Sprintf(" OFFSET %d", offset))
+	}
+	return sb.String()
+}
+
+// Placeholder always returns "?" for ClickHouse. The index parameter is
+// ignored since parameter markers are not numbered.
+func (d *dialectImpl) Placeholder(_ int) string {
+	return d.opts.PlaceholderStyle
+}
+
+// QualifySyntax renders the QUALIFY clause (ClickHouse 24.4+).
+//
+// Example:
+//
+//	d.QualifySyntax("rn = 1") // → QUALIFY rn = 1
+func (d *dialectImpl) QualifySyntax(condition string) string {
+	if condition == "" {
+		return ""
+	}
+	return "QUALIFY " + condition
+}
+
+// SampleSyntax renders the SAMPLE clause. ClickHouse samples by ratio, so
+// percent is converted to a fraction of 1. The table must declare a
+// sampling key.
+//
+// Example:
+//
+//	d.SampleSyntax(10) // → SAMPLE 0.1
+func (d *dialectImpl) SampleSyntax(percent float64) string {
+	if percent <= 0 || percent > 100 {
+		return ""
+	}
+	return "SAMPLE " + strconv.FormatFloat(percent/100, 'f', -1, 64)
+}
+
+// Comment places comment right after the INSERT keyword of INSERT
+// statements, whose FORMAT clause may be followed by data, and appends it
+// to other statements.
+//
+// Example:
+//
+//	d.Comment("INSERT INTO logs FORMAT JSONEachRow", "/*app='etl'*/")
+//	// → INSERT /*app='etl'*/ INTO logs FORMAT JSONEachRow
+func (d *dialectImpl) Comment(query, comment string) string {
+	trimmed := strings.TrimLeft(query, " \t\r\n")
+	if len(trimmed) > 6 && strings.EqualFold(trimmed[:6], "INSERT") && strings.IndexByte(" \t\r\n", trimmed[6]) >= 0 {
+		i := len(query) - len(trimmed) + 6
+		return query[:i] + " " + comment + query[i:]
+	}
+	return dialect.AppendComment(d, query, comment)
+}
+
+// LimitBySyntax renders ClickHouse's LIMIT n BY clause, which keeps the
+// first limit rows of every distinct combination of columns.
+//
+// Example:
+//
+//	d.LimitBySyntax(3, 0, []string{"domain"}) // → LIMIT 3 BY domain
+//	d.LimitBySyntax(3, 1, []string{"domain"}) // → LIMIT 3 OFFSET 1 BY domain
+func (d *dialectImpl) LimitBySyntax(limit, offset int, columns []string) string {
+	if limit <= 0 || len(columns) == 0 {
+		return ""
+	}
+	cols := make([]string, len(columns))
+	for i, c := range columns {
+		cols[i] = d.QuoteIdentifier(c)
+	}
+	if offset > 0 {
+		return fmt.Sprintf("LIMIT %d OFFSET %d BY %s", limit, offset, strings.Join(cols, ", "))
+	}
+	return fmt.Sprintf("LIMIT %d BY %s", limit, strings.Join(cols, ", "))
+}
+
+// quoteString wraps s in single quotes, escaping backslashes and quotes
+// with a backslash as ClickHouse string literals require.
+func quoteString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
+
+// SavepointSyntax returns an empty string: ClickHouse has no savepoints.
+func (d *dialectImpl) SavepointSyntax(string) string {
+	return ""
+}
+
+// ReleaseSavepointSyntax returns an empty string: ClickHouse has no savepoints.
+func (d *dialectImpl) ReleaseSavepointSyntax(string) string {
+	return ""
+}
+
+// RollbackToSavepointSyntax returns an empty string: ClickHouse has no savepoints.
+func (d *dialectImpl) RollbackToSavepointSyntax(string) string {
+	return ""
+}
+
+// ErrorCodes returns the ClickHouse exception codes. ClickHouse has no
+// constraints checked by the server beyond CHECK.
+func (d *dialectImpl) ErrorCodes() dialect.ErrorCodes {
+	return dialect.ErrorCodes{
+		"473": dberrors.ErrDeadlock,      // DEADLOCK_AVOIDED
+		"394": dberrors.ErrQueryCanceled, // QUERY_WAS_CANCELLED
+		"159": dberrors.ErrQueryCanceled, // TIMEOUT_EXCEEDED
+	}
+}
@@ -0,0 +1,124 @@
+package clickhouse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/clickhouse"
+)
+
+func TestClickHouseDialect(t *testing.T) {
+	d := clickhouse.New()
+
+	t.Run("Name", func(t *testing.T) {
+		if got, want := d.Name(), "clickhouse"; got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+		if got := clickhouse.NewWithOptions(dialect.Options{Name: "ch"}).Name(); got != "ch" {
+			t.Errorf("expected %q, got %q", "ch", got)
+		}
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		opts := d.Options()
+		if opts.AllowMerge || opts.AllowUpsert || opts.EnableReturning {
+			t.Error("expected MERGE, UPSERT and RETURNING to be disabled")
+		}
+		if opts.QuoteStyle != "`" {
+			t.Errorf("unexpected QuoteStyle = %q", opts.QuoteStyle)
+		}
+		if _, ok := d.(dialect.Merger); ok {
+			t.Error("expected ClickHouse not to implement dialect.Merger")
+		}
+	})
+
+	t.Run("QuoteIdentifier", func(t *testing.T) {
+		cases := []struct {
+			in   string
+			want string
+		}{
+			{"users", "users"},
+			{"UserData", "UserData"},
+			{"user-data", "`user-data`"},
+			{"odd`name", "`odd\\`name`"},
+			{`back\slash`, "`back\\\\slash`"},
+			{"", ""},
+		}
+		for _, c := range cases {
+			if got := d.QuoteIdentifier(c.in); got != c.want {
+				t.Errorf("QuoteIdentifier(%q) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("QuoteLiteral", func(t *testing.T) {
+		now := time.Date(2025, 9, 19, 3, 30, 0, 0, time.UTC)
+		cases := []struct {
+			in   any
+			want string
+		}{
+			{nil, "NULL"},
+			{"O'Reilly", `'O\'Reilly'`},
+			{`C:\tmp`, `'C:\\tmp'`},
+			{true, "true"},
+			{false, "false"},
+			{uint8(7), "7"},
+			{float32(2.5), "2.5"},
+			{0.25, "0.25"},
+			{now, "toDateTime('2025-09-19 03:30:00', 'UTC')"},
+			{now.Add(500 * time.Millisecond), "toDateTime64('2025-09-19 03:30:00.500000', 6, 'UTC')"},
+			{[]byte("hi"), "unhex('6869')"},
+		}
+		for _, c := range cases {
+			if got := d.QuoteLiteral(c.in); got != c.want {
+				t.Errorf("QuoteLiteral(%v) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("Placeholder", func(t *testing.T) {
+		if got := d.Placeholder(3); got != "?" {
+			t.Errorf("Placeholder(3) = %q, want '?'", got)
+		}
+	})
+
+	t.Run("Golden", func(t *testing.T) {
+		q := d.(dialect.Qualifier)
+		s := d.(dialect.Sampler)
+		l := d.(dialect.GroupLimiter)
+		cases := []struct {
+			name string
+			got  string
+			want string
+		}{
+			{"paginate", dialect.Paginate(d, "SELECT id FROM hits", 10, 20),
+				"SELECT id FROM hits LIMIT 10 OFFSET 20"},
+			{"paginate offset", dialect.Paginate(d, "SELECT id FROM hits", 0, 20),
+				"SELECT id FROM hits OFFSET 20"},
+			{"limit by", "SELECT domain, url FROM hits ORDER BY ts DESC " + l.LimitBySyntax(3, 0, []string{"domain"}),
+				"SELECT domain, url FROM hits ORDER BY ts DESC LIMIT 3 BY domain"},
+			{"limit offset by", "SELECT domain, url FROM hits " + l.LimitBySyntax(3, 1, []string{"domain", "Region"}),
+				"SELECT domain, url FROM hits LIMIT 3 OFFSET 1 BY domain, Region"},
+			{"limit by empty", l.LimitBySyntax(3, 0, nil), ""},
+			{"limit by zero", l.LimitBySyntax(0, 0, []string{"domain"}), ""},
+			{"qualify", "SELECT id, row_number() OVER (PARTITION BY uid) AS rn FROM hits " + q.QualifySyntax("rn = 1"),
+				"SELECT id, row_number() OVER (PARTITION BY uid) AS rn FROM hits QUALIFY rn = 1"},
+			{"qualify empty", q.QualifySyntax(""), ""},
+			{"sample", "SELECT count() FROM hits " + s.SampleSyntax(10),
+				"SELECT count() FROM hits SAMPLE 0.1"},
+			{"sample full", s.SampleSyntax(100), "SAMPLE 1"},
+			{"sample out of range", s.SampleSyntax(150), ""},
+			{"sample zero", s.SampleSyntax(0), ""},
+			{"comment insert", dialect.Comment(d, "INSERT INTO logs FORMAT JSONEachRow", map[string]string{"app": "etl"}),
+				"INSERT /*app='etl'*/ INTO logs FORMAT JSONEachRow"},
+			{"comment select", dialect.Comment(d, "SELECT id FROM hits", map[string]string{"app": "etl"}),
+				"SELECT id FROM hits /*app='etl'*/"},
+		}
+		for _, c := range cases {
+			if c.got != c.want {
+				t.Errorf("%s:\n got %q\nwant %q", c.name, c.got, c.want)
+			}
+		}
+	})
+}
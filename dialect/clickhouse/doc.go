@@ -0,0 +1,31 @@
+/*
+Package clickhouse provides the ClickHouse SQL dialect implementation.
+
+# Overview
+
+The ClickHouse dialect renders SQL following ClickHouse rules:
+
+  - Identifiers are case-sensitive. Simple names are emitted bare; others
+    are wrapped in backticks, with backticks and backslashes escaped by a
+    backslash.
+  - String literals escape quotes and backslashes with a backslash.
+  - Placeholders are unnumbered parameter markers (?).
+  - Pagination uses LIMIT n OFFSET m; per-group limits use LIMIT n BY.
+  - QUALIFY (24.4+) and SAMPLE are supported.
+  - MERGE, UPSERT and RETURNING are not available; deduplication is left
+    to table engines such as ReplacingMergeTree.
+
+# Usage
+
+	d := clickhouse.New()
+	l := d.(dialect.GroupLimiter)
+	sql := "SELECT domain, url FROM hits " + l.LimitBySyntax(3, 0, []string{"domain"})
+	// SELECT domain, url FROM hits LIMIT 3 BY domain
+
+# Capabilities
+
+Besides dialect.SQLDialect, the ClickHouse dialect implements the optional
+capability interfaces Qualifier, Sampler and GroupLimiter declared in the
+dialect package.
+*/
+package clickhouse
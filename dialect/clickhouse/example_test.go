@@ -0,0 +1,31 @@
+package clickhouse_test
+
+import (
+	"fmt"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/clickhouse"
+)
+
+func Example() {
+	d := clickhouse.New()
+	fmt.Println(d.Name())
+	fmt.Println(d.QuoteIdentifier("user-data"))
+	// Output:
+	// clickhouse
+	// `user-data`
+}
+
+func Example_limitBy() {
+	d := clickhouse.New().(dialect.GroupLimiter)
+	fmt.Println("SELECT domain, url FROM hits " + d.LimitBySyntax(3, 0, []string{"domain"}))
+	// Output:
+	// SELECT domain, url FROM hits LIMIT 3 BY domain
+}
+
+func Example_sample() {
+	d := clickhouse.New().(dialect.Sampler)
+	fmt.Println("SELECT count() FROM hits " + d.SampleSyntax(10))
+	// Output:
+	// SELECT count() FROM hits SAMPLE 0.1
+}
@@ -0,0 +1,27 @@
+package clickhouse
+
+import "github.com/entiqon/db/dialect"
+
+// keywords holds the ClickHouse clause keywords. ClickHouse only treats
+// keywords as such in context, but an alias matching one of the reserved
+// words below breaks parsing of the surrounding query unless quoted.
+var keywords = dialect.NewKeywords(
+	[]string{
+		"ALL", "AND", "ANTI", "ANY", "ARRAY", "AS", "ASOF", "BETWEEN", "BY",
+		"CASE", "CAST", "CROSS", "DISTINCT", "ELSE", "END", "EXCEPT", "FINAL",
+		"FORMAT", "FROM", "FULL", "GLOBAL", "GROUP", "HAVING", "ILIKE", "IN",
+		"INNER", "INTERSECT", "INTERVAL", "INTO", "IS", "JOIN", "LEFT",
+		"LIKE", "LIMIT", "NOT", "NULL", "OFFSET", "ON", "OR", "ORDER",
+		"OUTER", "PASTE", "PREWHERE", "QUALIFY", "RIGHT", "SAMPLE", "SELECT",
+		"SEMI", "SETTINGS", "THEN", "TOTALS", "UNION", "USING", "WHEN",
+		"WHERE", "WINDOW", "WITH",
+	},
+	[]string{
+		"ALTER", "ASC", "ATTACH", "CLUSTER", "COLLATE", "CREATE", "DATABASE",
+		"DELETE", "DESC", "DETACH", "DICTIONARY", "DROP", "ENGINE", "EXISTS",
+		"FILL", "FIRST", "IF", "INSERT", "KEY", "LAST", "LIVE", "MATERIALIZED",
+		"NULLS", "OPTIMIZE", "PARTITION", "POPULATE", "PRIMARY", "RENAME",
+		"SET", "SHOW", "STEP", "SYSTEM", "TABLE", "TEMPORARY", "TIES", "TO",
+		"TRUNCATE", "TTL", "UPDATE", "USE", "VALUES", "VIEW",
+	},
+)
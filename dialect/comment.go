@@ -0,0 +1,136 @@
+package dialect
+
+import (
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// FormatComment renders tags as a comment in the sqlcommenter format:
+// key='value' pairs sorted by key, separated by commas. Keys and values are
+// URL-encoded, so quotes, "*/" and newlines cannot end the comment or the
+// value early, and a comment never opens as an optimizer hint ("/*+"). No
+// tags render an empty string.
+//
+// Example:
+//
+//	dialect.FormatComment(map[string]string{"route": "/orders/{id}", "app": "billing"})
+//	// /*app='billing',route='%2Forders%2F%7Bid%7D'*/
+func FormatComment(tags map[string]string) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	pairs := make([]string, 0, len(tags))
+	for _, k := range slices.Sorted(maps.Keys(tags)) {
+		pairs = append(pairs, commentEscape(k)+"='"+commentEscape(tags[k])+"'")
+	}
+	return "/*" + strings.Join(pairs, ",") + "*/"
+}
+
+// Comment returns query tagged with tags, in the sqlcommenter format of
+// FormatComment. When query already carries such a comment, as left by an
+// earlier call, the tags are merged into it and those already present win.
+// Otherwise the comment is placed by d when it implements Commenter, or
+// appended by AppendComment. A nil d reads standard SQL.
+//
+// Example:
+//
+//	dialect.Comment(oracle.New(), "SELECT * FROM users WHERE id = :1", map[string]string{"app": "billing"})
+//	// SELECT * FROM users WHERE id = :1 /*app='billing'*/
+func Comment(d SQLDialect, query string, tags map[string]string) string {
+	if len(tags) == 0 {
+		return query
+	}
+	if start, end, existing := findComment(d, query); existing != nil {
+		merged := maps.Clone(tags)
+		maps.Copy(merged, existing)
+		return query[:start] + FormatComment(merged) + query[end:]
+	}
+
+	comment := FormatComment(tags)
+	if c, ok := d.(Commenter); ok {
+		return c.Comment(query, comment)
+	}
+	return AppendComment(d, query, comment)
+}
+
+// AppendComment appends comment to query, before trailing semicolons and
+// whitespace, and on a line of its own when query ends with a line comment
+// ("-- ..."), which would swallow it. It is the placement used by Comment
+// for dialects not implementing Commenter.
+func AppendComment(d SQLDialect, query, comment string) string {
+	backslash, brackets := lexing(d)
+
+	end := len(strings.TrimRight(query, " \t\r\n;"))
+	sep := " "
+	for i := 0; i < end; {
+		next := skipQuoted(query, i, backslash, brackets)
+		if next <= i {
+			i++
+			continue
+		}
+		if next >= end && strings.HasPrefix(query[i:], "--") {
+			end, sep = len(strings.TrimRight(query, " \t\r\n")), "\n"
+		}
+		i = next
+	}
+	if end == 0 {
+		sep = ""
+	}
+	return query[:end] + sep + comment + query[end:]
+}
+
+// findComment returns the span and the tags of the last sqlcommenter
+// comment of query, or nil tags when there is none.
+func findComment(d SQLDialect, query string) (int, int, map[string]string) {
+	backslash, brackets := lexing(d)
+
+	start, end := 0, 0
+	var tags map[string]string
+	for i := 0; i < len(query); {
+		next := skipQuoted(query, i, backslash, brackets)
+		if next <= i {
+			i++
+			continue
+		}
+		if strings.HasPrefix(query[i:], "/*") {
+			if t := parseComment(query[i:next]); t != nil {
+				start, end, tags = i, next, t
+			}
+		}
+		i = next
+	}
+	return start, end, tags
+}
+
+// parseComment returns the tags of a comment rendered by FormatComment, or
+// nil when comment is not in that format.
+func parseComment(comment string) map[string]string {
+	if len(comment) <= 4 || !strings.HasPrefix(comment, "/*") || !strings.HasSuffix(comment, "*/") {
+		return nil
+	}
+	body := comment[2 : len(comment)-2]
+	tags := make(map[string]string)
+	for _, pair := range strings.Split(body, ",") {
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok || len(v) < 2 || v[0] != '\'' || v[len(v)-1] != '\'' {
+			return nil
+		}
+		key, err := url.PathUnescape(k)
+		if err != nil || key == "" {
+			return nil
+		}
+		value, err := url.PathUnescape(v[1 : len(v)-1])
+		if err != nil {
+			return nil
+		}
+		tags[key] = value
+	}
+	return tags
+}
+
+// commentEscape URL-encodes s as sqlcommenter requires, spaces as %20.
+func commentEscape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
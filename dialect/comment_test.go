@@ -0,0 +1,74 @@
+package dialect_test
+
+import (
+	"testing"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/clickhouse"
+	"github.com/entiqon/db/dialect/generic"
+	"github.com/entiqon/db/dialect/oracle"
+)
+
+func TestComment(t *testing.T) {
+	tags := map[string]string{"route": "/orders/{id}", "app": "billing"}
+
+	t.Run("Format", func(t *testing.T) {
+		tests := []struct {
+			name string
+			tags map[string]string
+			want string
+		}{
+			{"Sorted", tags, "/*app='billing',route='%2Forders%2F%7Bid%7D'*/"},
+			{"Escaped", map[string]string{"a b": "it's */ x\n"}, "/*a%20b='it%27s%20%2A%2F%20x%0A'*/"},
+			{"Hint", map[string]string{"+INDEX(t)": "x"}, "/*%2BINDEX%28t%29='x'*/"},
+			{"Empty", nil, ""},
+		}
+		for _, tt := range tests {
+			if got := dialect.FormatComment(tt.tags); got != tt.want {
+				t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("Placement", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			d     dialect.SQLDialect
+			query string
+			want  string
+		}{
+			{"Trailing", oracle.New(), "SELECT * FROM t WHERE id = :1",
+				"SELECT * FROM t WHERE id = :1 /*app='billing',route='%2Forders%2F%7Bid%7D'*/"},
+			{"Semicolon", generic.New(), "DELETE FROM t;\n",
+				"DELETE FROM t /*app='billing',route='%2Forders%2F%7Bid%7D'*/;\n"},
+			{"LineComment", generic.New(), "SELECT 1 -- total\n",
+				"SELECT 1 -- total\n/*app='billing',route='%2Forders%2F%7Bid%7D'*/\n"},
+			{"QuotedDashes", generic.New(), "SELECT '--' FROM t",
+				"SELECT '--' FROM t /*app='billing',route='%2Forders%2F%7Bid%7D'*/"},
+			{"Neutral", nil, "SELECT * FROM t WHERE id = :id",
+				"SELECT * FROM t WHERE id = :id /*app='billing',route='%2Forders%2F%7Bid%7D'*/"},
+			{"Commenter", clickhouse.New(), "INSERT INTO t FORMAT CSV",
+				"INSERT /*app='billing',route='%2Forders%2F%7Bid%7D'*/ INTO t FORMAT CSV"},
+		}
+		for _, tt := range tests {
+			if got := dialect.Comment(tt.d, tt.query, tags); got != tt.want {
+				t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("Merge", func(t *testing.T) {
+		query := dialect.Comment(generic.New(), "SELECT /*+ FULL(t) */ * FROM t", map[string]string{"app": "api", "caller": "x.go:1"})
+		got := dialect.Comment(generic.New(), query, tags)
+		want := "SELECT /*+ FULL(t) */ * FROM t /*app='api',caller='x.go%3A1',route='%2Forders%2F%7Bid%7D'*/"
+		if got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+		if got := dialect.Comment(generic.New(), "SELECT 1 /* note */", tags); got != "SELECT 1 /* note */ "+dialect.FormatComment(tags) {
+			t.Errorf("expected plain comments to be left alone, got %q", got)
+		}
+		if got := dialect.Comment(generic.New(), "SELECT 1", nil); got != "SELECT 1" {
+			t.Errorf("expected no tags to leave the query alone, got %q", got)
+		}
+	})
+}
@@ -0,0 +1,56 @@
+// File: db/dialect/conflict.go
+
+package dialect
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OnConflict renders the INSERT ... ON CONFLICT upsert shared by
+// PostgreSQL and SQLite: a row of columns, bound positionally in column
+// order through d.Placeholder, is inserted into table, and the non-key
+// columns are updated from EXCLUDED when a row matching keys already
+// exists. When every column is a key, the conflict is ignored (DO
+// NOTHING). Names are quoted with d.QuoteIdentifier. It returns "" when
+// table, columns or keys are empty.
+//
+// Example:
+//
+//	dialect.OnConflict(d, "users", []string{"id", "name"}, []string{"id"})
+//	// INSERT INTO users (id, name) VALUES ($1, $2)
+//	//   ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name
+func OnConflict(d SQLDialect, table string, columns, keys []string) string {
+	if table == "" || len(columns) == 0 || len(keys) == 0 {
+		return ""
+	}
+
+	isKey := make(map[string]bool, len(keys))
+	target := make([]string, len(keys))
+	for i, k := range keys {
+		isKey[k] = true
+		target[i] = d.QuoteIdentifier(k)
+	}
+
+	cols := make([]string, len(columns))
+	marks := make([]string, len(columns))
+	var updates []string
+	for i, c := range columns {
+		q := d.QuoteIdentifier(c)
+		cols[i] = q
+		marks[i] = d.Placeholder(i + 1)
+		if !isKey[c] {
+			updates = append(updates, fmt.Sprintf("%s = EXCLUDED.%s", q, q))
+		}
+	}
+
+	action := "DO NOTHING"
+	if len(updates) > 0 {
+		action = "DO UPDATE SET " + strings.Join(updates, ", ")
+	}
+	return fmt.Sprintf(
+		"INSERT INTO %s (%s) VALUES (%s) ON CONFLICT (%s) %s",
+		d.QuoteIdentifier(table), strings.Join(cols, ", "), strings.Join(marks, ", "),
+		strings.Join(target, ", "), action,
+	)
+}
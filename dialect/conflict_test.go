@@ -0,0 +1,41 @@
+package dialect_test
+
+import (
+	"testing"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/generic"
+)
+
+func TestOnConflict(t *testing.T) {
+	d := generic.New()
+
+	t.Run("Update", func(t *testing.T) {
+		got := dialect.OnConflict(d, "users", []string{"id", "name", "order"}, []string{"id"})
+		want := `INSERT INTO users (id, name, "order") VALUES (?, ?, ?)` +
+			` ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name, "order" = EXCLUDED."order"`
+		if got != want {
+			t.Errorf("OnConflict = %q\nwant         %q", got, want)
+		}
+	})
+
+	t.Run("KeysOnly", func(t *testing.T) {
+		got := dialect.OnConflict(d, "tags", []string{"post_id", "tag"}, []string{"post_id", "tag"})
+		want := "INSERT INTO tags (post_id, tag) VALUES (?, ?) ON CONFLICT (post_id, tag) DO NOTHING"
+		if got != want {
+			t.Errorf("OnConflict = %q\nwant         %q", got, want)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		if got := dialect.OnConflict(d, "", []string{"id"}, []string{"id"}); got != "" {
+			t.Errorf("expected empty statement without table, got %q", got)
+		}
+		if got := dialect.OnConflict(d, "users", nil, []string{"id"}); got != "" {
+			t.Errorf("expected empty statement without columns, got %q", got)
+		}
+		if got := dialect.OnConflict(d, "users", []string{"id"}, nil); got != "" {
+			t.Errorf("expected empty statement without keys, got %q", got)
+		}
+	})
+}
@@ -0,0 +1,249 @@
+package db2
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+	dberrors "github.com/entiqon/db/errors"
+)
+
+//
+// DB2 Dialect
+//
+
+// dialectImpl provides the IBM Db2 implementation of the dialect.SQLDialect
+// interface. It is unexported to prevent direct instantiation; consumers should
+// always use the New() constructor.
+//
+// Key behaviors:
+//   - Identifiers are quoted using double quotes (") only when required.
+//   - Placeholders are unnumbered parameter markers "?".
+//   - Pagination uses OFFSET n ROWS FETCH FIRST m ROWS ONLY (Db2 11.1+).
+//   - Upserts are rendered as MERGE INTO ... USING (VALUES ...).
+//   - RETURNING is not available; Db2 uses SELECT ... FROM FINAL TABLE (...).
+type dialectImpl struct {
+	opts dialect.Options
+}
+
+// Compile-time checks: ensure dialectImpl implements SQLDialect and the
+// optional capabilities it advertises.
+var (
+	_ dialect.SQLDialect      = (*dialectImpl)(nil)
+	_ dialect.Merger          = (*dialectImpl)(nil)
+	_ dialect.Keyworder       = (*dialectImpl)(nil)
+	_ dialect.FunctionMapper  = (*dialectImpl)(nil)
+	_ dialect.BinaryQuoter    = (*dialectImpl)(nil)
+	_ dialect.Savepointer     = (*dialectImpl)(nil)
+	_ dialect.ErrorClassifier = (*dialectImpl)(nil)
+)
+
+// bareIdentifier matches identifiers that can be emitted without quotes.
+var bareIdentifier = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+//
+// Constructors
+//
+
+// New returns an IBM Db2 (LUW 11.1+) dialect. The returned value implements
+// the dialect.SQLDialect interface.
+//
+// Example:
+//
+//	d := db2.New()
+//	d.PaginationSyntax(10, 20) // → " OFFSET 20 ROWS FETCH FIRST 10 ROWS ONLY"
+func New() dialect.SQLDialect {
+	return &dialectImpl{
+		opts: dialect.Options{
+			Name:                    "db2",
+			QuoteStyle:              `"`,
+			CaseFolding:             dialect.FoldUpper,
+			PlaceholderStyle:        "?",
+			AllowMerge:              true,
+			AllowUpsert:             false,
+			ForcedAliasing:          true,
+			EnableReturning:         false,
+			SupportsCTE:             true,
+			SupportsWindowFunctions: true,
+			SupportsNullsOrdering:   true,
+			SupportsILike:           false,
+			SupportsArrayBinding:    false,
+			SupportsValuesList:      true,
+			MaxPlaceholderIndex:     32767,
+			MaxIdentifierLength:     128,
+		},
+	}
+}
+
+// NewWithOptions creates a Db2 dialect with the given static options.
+// Options are immutable once the dialect is constructed.
+func NewWithOptions(opts dialect.Options) dialect.SQLDialect {
+	return &dialectImpl{opts: opts}
+}
+
+//
+// Interface Implementation
+//
+
+// Name returns the identifier of this dialect, "db2" by default.
+func (d *dialectImpl) Name() string {
+	return d.opts.Name
+}
+
+// Options returns the feature capability matrix for the Db2 dialect.
+func (d *dialectImpl) Options() dialect.Options {
+	return d.opts
+}
+
+// QuoteIdentifier returns a Db2 identifier. Lowercase simple names are
+// returned as-is; anything else is wrapped in double quotes, with embedded
+// double quotes escaped by doubling them.
+//
+// Each part of a qualified name is quoted on its own, reserved words
+// included, unless Options().QuotePolicy says otherwise.
+//
+// Example:
+//
+//	d.QuoteIdentifier("users")      // → users
+//	d.QuoteIdentifier("UserData")   // → UserData, resolved as USERDATA
+//	d.QuoteIdentifier(`"UserData"`) // → "UserData"
+func (d *dialectImpl) QuoteIdentifier(name string) string {
+	q := dialect.NewQuoter(d.opts, bareIdentifier.MatchString)
+	q.Reserved = keywords.IsReserved
+	return q.Quote(name)
+}
+
+// Keywords returns the DB2 keyword set used to decide which names
+// QuoteIdentifier must delimit.
+func (d *dialectImpl) Keywords() dialect.Keywords {
+	return keywords
+}
+
+// Functions returns the Db2 spellings of canonical functions: SUBSTR,
+// LENGTH and DATE_TRUNC with an uppercase unit string.
+func (d *dialectImpl) Functions() dialect.FunctionMap {
+	return dialect.FunctionMap{
+		dialect.FuncSubstring: dialect.Call("SUBSTR"),
+		dialect.FuncLength:    dialect.Call("LENGTH"),
+		dialect.FuncDateTrunc: func(args []string) (string, error) {
+			return fmt.Sprintf("DATE_TRUNC('%s', %s)", strings.ToUpper(args[0]), args[1]), nil
+		},
+	}
+}
+
+// QuoteLiteral quotes a literal value for inline use in Db2 SQL.
+//
+// Supported types:
+//   - nil       → NULL
+//   - string    → escaped and wrapped in single quotes
+//   - bool      → TRUE or FALSE (Db2 11.1+)
+//   - numbers   → rendered in decimal form
+//   - time.Time → TIMESTAMP 'YYYY-MM-DD HH:MM:SS' in UTC
+//   - []byte    → BX'..'
+//   - others    → dialect.MustLiteral
+func (d *dialectImpl) QuoteLiteral(literal any) string {
+	switch v := literal.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return "'" + strings.ReplaceAll(v, "'", "''") + "'"
+	case bool:
+		if v {
+			return "TRUE"
+		}
+		return "FALSE"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 64)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []byte:
+		return d.QuoteBinary(v)
+	case time.Time:
+		return dialect.QuoteTime(d, v)
+	default:
+		return dialect.MustLiteral(d, v)
+	}
+}
+
+// QuoteBinary renders data as a Db2 binary string constant.
+//
+// Example:
+//
+//	d.QuoteBinary([]byte("hi")) // → BX'6869'
+func (d *dialectImpl) QuoteBinary(data []byte) string {
+	return "BX'" + strings.ToUpper(hex.EncodeToString(data)) + "'"
+}
+
+// PaginationSyntax renders the Db2 row-limiting clause.
+//
+// Example:
+//
+//	d.PaginationSyntax(10, 0)  // → " FETCH FIRST 10 ROWS ONLY"
+//	d.PaginationSyntax(10, 20) // → " OFFSET 20 ROWS FETCH FIRST 10 ROWS ONLY"
+//	d.PaginationSyntax(0, 20)  // → " OFFSET 20 ROWS"
+func (d *dialectImpl) PaginationSyntax(limit, offset int) string {
+	var sb strings.Builder
+	if offset > 0 {
+		sb.WriteString(fmt.Sprintf(" OFFSET %d ROWS", offset))
+	}
+	if limit > 0 {
+		sb.WriteString(fmt.Sprintf(" FETCH FIRST %d ROWS ONLY", limit))
+	}
+	return sb.String()
+}
+
+// Placeholder always returns "?" for Db2. The index parameter is ignored
+// since parameter markers are not numbered.
+func (d *dialectImpl) Placeholder(_ int) string {
+	return d.opts.PlaceholderStyle
+}
+
+// MergeSyntax renders an upsert as a MERGE statement reading a single row
+// of parameter markers from a VALUES table constructor.
+//
+// Example:
+//
+//	d.MergeSyntax("users", []string{"id", "name"}, []string{"id"})
+//	// MERGE INTO users tgt USING (VALUES (?, ?)) AS src (id, name)
+//	//   ON (tgt.id = src.id)
+//	//   WHEN MATCHED THEN UPDATE SET tgt.name = src.name
+//	//   WHEN NOT MATCHED THEN INSERT (id, name) VALUES (src.id, src.name)
+func (d *dialectImpl) MergeSyntax(table string, columns, keys []string) string {
+	return dialect.Merge(d, table, columns, keys, dialect.MergeStyle{Source: dialect.MergeValues})
+}
+
+// SavepointSyntax renders "SAVEPOINT name ON ROLLBACK RETAIN CURSORS"; Db2
+// requires the ON ROLLBACK clause.
+func (d *dialectImpl) SavepointSyntax(name string) string {
+	return "SAVEPOINT " + name + " ON ROLLBACK RETAIN CURSORS"
+}
+
+// ReleaseSavepointSyntax renders "RELEASE SAVEPOINT name".
+func (d *dialectImpl) ReleaseSavepointSyntax(name string) string {
+	return "RELEASE SAVEPOINT " + name
+}
+
+// RollbackToSavepointSyntax renders "ROLLBACK TO SAVEPOINT name".
+func (d *dialectImpl) RollbackToSavepointSyntax(name string) string {
+	return "ROLLBACK TO SAVEPOINT " + name
+}
+
+// ErrorCodes returns the Db2 SQLCODE values. Db2 also reports standard
+// SQLSTATE values, classified by dialect.StandardErrorCodes.
+func (d *dialectImpl) ErrorCodes() dialect.ErrorCodes {
+	return dialect.ErrorCodes{
+		"-803": dberrors.ErrUniqueViolation,     // duplicate key
+		"-530": dberrors.ErrForeignKeyViolation, // invalid foreign key value
+		"-532": dberrors.ErrForeignKeyViolation, // delete restricted
+		"-407": dberrors.ErrNotNullViolation,    // NULL into NOT NULL column
+		"-911": dberrors.ErrDeadlock,            // rolled back: deadlock or timeout
+		"-913": dberrors.ErrLockTimeout,         // not rolled back: deadlock or timeout
+		"-952": dberrors.ErrQueryCanceled,       // interrupted
+	}
+}
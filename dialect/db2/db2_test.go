@@ -0,0 +1,121 @@
+package db2_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/db2"
+)
+
+func TestDB2Dialect(t *testing.T) {
+	d := db2.New()
+
+	t.Run("Name", func(t *testing.T) {
+		if got, want := d.Name(), "db2"; got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+		if got := db2.NewWithOptions(dialect.Options{Name: "db2-zos"}).Name(); got != "db2-zos" {
+			t.Errorf("expected %q, got %q", "db2-zos", got)
+		}
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		opts := d.Options()
+		if !opts.AllowMerge {
+			t.Error("expected AllowMerge=true")
+		}
+		if opts.AllowUpsert || opts.EnableReturning {
+			t.Error("expected UPSERT and RETURNING to be disabled")
+		}
+		if opts.PlaceholderStyle != "?" {
+			t.Errorf("unexpected PlaceholderStyle = %q", opts.PlaceholderStyle)
+		}
+	})
+
+	t.Run("QuoteIdentifier", func(t *testing.T) {
+		cases := []struct {
+			in   string
+			want string
+		}{
+			{"users", "users"},
+			{"UserData", "UserData"},
+			{`"UserData"`, `"UserData"`},
+			{`odd"name`, `"odd""name"`},
+			{"", ""},
+		}
+		for _, c := range cases {
+			if got := d.QuoteIdentifier(c.in); got != c.want {
+				t.Errorf("QuoteIdentifier(%q) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("QuoteLiteral", func(t *testing.T) {
+		now := time.Date(2025, 9, 19, 3, 30, 0, 0, time.UTC)
+		cases := []struct {
+			in   any
+			want string
+		}{
+			{nil, "NULL"},
+			{"O'Reilly", "'O''Reilly'"},
+			{true, "TRUE"},
+			{false, "FALSE"},
+			{-7, "-7"},
+			{float32(2.5), "2.5"},
+			{3.14, "3.14"},
+			{now, "TIMESTAMP '2025-09-19 03:30:00'"},
+			{now.Add(500 * time.Millisecond), "TIMESTAMP '2025-09-19 03:30:00.5'"},
+			{[]byte("hi"), "BX'6869'"},
+		}
+		for _, c := range cases {
+			if got := d.QuoteLiteral(c.in); got != c.want {
+				t.Errorf("QuoteLiteral(%v) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("Placeholder", func(t *testing.T) {
+		if got := d.Placeholder(3); got != "?" {
+			t.Errorf("Placeholder(3) = %q, want '?'", got)
+		}
+	})
+
+	t.Run("Paginate", func(t *testing.T) {
+		const q = "SELECT id FROM users ORDER BY id"
+		cases := []struct {
+			limit, offset int
+			want          string
+		}{
+			{0, 0, q},
+			{10, 0, q + " FETCH FIRST 10 ROWS ONLY"},
+			{0, 20, q + " OFFSET 20 ROWS"},
+			{10, 20, q + " OFFSET 20 ROWS FETCH FIRST 10 ROWS ONLY"},
+		}
+		for _, c := range cases {
+			if got := dialect.Paginate(d, q, c.limit, c.offset); got != c.want {
+				t.Errorf("Paginate(%d,%d) = %q, want %q", c.limit, c.offset, got, c.want)
+			}
+		}
+	})
+
+	t.Run("MergeSyntax", func(t *testing.T) {
+		m := d.(dialect.Merger)
+		got := m.MergeSyntax("users", []string{"id", "name"}, []string{"id"})
+		want := "MERGE INTO users tgt USING (VALUES (?, ?)) AS src (id, name) ON (tgt.id = src.id)" +
+			" WHEN MATCHED THEN UPDATE SET tgt.name = src.name" +
+			" WHEN NOT MATCHED THEN INSERT (id, name) VALUES (src.id, src.name)"
+		if got != want {
+			t.Errorf("MergeSyntax =\n%s\nwant\n%s", got, want)
+		}
+		got = m.MergeSyntax("tags", []string{"id"}, []string{"id"})
+		want = "MERGE INTO tags tgt USING (VALUES (?)) AS src (id) ON (tgt.id = src.id)" +
+			" WHEN NOT MATCHED THEN INSERT (id) VALUES (src.id)"
+		if got != want {
+			t.Errorf("MergeSyntax keys only =\n%s\nwant\n%s", got, want)
+		}
+		if got := m.MergeSyntax("", []string{"id"}, []string{"id"}); got != "" {
+			t.Errorf("expected empty merge, got %q", got)
+		}
+	})
+}
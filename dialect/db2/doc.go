@@ -0,0 +1,30 @@
+/*
+Package db2 provides the IBM Db2 SQL dialect implementation.
+
+# Overview
+
+The Db2 dialect renders SQL following Db2 for LUW (11.1+) rules:
+
+  - Placeholders are unnumbered parameter markers (?).
+  - Identifiers are double-quoted only when required, with embedded
+    quotes doubled.
+  - Pagination uses OFFSET n ROWS FETCH FIRST m ROWS ONLY.
+  - Upserts are rendered as MERGE INTO ... USING (VALUES ...).
+  - RETURNING is not available; Db2 exposes generated values through
+    SELECT ... FROM FINAL TABLE (...), which is out of scope here.
+
+# Usage
+
+	d := db2.New()
+	sql := dialect.Paginate(d, "SELECT id FROM users ORDER BY id", 10, 20)
+	// SELECT id FROM users ORDER BY id OFFSET 20 ROWS FETCH FIRST 10 ROWS ONLY
+
+# Capabilities
+
+Besides dialect.SQLDialect, the Db2 dialect implements the optional
+dialect.Merger interface:
+
+	m := d.(dialect.Merger)
+	sql = m.MergeSyntax("users", []string{"id", "name"}, []string{"id"})
+*/
+package db2
@@ -0,0 +1,30 @@
+package db2_test
+
+import (
+	"fmt"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/db2"
+)
+
+func Example() {
+	d := db2.New()
+	fmt.Println(d.Name())
+	fmt.Println(d.Placeholder(1))
+	// Output:
+	// db2
+	// ?
+}
+
+func Example_paginate() {
+	fmt.Println(dialect.Paginate(db2.New(), "SELECT id FROM users ORDER BY id", 10, 20))
+	// Output:
+	// SELECT id FROM users ORDER BY id OFFSET 20 ROWS FETCH FIRST 10 ROWS ONLY
+}
+
+func Example_merge() {
+	d := db2.New().(dialect.Merger)
+	fmt.Println(d.MergeSyntax("users", []string{"id", "name"}, []string{"id"}))
+	// Output:
+	// MERGE INTO users tgt USING (VALUES (?, ?)) AS src (id, name) ON (tgt.id = src.id) WHEN MATCHED THEN UPDATE SET tgt.name = src.name WHEN NOT MATCHED THEN INSERT (id, name) VALUES (src.id, src.name)
+}
@@ -33,6 +33,7 @@ Concrete implementations live in subpackages such as:
   - redshift  — Amazon Redshift
   - teradata  — Teradata SQL
   - clickhouse — ClickHouse SQL-like syntax
+  - bigquery  — Google BigQuery (GoogleSQL)
 
 # SQLDialect
 
@@ -54,6 +55,8 @@ The Options struct advertises the capabilities of each dialect:
 	type Options struct {
 	    Name                  string
 	    QuoteStyle            string
+	    QuotePolicy           QuotePolicy
+	    CaseFolding           CaseFolding
 	    PlaceholderStyle      string
 	    AllowMerge            bool
 	    AllowUpsert           bool
@@ -61,9 +64,126 @@ The Options struct advertises the capabilities of each dialect:
 	    EnableReturning       bool
 	    SupportsCTE           bool
 	    SupportsWindowFunctions bool
+	    SupportsNullsOrdering bool
+	    SupportsILike         bool
+	    SupportsArrayBinding  bool
+	    SupportsValuesList    bool
 	    MaxPlaceholderIndex   int
+	    MaxIdentifierLength   int
 	}
 
+# Identifier Quoting
+
+Quoter implements identifier quoting shared by all dialects. Qualified names
+are split on dots outside delimiters and quoted part by part. Embedded
+delimiters are escaped by doubling them, or with a backslash for ClickHouse
+and BigQuery. Options.QuotePolicy selects when a part is quoted:
+
+  - QuoteAsNeeded — parts that are not bare-safe or are reserved (default)
+  - QuoteAlways   — every part
+  - QuoteNever    — nothing; identifiers are emitted untouched
+
+# Case Folding
+
+Options.CaseFolding declares how the server folds unquoted identifiers:
+FoldLower (PostgreSQL, Redshift, Informix), FoldUpper (Oracle, Snowflake,
+Db2, Firebird) or FoldNone. An unquoted name is taken to mean the folded
+identifier and is left bare; an ANSI-quoted name means the exact spelling
+and is re-delimited in the dialect's style. Normalize and Equal compare
+identifiers under a rule, and FoldingOf returns the rule of a dialect.
+
+# Keywords
+
+Each dialect carries its own reserved and non-reserved keyword sets through
+the Keyworder capability: SQL2016 for generic, vendor lists for the others.
+A word such as LEVEL is reserved in Oracle but a valid bare identifier in
+Redshift. QuoteIdentifier quotes the dialect's reserved words under
+QuoteAsNeeded, and KeywordsOf falls back to SQL2016 for other dialects.
+IsReserved reports the small core reserved on every engine.
+
+# Literals
+
+Literal renders strings, numbers, booleans, []byte as binary literals,
+time.Time as typed timestamps in UTC, math/big values and driver.Valuer
+types, and returns ErrUnsupportedLiteral for anything else. QuoteLiteral
+renders the dialect's own forms and hands other values to MustLiteral,
+which panics where Literal would fail: a struct or map is never written
+into a statement. Interpolate inlines the
+args of a built query with Literal, leaving placeholders inside strings,
+quoted identifiers and comments alone. Inlined SQL is for logs, EXPLAIN
+and DDL defaults, not for execution.
+
+Params lists the placeholders of a built query with the argument and the
+column each one binds (email in "email = ?", id in "id IN (?, ?)"), so
+args can be handled by column, as when redacting them from logs.
+Sanitize replaces the literals written into a query with "?", hiding
+values that were not bound.
+
+# Comments
+
+Comment tags a query with key/value pairs in a trailing comment of the
+sqlcommenter format, such as app='billing',route='%2Forders', URL-encoded
+so no value can end the comment. Tags are merged into a comment left by an earlier call. The
+comment is appended, before trailing semicolons, by AppendComment, or
+placed by dialects implementing Commenter, such as ClickHouse.
+
+# Functions
+
+RenderFunction renders canonical functions (FuncCoalesce, FuncSubstring,
+FuncDateTrunc, ...) for a dialect. Translations come from the table set
+with Options.WithFunctions, which overrides or extends a dialect per
+instance, then from the FunctionMapper capability, then from
+StandardFunctions. Functions without a translation yield ErrNoTranslation.
+
+# Parameter Limits
+
+Options.MaxPlaceholderIndex caps the placeholders of one statement.
+CheckPlaceholders reports an overflow as a *PlaceholderLimitError, which
+matches ErrTooManyPlaceholders, and RowsPerStatement sizes the batches of
+a multi-row insert. The postgres (65535), mssql (2099) and sqlite (999)
+dialects declare their limits; postgres also binds arrays
+(SupportsArrayBinding).
+
+# Error Classification
+
+ClassifyError wraps driver errors in a *errors.DriverError matching the
+classes of the errors package (ErrUniqueViolation, ErrDeadlock, ...). The
+SQLSTATE and vendor codes returned by DriverCodes are looked up in
+Options.ErrorCodes, then in the ErrorClassifier capability, then in
+StandardErrorCodes. MySQLErrorCodes and SQLServerErrorCodes are the codes
+of the mysql and mssql dialects; set them with Options.WithErrorCodes to use
+them with another dialect, as for TiDB or MariaDB.
+
+# Optional Capabilities
+
+Vendor behaviors that cannot be expressed through SQLDialect alone are
+exposed as optional interfaces, detected with a type assertion:
+
+  - Paginator           — rewrites a statement to paginate it (e.g. ROWNUM)
+  - TableAliaser        — renders table aliases (e.g. without AS)
+  - IdentifierValidator — enforces vendor identifier limits
+  - NamedBinder         — renders named bind variables
+  - Returner            — renders RETURNING clauses
+  - Merger              — renders upserts as MERGE statements
+  - Upserter            — renders native non-MERGE upserts
+  - Qualifier           — renders QUALIFY clauses
+  - Sampler             — renders SAMPLE / TABLESAMPLE clauses
+  - GroupLimiter        — renders per-group limits (LIMIT n BY)
+  - Keyworder           — reports the dialect's keyword sets
+  - FunctionMapper      — translates canonical functions
+  - BinaryQuoter        — renders binary literals
+  - TimeQuoter          — renders typed timestamp literals
+  - Savepointer         — renders savepoint statements
+  - ErrorClassifier     — maps vendor error codes
+
+The Paginate and AliasTable helpers use these interfaces when available
+and fall back to SQLDialect and Options otherwise. PrefixSelect inserts a
+clause right after the leading SELECT keyword, for dialects paginating in
+the projection (FIRST/SKIP). Savepoint, ReleaseSavepoint and
+RollbackToSavepoint render savepoint statements, standard SQL unless the
+dialect implements Savepointer; Savepoint fails with ErrNoSavepoints on
+engines without savepoints.
+
 # Usage
 
 Clients typically obtain a dialect from a subpackage:
@@ -0,0 +1,32 @@
+/*
+Package firebird provides the Firebird SQL dialect implementation.
+
+# Overview
+
+The Firebird dialect renders SQL following Firebird 3.0+ rules:
+
+  - Placeholders are unnumbered parameter markers (?).
+  - Identifiers are double-quoted only when required, with embedded
+    quotes doubled. Identifiers are limited to 63 characters (Firebird 4).
+  - Pagination uses the trailing ROWS m TO n clause. Offset-only queries,
+    which ROWS cannot express, fall back to SKIP after the SELECT keyword.
+  - FirstSkip renders the FIRST/SKIP form supported by every release.
+  - RETURNING is supported for single-row INSERT, UPDATE and DELETE.
+  - Upserts are rendered as UPDATE OR INSERT ... MATCHING, or as MERGE.
+
+# Usage
+
+	d := firebird.New()
+	sql := dialect.Paginate(d, "SELECT id FROM users ORDER BY id", 10, 20)
+	// SELECT id FROM users ORDER BY id ROWS 21 TO 30
+
+	sql = firebird.FirstSkip("SELECT id FROM users ORDER BY id", 10, 20)
+	// SELECT FIRST 10 SKIP 20 id FROM users ORDER BY id
+
+# Capabilities
+
+Besides dialect.SQLDialect, the Firebird dialect implements the optional
+capability interfaces Paginator, Returner, Upserter and Merger declared
+in the dialect package.
+*/
+package firebird
@@ -0,0 +1,39 @@
+package firebird_test
+
+import (
+	"fmt"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/firebird"
+)
+
+func Example() {
+	d := firebird.New()
+	fmt.Println(d.Name())
+	fmt.Println(d.Placeholder(1))
+	// Output:
+	// firebird
+	// ?
+}
+
+func Example_paginate() {
+	d := firebird.New()
+	fmt.Println(dialect.Paginate(d, "SELECT id FROM users ORDER BY id", 10, 20))
+	fmt.Println(dialect.Paginate(d, "SELECT id FROM users ORDER BY id", 0, 20))
+	// Output:
+	// SELECT id FROM users ORDER BY id ROWS 21 TO 30
+	// SELECT SKIP 20 id FROM users ORDER BY id
+}
+
+func ExampleFirstSkip() {
+	fmt.Println(firebird.FirstSkip("SELECT id FROM users ORDER BY id", 10, 20))
+	// Output:
+	// SELECT FIRST 10 SKIP 20 id FROM users ORDER BY id
+}
+
+func Example_upsert() {
+	d := firebird.New().(dialect.Upserter)
+	fmt.Println(d.UpsertSyntax("users", []string{"id", "name"}, []string{"id"}))
+	// Output:
+	// UPDATE OR INSERT INTO users (id, name) VALUES (?, ?) MATCHING (id)
+}
@@ -0,0 +1,314 @@
+package firebird
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+	dberrors "github.com/entiqon/db/errors"
+)
+
+//
+// Firebird Dialect
+//
+
+// dialectImpl provides the Firebird implementation of the dialect.SQLDialect
+// interface. It is unexported to prevent direct instantiation; consumers should
+// always use the New() constructor.
+//
+// Key behaviors:
+//   - Identifiers are quoted using double quotes (") only when required.
+//   - Placeholders are unnumbered parameter markers "?".
+//   - Pagination uses ROWS m TO n; offset-only queries fall back to SKIP.
+//   - RETURNING is supported for single-row INSERT/UPDATE/DELETE.
+//   - Upserts are rendered as UPDATE OR INSERT ... MATCHING, or MERGE.
+type dialectImpl struct {
+	opts dialect.Options
+}
+
+// Compile-time checks: ensure dialectImpl implements SQLDialect and the
+// optional capabilities it advertises.
+var (
+	_ dialect.SQLDialect      = (*dialectImpl)(nil)
+	_ dialect.Paginator       = (*dialectImpl)(nil)
+	_ dialect.Returner        = (*dialectImpl)(nil)
+	_ dialect.Merger          = (*dialectImpl)(nil)
+	_ dialect.Upserter        = (*dialectImpl)(nil)
+	_ dialect.Keyworder       = (*dialectImpl)(nil)
+	_ dialect.BinaryQuoter    = (*dialectImpl)(nil)
+	_ dialect.TimeQuoter      = (*dialectImpl)(nil)
+	_ dialect.ErrorClassifier = (*dialectImpl)(nil)
+)
+
+// bareIdentifier matches identifiers that can be emitted without quotes.
+var bareIdentifier = regexp.MustCompile(`^[a-z][a-z0-9_$]*$`)
+
+//
+// Constructors
+//
+
+// New returns a Firebird (3.0+) dialect. The returned value implements the
+// dialect.SQLDialect interface.
+//
+// Example:
+//
+//	d := firebird.New()
+//	d.PaginationSyntax(10, 20) // → " ROWS 21 TO 30"
+func New() dialect.SQLDialect {
+	return &dialectImpl{
+		opts: dialect.Options{
+			Name:                    "firebird",
+			QuoteStyle:              `"`,
+			CaseFolding:             dialect.FoldUpper,
+			PlaceholderStyle:        "?",
+			AllowMerge:              true,
+			AllowUpsert:             true,
+			ForcedAliasing:          true,
+			EnableReturning:         true,
+			SupportsCTE:             true,
+			SupportsWindowFunctions: true,
+			SupportsNullsOrdering:   true,
+			SupportsILike:           false,
+			SupportsArrayBinding:    false,
+			SupportsValuesList:      false,
+			MaxPlaceholderIndex:     0,
+			MaxIdentifierLength:     63,
+		},
+	}
+}
+
+// NewWithOptions creates a Firebird dialect with the given static options.
+// Options are immutable once the dialect is constructed.
+func NewWithOptions(opts dialect.Options) dialect.SQLDialect {
+	return &dialectImpl{opts: opts}
+}
+
+//
+// Interface Implementation
+//
+
+// Name returns the identifier of this dialect, "firebird" by default.
+func (d *dialectImpl) Name() string {
+	return d.opts.Name
+}
+
+// Options returns the feature capability matrix for the Firebird dialect.
+func (d *dialectImpl) Options() dialect.Options {
+	return d.opts
+}
+
+// QuoteIdentifier returns a Firebird identifier. Lowercase simple names are
+// returned as-is; anything else is wrapped in double quotes, with embedded
+// double quotes escaped by doubling them.
+//
+// Qualified names are handled part by part and reserved words are
+// quoted; see Options().QuotePolicy to quote always or never.
+//
+// Example:
+//
+//	d.QuoteIdentifier("users")      // → users
+//	d.QuoteIdentifier("UserData")   // → UserData, resolved as USERDATA
+//	d.QuoteIdentifier(`"UserData"`) // → "UserData"
+func (d *dialectImpl) QuoteIdentifier(name string) string {
+	q := dialect.NewQuoter(d.opts, bareIdentifier.MatchString)
+	q.Reserved = keywords.IsReserved
+	return q.Quote(name)
+}
+
+// Keywords returns the Firebird keyword set. Firebird-only reserved words
+// such as DELETING, GDSCODE and POST_EVENT are included.
+func (d *dialectImpl) Keywords() dialect.Keywords {
+	return keywords
+}
+
+// QuoteLiteral quotes a literal value for inline use in Firebird SQL.
+//
+// Supported types:
+//   - nil       → NULL
+//   - string    → escaped and wrapped in single quotes
+//   - bool      → TRUE or FALSE (Firebird 3.0+)
+//   - numbers   → rendered in decimal form
+//   - time.Time → TIMESTAMP 'YYYY-MM-DD HH:MM:SS' in UTC
+//   - []byte    → X'..' (Firebird 2.5+)
+//   - others    → dialect.MustLiteral
+func (d *dialectImpl) QuoteLiteral(literal any) string {
+	switch v := literal.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return "'" + strings.ReplaceAll(v, "'", "''") + "'"
+	case bool:
+		if v {
+			return "TRUE"
+		}
+		return "FALSE"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 64)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []byte:
+		return d.QuoteBinary(v)
+	case time.Time:
+		return dialect.QuoteTime(d, v)
+	default:
+		return dialect.MustLiteral(d, v)
+	}
+}
+
+// QuoteBinary renders data as a hexadecimal binary string literal.
+//
+// Example:
+//
+//	d.QuoteBinary([]byte("hi")) // → X'6869'
+func (d *dialectImpl) QuoteBinary(data []byte) string {
+	return "X'" + strings.ToUpper(hex.EncodeToString(data)) + "'"
+}
+
+// QuoteTime renders t as a TIMESTAMP literal in UTC. Firebird stores
+// timestamps with a precision of 1/10000 second.
+//
+// Example:
+//
+//	d.QuoteTime(t) // → TIMESTAMP '2025-09-19 03:30:00.1234'
+func (d *dialectImpl) QuoteTime(t time.Time) string {
+	return fmt.Sprintf("TIMESTAMP '%s'", t.UTC().Format("2006-01-02 15:04:05.9999"))
+}
+
+// PaginationSyntax renders the trailing ROWS clause. ROWS is one-based and
+// inclusive, so offset 20 and limit 10 select rows 21 to 30. ROWS requires
+// an upper bound, so an offset without limit cannot be expressed and yields
+// an empty string; use Paginate, which falls back to SKIP.
+//
+// Example:
+//
+//	d.PaginationSyntax(10, 0)  // → " ROWS 10"
+//	d.PaginationSyntax(10, 20) // → " ROWS 21 TO 30"
+//	d.PaginationSyntax(0, 20)  // → ""
+func (d *dialectImpl) PaginationSyntax(limit, offset int) string {
+	switch {
+	case limit <= 0:
+		return ""
+	case offset > 0:
+		return fmt.Sprintf(" ROWS %d TO %d", offset+1, offset+limit)
+	default:
+		return fmt.Sprintf(" ROWS %d", limit)
+	}
+}
+
+// Paginate applies limit and offset to query. The ROWS clause is appended
+// whenever a limit is present; offset-only queries use SKIP.
+//
+// Example:
+//
+//	d.Paginate("SELECT id FROM users", 10, 20) // → SELECT id FROM users ROWS 21 TO 30
+//	d.Paginate("SELECT id FROM users", 0, 20)  // → SELECT SKIP 20 id FROM users
+func (d *dialectImpl) Paginate(query string, limit, offset int) string {
+	if limit > 0 {
+		return query + d.PaginationSyntax(limit, offset)
+	}
+	return FirstSkip(query, limit, offset)
+}
+
+// Placeholder always returns "?" for Firebird. The index parameter is
+// ignored since parameter markers are not numbered.
+func (d *dialectImpl) Placeholder(_ int) string {
+	return d.opts.PlaceholderStyle
+}
+
+// ReturningSyntax renders "RETURNING ..." for the given columns. Firebird
+// returns values as a result set, so nextIndex is ignored.
+//
+// Example:
+//
+//	d.ReturningSyntax([]string{"id"}, 1) // → RETURNING id
+func (d *dialectImpl) ReturningSyntax(columns []string, _ int) string {
+	if len(columns) == 0 {
+		return ""
+	}
+	cols := make([]string, len(columns))
+	for i, c := range columns {
+		cols[i] = d.QuoteIdentifier(c)
+	}
+	return "RETURNING " + strings.Join(cols, ", ")
+}
+
+// UpsertSyntax renders Firebird's native UPDATE OR INSERT statement.
+//
+// Example:
+//
+//	d.UpsertSyntax("users", []string{"id", "name"}, []string{"id"})
+//	// UPDATE OR INSERT INTO users (id, name) VALUES (?, ?) MATCHING (id)
+func (d *dialectImpl) UpsertSyntax(table string, columns, keys []string) string {
+	if table == "" || len(columns) == 0 || len(keys) == 0 {
+		return ""
+	}
+	cols := make([]string, len(columns))
+	marks := make([]string, len(columns))
+	for i, c := range columns {
+		cols[i] = d.QuoteIdentifier(c)
+		marks[i] = d.Placeholder(i + 1)
+	}
+	matching := make([]string, len(keys))
+	for i, k := range keys {
+		matching[i] = d.QuoteIdentifier(k)
+	}
+	return fmt.Sprintf(
+		"UPDATE OR INSERT INTO %s (%s) VALUES (%s) MATCHING (%s)",
+		d.QuoteIdentifier(table), strings.Join(cols, ", "),
+		strings.Join(marks, ", "), strings.Join(matching, ", "),
+	)
+}
+
+// MergeSyntax renders an upsert as a MERGE statement reading a single row
+// of parameter markers from RDB$DATABASE.
+//
+// Example:
+//
+//	d.MergeSyntax("users", []string{"id", "name"}, []string{"id"})
+//	// MERGE INTO users tgt USING (SELECT ? AS id, ? AS name FROM RDB$DATABASE) src
+//	//   ON (tgt.id = src.id)
+//	//   WHEN MATCHED THEN UPDATE SET tgt.name = src.name
+//	//   WHEN NOT MATCHED THEN INSERT (id, name) VALUES (src.id, src.name)
+func (d *dialectImpl) MergeSyntax(table string, columns, keys []string) string {
+	return dialect.Merge(d, table, columns, keys, dialect.MergeStyle{Source: dialect.MergeSelect("RDB$DATABASE")})
+}
+
+// FirstSkip paginates query with the FIRST/SKIP clause placed right after
+// the leading SELECT keyword. It is supported by every Firebird version,
+// including 1.x where ROWS is unavailable.
+//
+// Example:
+//
+//	firebird.FirstSkip("SELECT id FROM users", 10, 20)
+//	// SELECT FIRST 10 SKIP 20 id FROM users
+func FirstSkip(query string, limit, offset int) string {
+	var parts []string
+	if limit > 0 {
+		parts = append(parts, fmt.Sprintf("FIRST %d", limit))
+	}
+	if offset > 0 {
+		parts = append(parts, fmt.Sprintf("SKIP %d", offset))
+	}
+	return dialect.PrefixSelect(query, strings.Join(parts, " "))
+}
+
+// ErrorCodes returns the Firebird ISC status codes.
+func (d *dialectImpl) ErrorCodes() dialect.ErrorCodes {
+	return dialect.ErrorCodes{
+		"335544665": dberrors.ErrUniqueViolation,     // isc_unique_key_violation
+		"335544349": dberrors.ErrUniqueViolation,     // isc_no_dup
+		"335544466": dberrors.ErrForeignKeyViolation, // isc_foreign_key
+		"335544838": dberrors.ErrForeignKeyViolation, // isc_foreign_key_target_doesnt_exist
+		"335544839": dberrors.ErrForeignKeyViolation, // isc_foreign_key_references_present
+		"335544336": dberrors.ErrDeadlock,            // isc_deadlock
+		"335544510": dberrors.ErrLockTimeout,         // isc_lock_timeout
+		"335544345": dberrors.ErrLockTimeout,         // isc_lock_conflict
+		"335544794": dberrors.ErrQueryCanceled,       // isc_cancelled
+	}
+}
@@ -0,0 +1,177 @@
+package firebird_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/firebird"
+)
+
+func TestFirebirdDialect(t *testing.T) {
+	d := firebird.New()
+
+	t.Run("Name", func(t *testing.T) {
+		if got, want := d.Name(), "firebird"; got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+		if got := firebird.NewWithOptions(dialect.Options{Name: "fb25"}).Name(); got != "fb25" {
+			t.Errorf("expected %q, got %q", "fb25", got)
+		}
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		opts := d.Options()
+		if !opts.AllowMerge || !opts.AllowUpsert || !opts.EnableReturning {
+			t.Error("expected MERGE, UPSERT and RETURNING to be enabled")
+		}
+		if opts.MaxIdentifierLength != 63 {
+			t.Errorf("unexpected MaxIdentifierLength = %d", opts.MaxIdentifierLength)
+		}
+	})
+
+	t.Run("QuoteIdentifier", func(t *testing.T) {
+		cases := []struct {
+			in   string
+			want string
+		}{
+			{"users", "users"},
+			{"rdb$relations", "rdb$relations"},
+			{"UserData", "UserData"},
+			{`"UserData"`, `"UserData"`},
+			{`odd"name`, `"odd""name"`},
+			{"", ""},
+		}
+		for _, c := range cases {
+			if got := d.QuoteIdentifier(c.in); got != c.want {
+				t.Errorf("QuoteIdentifier(%q) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("QuoteLiteral", func(t *testing.T) {
+		now := time.Date(2025, 9, 19, 3, 30, 0, 0, time.UTC)
+		cases := []struct {
+			in   any
+			want string
+		}{
+			{nil, "NULL"},
+			{"O'Reilly", "'O''Reilly'"},
+			{true, "TRUE"},
+			{false, "FALSE"},
+			{uint(9), "9"},
+			{float32(2.5), "2.5"},
+			{0.1, "0.1"},
+			{now, "TIMESTAMP '2025-09-19 03:30:00'"},
+			{now.Add(123456 * time.Microsecond), "TIMESTAMP '2025-09-19 03:30:00.1234'"},
+			{[]byte("hi"), "X'6869'"},
+		}
+		for _, c := range cases {
+			if got := d.QuoteLiteral(c.in); got != c.want {
+				t.Errorf("QuoteLiteral(%v) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("Placeholder", func(t *testing.T) {
+		if got := d.Placeholder(3); got != "?" {
+			t.Errorf("Placeholder(3) = %q, want '?'", got)
+		}
+	})
+
+	t.Run("PaginationSyntax", func(t *testing.T) {
+		cases := []struct {
+			limit, offset int
+			want          string
+		}{
+			{0, 0, ""},
+			{10, 0, " ROWS 10"},
+			{10, 20, " ROWS 21 TO 30"},
+			{0, 20, ""},
+		}
+		for _, c := range cases {
+			if got := d.PaginationSyntax(c.limit, c.offset); got != c.want {
+				t.Errorf("PaginationSyntax(%d,%d) = %q, want %q", c.limit, c.offset, got, c.want)
+			}
+		}
+	})
+
+	t.Run("Paginate", func(t *testing.T) {
+		const q = "SELECT id FROM users ORDER BY id"
+		cases := []struct {
+			limit, offset int
+			want          string
+		}{
+			{0, 0, q},
+			{10, 0, q + " ROWS 10"},
+			{10, 20, q + " ROWS 21 TO 30"},
+			{0, 20, "SELECT SKIP 20 id FROM users ORDER BY id"},
+		}
+		for _, c := range cases {
+			if got := dialect.Paginate(d, q, c.limit, c.offset); got != c.want {
+				t.Errorf("Paginate(%d,%d) = %q, want %q", c.limit, c.offset, got, c.want)
+			}
+		}
+	})
+
+	t.Run("FirstSkip", func(t *testing.T) {
+		cases := []struct {
+			query         string
+			limit, offset int
+			want          string
+		}{
+			{"SELECT id FROM users", 10, 20, "SELECT FIRST 10 SKIP 20 id FROM users"},
+			{"select distinct id from users", 10, 0, "select FIRST 10 distinct id from users"},
+			{"SELECT id FROM users", 0, 0, "SELECT id FROM users"},
+			{"WITH t AS (SELECT 1 AS id FROM RDB$DATABASE) SELECT id FROM t", 5, 0,
+				"SELECT FIRST 5 * FROM (WITH t AS (SELECT 1 AS id FROM RDB$DATABASE) SELECT id FROM t) q_"},
+		}
+		for _, c := range cases {
+			if got := firebird.FirstSkip(c.query, c.limit, c.offset); got != c.want {
+				t.Errorf("FirstSkip(%q,%d,%d) = %q, want %q", c.query, c.limit, c.offset, got, c.want)
+			}
+		}
+	})
+
+	t.Run("ReturningSyntax", func(t *testing.T) {
+		r := d.(dialect.Returner)
+		if got := r.ReturningSyntax([]string{"id", `"CreatedAt"`}, 4); got != `RETURNING id, "CreatedAt"` {
+			t.Errorf("unexpected returning %q", got)
+		}
+		if got := r.ReturningSyntax(nil, 1); got != "" {
+			t.Errorf("expected empty returning, got %q", got)
+		}
+	})
+
+	t.Run("UpsertSyntax", func(t *testing.T) {
+		u := d.(dialect.Upserter)
+		got := u.UpsertSyntax("users", []string{"id", "name"}, []string{"id"})
+		want := "UPDATE OR INSERT INTO users (id, name) VALUES (?, ?) MATCHING (id)"
+		if got != want {
+			t.Errorf("UpsertSyntax = %q, want %q", got, want)
+		}
+		if got := u.UpsertSyntax("users", []string{"id"}, nil); got != "" {
+			t.Errorf("expected empty upsert, got %q", got)
+		}
+	})
+
+	t.Run("MergeSyntax", func(t *testing.T) {
+		m := d.(dialect.Merger)
+		got := m.MergeSyntax("users", []string{"id", "name"}, []string{"id"})
+		want := "MERGE INTO users tgt USING (SELECT ? AS id, ? AS name FROM RDB$DATABASE) src ON (tgt.id = src.id)" +
+			" WHEN MATCHED THEN UPDATE SET tgt.name = src.name" +
+			" WHEN NOT MATCHED THEN INSERT (id, name) VALUES (src.id, src.name)"
+		if got != want {
+			t.Errorf("MergeSyntax =\n%s\nwant\n%s", got, want)
+		}
+		got = m.MergeSyntax("tags", []string{"id"}, []string{"id"})
+		want = "MERGE INTO tags tgt USING (SELECT ? AS id FROM RDB$DATABASE) src ON (tgt.id = src.id)" +
+			" WHEN NOT MATCHED THEN INSERT (id) VALUES (src.id)"
+		if got != want {
+			t.Errorf("MergeSyntax keys only =\n%s\nwant\n%s", got, want)
+		}
+		if got := m.MergeSyntax("users", nil, []string{"id"}); got != "" {
+			t.Errorf("expected empty merge, got %q", got)
+		}
+	})
+}
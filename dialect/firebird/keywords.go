@@ -0,0 +1,52 @@
+package firebird
+
+import "github.com/entiqon/db/dialect"
+
+// keywords holds the Firebird 3.0+ reserved words and the non-reserved
+// keywords listed in the Firebird Language Reference.
+var keywords = dialect.NewKeywords(
+	[]string{
+		"ADD", "ADMIN", "ALL", "ALTER", "AND", "ANY", "AS", "AT", "AVG",
+		"BEGIN", "BETWEEN", "BIGINT", "BIT_LENGTH", "BLOB", "BOOLEAN", "BOTH",
+		"BY", "CASE", "CAST", "CHAR", "CHAR_LENGTH", "CHARACTER",
+		"CHARACTER_LENGTH", "CHECK", "CLOSE", "COLLATE", "COLUMN", "COMMIT",
+		"CONNECT", "CONSTRAINT", "CORR", "COUNT", "COVAR_POP", "COVAR_SAMP",
+		"CREATE", "CROSS", "CURRENT", "CURRENT_CONNECTION", "CURRENT_DATE",
+		"CURRENT_ROLE", "CURRENT_TIME", "CURRENT_TIMESTAMP",
+		"CURRENT_TRANSACTION", "CURRENT_USER", "CURSOR", "DATE", "DAY", "DEC",
+		"DECIMAL", "DECLARE", "DEFAULT", "DELETE", "DELETING",
+		"DETERMINISTIC", "DISCONNECT", "DISTINCT", "DOUBLE", "DROP", "ELSE",
+		"END", "ESCAPE", "EXECUTE", "EXISTS", "EXTERNAL", "EXTRACT", "FALSE",
+		"FETCH", "FILTER", "FLOAT", "FOR", "FOREIGN", "FROM", "FULL",
+		"FUNCTION", "GDSCODE", "GLOBAL", "GRANT", "GROUP", "HAVING", "HOUR",
+		"IN", "INDEX", "INNER", "INSENSITIVE", "INSERT", "INSERTING", "INT",
+		"INTEGER", "INTO", "IS", "JOIN", "LEADING", "LEFT", "LIKE", "LONG",
+		"LOWER", "MAX", "MERGE", "MIN", "MINUTE", "MONTH", "NATIONAL",
+		"NATURAL", "NCHAR", "NO", "NOT", "NULL", "NUMERIC", "OCTET_LENGTH",
+		"OF", "OFFSET", "ON", "ONLY", "OPEN", "OR", "ORDER", "OUTER", "OVER",
+		"PARAMETER", "PLAN", "POSITION", "POST_EVENT", "PRECISION", "PRIMARY",
+		"PROCEDURE", "RDB$DB_KEY", "RDB$RECORD_VERSION", "REAL",
+		"RECORD_VERSION", "RECREATE", "RECURSIVE", "REFERENCES", "REGR_AVGX",
+		"REGR_AVGY", "REGR_COUNT", "REGR_INTERCEPT", "REGR_R2", "REGR_SLOPE",
+		"REGR_SXX", "REGR_SXY", "REGR_SYY", "RELEASE", "RETURN",
+		"RETURNING_VALUES", "RETURNS", "REVOKE", "RIGHT", "ROLLBACK", "ROW",
+		"ROW_COUNT", "ROWS", "SAVEPOINT", "SCROLL", "SECOND", "SELECT",
+		"SENSITIVE", "SET", "SIMILAR", "SMALLINT", "SOME", "SQLCODE",
+		"SQLSTATE", "START", "STDDEV_POP", "STDDEV_SAMP", "SUM", "TABLE",
+		"THEN", "TIME", "TIMESTAMP", "TO", "TRAILING", "TRIGGER", "TRIM",
+		"TRUE", "UNION", "UNIQUE", "UNKNOWN", "UPDATE", "UPDATING", "UPPER",
+		"USER", "USING", "VALUE", "VALUES", "VAR_POP", "VAR_SAMP", "VARCHAR",
+		"VARIABLE", "VARYING", "VIEW", "WHEN", "WHERE", "WHILE", "WITH",
+		"YEAR",
+	},
+	[]string{
+		"ABS", "ACTION", "ACTIVE", "AFTER", "ALWAYS", "ASC", "BEFORE", "BLOCK",
+		"CASCADE", "COALESCE", "COMMENT", "COMMITTED", "DATA", "DATABASE",
+		"DESC", "DOMAIN", "ENGINE", "EXCEPTION", "FIRST", "GENERATED",
+		"IDENTITY", "IGNORE", "INACTIVE", "KEY", "LAST", "LEVEL", "LIST",
+		"LOCK", "MATCHED", "MATCHING", "NAME", "NEXT", "NULLS", "PACKAGE",
+		"PASSWORD", "POSITION", "PRIVILEGES", "RESTART", "RETURNING", "ROLE",
+		"SEQUENCE", "SIZE", "SKIP", "SOURCE", "TEMPORARY", "TRUNCATE", "TYPE",
+		"WORK",
+	},
+)
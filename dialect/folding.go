@@ -0,0 +1,105 @@
+package dialect
+
+import "strings"
+
+// CaseFolding describes how a dialect resolves unquoted identifiers.
+//
+// Servers fold unquoted names before looking them up: PostgreSQL stores
+// UserId as userid, Oracle and Snowflake as USERID. Quoted names are kept
+// exactly as written. The folding rule lets renderers honor the author's
+// intent: an unquoted name refers to the folded identifier, a quoted one
+// to the exact spelling.
+//
+// The zero value is FoldNone, so dialects constructed without an explicit
+// rule keep delimiting every name whose case the bare pattern rejects.
+type CaseFolding uint8
+
+const (
+	// FoldNone leaves unquoted identifiers as written (ClickHouse,
+	// BigQuery, MySQL on case-sensitive file systems).
+	FoldNone CaseFolding = iota
+
+	// FoldLower folds unquoted identifiers to lower case (PostgreSQL,
+	// Redshift, Informix).
+	FoldLower
+
+	// FoldUpper folds unquoted identifiers to upper case, as the SQL
+	// standard requires (Oracle, Snowflake, Db2, Firebird).
+	FoldUpper
+)
+
+// String returns the name of the folding rule.
+func (f CaseFolding) String() string {
+	switch f {
+	case FoldNone:
+		return "none"
+	case FoldLower:
+		return "lower"
+	case FoldUpper:
+		return "upper"
+	default:
+		return "unknown"
+	}
+}
+
+// Fold returns part as the server stores it when written unquoted.
+func (f CaseFolding) Fold(part string) string {
+	switch f {
+	case FoldLower:
+		return strings.ToLower(part)
+	case FoldUpper:
+		return strings.ToUpper(part)
+	default:
+		return part
+	}
+}
+
+// Normalize returns the name a possibly qualified identifier resolves to:
+// ANSI-quoted parts ("UserId") are unquoted and kept exact, unquoted parts
+// are folded.
+//
+// Example:
+//
+//	dialect.FoldUpper.Normalize(`sales."Orders"`) // → SALES.Orders
+//	dialect.FoldLower.Normalize("Sales.Orders")   // → sales.orders
+func (f CaseFolding) Normalize(name string) string {
+	parts := SplitQualified(strings.TrimSpace(name), `"`, `"`)
+	for i, p := range parts {
+		if u, ok := Unquote(p); ok {
+			parts[i] = u
+		} else {
+			parts[i] = f.Fold(p)
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// Equal reports whether identifiers a and b resolve to the same name under
+// the folding rule.
+//
+// Example:
+//
+//	dialect.FoldLower.Equal("Users", "users")    // → true
+//	dialect.FoldLower.Equal(`"Users"`, "users")  // → false
+//	dialect.FoldUpper.Equal(`"USERS"`, "users")  // → true
+func (f CaseFolding) Equal(a, b string) bool {
+	return f.Normalize(a) == f.Normalize(b)
+}
+
+// FoldingOf returns the CaseFolding declared by d, or FoldNone for a nil
+// dialect.
+func FoldingOf(d SQLDialect) CaseFolding {
+	if d == nil {
+		return FoldNone
+	}
+	return d.Options().CaseFolding
+}
+
+// Unquote returns the content of an ANSI-quoted identifier part ("a""b" →
+// a"b) and true, or part and false when it is not quoted.
+func Unquote(part string) (string, bool) {
+	if len(part) < 2 || part[0] != '"' || part[len(part)-1] != '"' {
+		return part, false
+	}
+	return strings.ReplaceAll(part[1:len(part)-1], `""`, `"`), true
+}
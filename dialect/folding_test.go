@@ -0,0 +1,90 @@
+package dialect_test
+
+import (
+	"testing"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/clickhouse"
+	"github.com/entiqon/db/dialect/generic"
+	"github.com/entiqon/db/dialect/oracle"
+)
+
+func TestCaseFolding(t *testing.T) {
+	t.Run("String", func(t *testing.T) {
+		for f, want := range map[dialect.CaseFolding]string{
+			dialect.FoldNone:  "none",
+			dialect.FoldLower: "lower",
+			dialect.FoldUpper: "upper",
+			9:                 "unknown",
+		} {
+			if got := f.String(); got != want {
+				t.Errorf("expected %q, got %q", want, got)
+			}
+		}
+	})
+
+	t.Run("Normalize", func(t *testing.T) {
+		cases := []struct {
+			folding dialect.CaseFolding
+			in      string
+			want    string
+		}{
+			{dialect.FoldLower, "Sales.Orders", "sales.orders"},
+			{dialect.FoldUpper, `sales."Orders"`, "SALES.Orders"},
+			{dialect.FoldUpper, `"odd""name"`, `odd"name`},
+			{dialect.FoldNone, "Sales.Orders", "Sales.Orders"},
+			{dialect.FoldLower, " users ", "users"},
+		}
+		for _, c := range cases {
+			if got := c.folding.Normalize(c.in); got != c.want {
+				t.Errorf("[%s] Normalize(%q) = %q, want %q", c.folding, c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("Equal", func(t *testing.T) {
+		cases := []struct {
+			folding dialect.CaseFolding
+			a, b    string
+			want    bool
+		}{
+			{dialect.FoldLower, "Users", "users", true},
+			{dialect.FoldLower, `"Users"`, "users", false},
+			{dialect.FoldLower, `"users"`, "USERS", true},
+			{dialect.FoldUpper, `"USERS"`, "users", true},
+			{dialect.FoldUpper, `"Users"`, "users", false},
+			{dialect.FoldNone, "Users", "users", false},
+		}
+		for _, c := range cases {
+			if got := c.folding.Equal(c.a, c.b); got != c.want {
+				t.Errorf("[%s] Equal(%q, %q) = %v, want %v", c.folding, c.a, c.b, got, c.want)
+			}
+		}
+	})
+
+	t.Run("FoldingOf", func(t *testing.T) {
+		cases := []struct {
+			d    dialect.SQLDialect
+			want dialect.CaseFolding
+		}{
+			{nil, dialect.FoldNone},
+			{generic.New(), dialect.FoldLower},
+			{oracle.New(), dialect.FoldUpper},
+			{clickhouse.New(), dialect.FoldNone},
+		}
+		for _, c := range cases {
+			if got := dialect.FoldingOf(c.d); got != c.want {
+				t.Errorf("expected %s, got %s", c.want, got)
+			}
+		}
+	})
+
+	t.Run("Unquote", func(t *testing.T) {
+		if got, ok := dialect.Unquote(`"a""b"`); !ok || got != `a"b` {
+			t.Errorf("unexpected %q %v", got, ok)
+		}
+		if got, ok := dialect.Unquote("users"); ok || got != "users" {
+			t.Errorf("unexpected %q %v", got, ok)
+		}
+	})
+}
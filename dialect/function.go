@@ -0,0 +1,109 @@
+package dialect
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Canonical function names. Builders express portable functions with these
+// names (see token/fn) and each dialect translates them to its own syntax.
+const (
+	FuncCoalesce  = "COALESCE"
+	FuncNow       = "NOW"
+	FuncConcat    = "CONCAT"
+	FuncSubstring = "SUBSTRING"
+	FuncDateTrunc = "DATE_TRUNC"
+	FuncLength    = "LENGTH"
+	FuncCast      = "CAST"
+)
+
+// ErrNoTranslation is returned by RenderFunction when a dialect has no
+// rendering for a canonical function.
+var ErrNoTranslation = errors.New("no function translation")
+
+// FunctionFunc renders a canonical function from its already rendered
+// arguments. Arguments are SQL expressions, except for:
+//   - SUBSTRING: expr, start and optional length
+//   - DATE_TRUNC: a lowercase unit ("month") followed by the expression
+//   - CAST: the expression followed by the target type
+type FunctionFunc func(args []string) (string, error)
+
+// FunctionMap maps canonical function names to their renderings. A nil
+// entry marks the function as unsupported.
+type FunctionMap map[string]FunctionFunc
+
+// StandardFunctions renders canonical functions in SQL standard syntax. It
+// is used for dialects without a translation of their own. DATE_TRUNC has
+// no standard form and is absent.
+var StandardFunctions = FunctionMap{
+	FuncCoalesce: Call("COALESCE"),
+	FuncNow:      Keyword("CURRENT_TIMESTAMP"),
+	FuncConcat:   Infix("||"),
+	FuncSubstring: func(args []string) (string, error) {
+		if len(args) == 3 {
+			return fmt.Sprintf("SUBSTRING(%s FROM %s FOR %s)", args[0], args[1], args[2]), nil
+		}
+		return fmt.Sprintf("SUBSTRING(%s FROM %s)", args[0], args[1]), nil
+	},
+	FuncLength: Call("CHAR_LENGTH"),
+	FuncCast: func(args []string) (string, error) {
+		return fmt.Sprintf("CAST(%s AS %s)", args[0], args[1]), nil
+	},
+}
+
+// Call returns a FunctionFunc rendering name(arg1, arg2, ...).
+//
+// Example:
+//
+//	dialect.Call("IFNULL")([]string{"a", "b"}) // IFNULL(a, b)
+func Call(name string) FunctionFunc {
+	return func(args []string) (string, error) {
+		return name + "(" + strings.Join(args, ", ") + ")", nil
+	}
+}
+
+// Keyword returns a FunctionFunc rendering a fixed keyword, such as
+// CURRENT_TIMESTAMP, for functions without arguments.
+func Keyword(word string) FunctionFunc {
+	return func([]string) (string, error) {
+		return word, nil
+	}
+}
+
+// Infix returns a FunctionFunc joining the arguments with op, wrapped in
+// parentheses: (a || b || c).
+func Infix(op string) FunctionFunc {
+	return func(args []string) (string, error) {
+		return "(" + strings.Join(args, " "+op+" ") + ")", nil
+	}
+}
+
+// RenderFunction renders the canonical function name with args for d.
+//
+// Translations are looked up in order:
+//  1. d.Options().Functions(), to override or extend a dialect per instance
+//  2. the dialect's own FunctionMapper mapping
+//  3. StandardFunctions
+//
+// When none applies, RenderFunction returns ErrNoTranslation.
+//
+// Example:
+//
+//	dialect.RenderFunction(oracle.New(), dialect.FuncSubstring, []string{"name", "1", "3"})
+//	// SUBSTR(name, 1, 3)
+func RenderFunction(d SQLDialect, name string, args []string) (string, error) {
+	f, ok := d.Options().Functions()[name]
+	if !ok {
+		if m, isMapper := d.(FunctionMapper); isMapper {
+			f, ok = m.Functions()[name]
+		}
+	}
+	if !ok {
+		f = StandardFunctions[name]
+	}
+	if f == nil {
+		return "", fmt.Errorf("%w: %s for %s", ErrNoTranslation, name, d.Name())
+	}
+	return f(args)
+}
@@ -0,0 +1,102 @@
+package dialect_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/bigquery"
+	"github.com/entiqon/db/dialect/clickhouse"
+	"github.com/entiqon/db/dialect/db2"
+	"github.com/entiqon/db/dialect/firebird"
+	"github.com/entiqon/db/dialect/generic"
+	"github.com/entiqon/db/dialect/informix"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/dialect/redshift"
+	"github.com/entiqon/db/dialect/snowflake"
+)
+
+func TestRenderFunction(t *testing.T) {
+	t.Run("PerDialect", func(t *testing.T) {
+		cases := []struct {
+			d    dialect.SQLDialect
+			name string
+			args []string
+			want string
+		}{
+			{generic.New(), dialect.FuncCoalesce, []string{"a", "b"}, "COALESCE(a, b)"},
+			{generic.New(), dialect.FuncNow, nil, "CURRENT_TIMESTAMP"},
+			{generic.New(), dialect.FuncConcat, []string{"a", "b"}, "(a || b)"},
+			{generic.New(), dialect.FuncSubstring, []string{"s", "2"}, "SUBSTRING(s FROM 2)"},
+			{generic.New(), dialect.FuncSubstring, []string{"s", "1", "3"}, "SUBSTRING(s FROM 1 FOR 3)"},
+			{generic.New(), dialect.FuncLength, []string{"s"}, "CHAR_LENGTH(s)"},
+			{generic.New(), dialect.FuncCast, []string{"x", "INTEGER"}, "CAST(x AS INTEGER)"},
+			{oracle.New(), dialect.FuncSubstring, []string{"s", "1", "3"}, "SUBSTR(s, 1, 3)"},
+			{oracle.New(), dialect.FuncDateTrunc, []string{"month", "ts"}, "TRUNC(ts, 'MM')"},
+			{db2.New(), dialect.FuncDateTrunc, []string{"day", "ts"}, "DATE_TRUNC('DAY', ts)"},
+			{firebird.New(), dialect.FuncLength, []string{"s"}, "CHAR_LENGTH(s)"},
+			{informix.New(), dialect.FuncNow, nil, "CURRENT"},
+			{clickhouse.New(), dialect.FuncNow, nil, "now()"},
+			{clickhouse.New(), dialect.FuncConcat, []string{"a", "b"}, "concat(a, b)"},
+			{clickhouse.New(), dialect.FuncLength, []string{"s"}, "lengthUTF8(s)"},
+			{snowflake.New(), dialect.FuncDateTrunc, []string{"week", "ts"}, "DATE_TRUNC('WEEK', ts)"},
+			{bigquery.New(), dialect.FuncNow, nil, "CURRENT_TIMESTAMP()"},
+			{bigquery.New(), dialect.FuncDateTrunc, []string{"month", "ts"}, "TIMESTAMP_TRUNC(ts, MONTH)"},
+			{redshift.New(), dialect.FuncNow, nil, "GETDATE()"},
+			{redshift.New(), dialect.FuncCoalesce, []string{"a", "b"}, "COALESCE(a, b)"},
+		}
+		for _, c := range cases {
+			got, err := dialect.RenderFunction(c.d, c.name, c.args)
+			if err != nil || got != c.want {
+				t.Errorf("%s %s = %q (%v), want %q", c.d.Name(), c.name, got, err, c.want)
+			}
+		}
+	})
+
+	t.Run("NoTranslation", func(t *testing.T) {
+		cases := []struct {
+			d    dialect.SQLDialect
+			name string
+			args []string
+		}{
+			{generic.New(), dialect.FuncDateTrunc, []string{"month", "ts"}},
+			{firebird.New(), dialect.FuncDateTrunc, []string{"month", "ts"}},
+			{informix.New(), dialect.FuncDateTrunc, []string{"month", "ts"}},
+			{oracle.New(), dialect.FuncDateTrunc, []string{"second", "ts"}},
+			{generic.New(), "JSON_VALUE", []string{"data", "'$.id'"}},
+		}
+		for _, c := range cases {
+			if _, err := dialect.RenderFunction(c.d, c.name, c.args); !errors.Is(err, dialect.ErrNoTranslation) {
+				t.Errorf("%s %s: expected ErrNoTranslation, got %v", c.d.Name(), c.name, err)
+			}
+		}
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		d := generic.NewWithOptions(dialect.Options{Name: "custom"}.WithFunctions(dialect.FunctionMap{
+			dialect.FuncCoalesce: dialect.Call("IFNULL"),
+			"JSON_VALUE":         dialect.Call("JSON_EXTRACT"),
+			dialect.FuncConcat:   nil,
+		}))
+		if got, _ := dialect.RenderFunction(d, dialect.FuncCoalesce, []string{"a", "b"}); got != "IFNULL(a, b)" {
+			t.Errorf("expected override, got %q", got)
+		}
+		if got, _ := dialect.RenderFunction(d, "JSON_VALUE", []string{"data", "'$.id'"}); got != "JSON_EXTRACT(data, '$.id')" {
+			t.Errorf("expected extension, got %q", got)
+		}
+		if got, _ := dialect.RenderFunction(d, dialect.FuncLength, []string{"s"}); got != "CHAR_LENGTH(s)" {
+			t.Errorf("expected standard fallback, got %q", got)
+		}
+		if _, err := dialect.RenderFunction(d, dialect.FuncConcat, []string{"a", "b"}); !errors.Is(err, dialect.ErrNoTranslation) {
+			t.Errorf("expected disabled function, got %v", err)
+		}
+
+		opts := d.Options().WithErrorCodes(dialect.MySQLErrorCodes)
+		if opts.Functions() == nil || opts.ErrorCodes() == nil {
+			t.Error("expected WithErrorCodes to keep the functions")
+		}
+		if seen := map[dialect.Options]bool{d.Options(): true}; !seen[d.Options()] || seen[opts] {
+			t.Error("expected Options to be comparable by fields and tables")
+		}
+	})
+}
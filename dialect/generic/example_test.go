@@ -26,9 +26,11 @@ func Example_quoteIdentifier() {
 	d := generic.New()
 	fmt.Println(d.QuoteIdentifier("users"))
 	fmt.Println(d.QuoteIdentifier("UserData"))
+	fmt.Println(d.QuoteIdentifier(`"UserData"`))
 	fmt.Println(d.QuoteIdentifier("order items"))
 	// Output:
 	// users
+	// UserData
 	// "UserData"
 	// "order items"
 }
@@ -43,7 +45,7 @@ func Example_quoteLiteral() {
 	// 'O''Reilly'
 	// TRUE
 	// 42
-	// '2025-09-19 03:30:00'
+	// TIMESTAMP '2025-09-19 03:30:00'
 }
 
 func Example_paginationSyntax() {
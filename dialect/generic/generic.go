@@ -1,7 +1,9 @@
 package generic
 
 import (
+	"encoding/hex"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -30,8 +32,16 @@ type dialectImpl struct {
 	opts dialect.Options
 }
 
-// Compile-time check: ensure dialectImpl implements SQLDialect
-var _ dialect.SQLDialect = (*dialectImpl)(nil)
+// Compile-time checks: ensure dialectImpl implements SQLDialect and the
+// optional capabilities it advertises.
+var (
+	_ dialect.SQLDialect   = (*dialectImpl)(nil)
+	_ dialect.Keyworder    = (*dialectImpl)(nil)
+	_ dialect.BinaryQuoter = (*dialectImpl)(nil)
+)
+
+// bareIdentifier matches identifiers that can be emitted without quotes.
+var bareIdentifier = regexp.MustCompile(`^[a-z_][a-z0-9_$]*$`)
 
 //
 // Constructor
@@ -56,6 +66,7 @@ func New() dialect.SQLDialect {
 		opts: dialect.Options{
 			Name:                    "generic",
 			QuoteStyle:              `"`,
+			CaseFolding:             dialect.FoldLower,
 			PlaceholderStyle:        "?",
 			AllowMerge:              false,
 			AllowUpsert:             false,
@@ -63,6 +74,10 @@ func New() dialect.SQLDialect {
 			EnableReturning:         false,
 			SupportsCTE:             true,
 			SupportsWindowFunctions: true,
+			SupportsNullsOrdering:   true,
+			SupportsILike:           false,
+			SupportsArrayBinding:    false,
+			SupportsValuesList:      true,
 			MaxPlaceholderIndex:     0,
 		},
 	}
@@ -98,20 +113,27 @@ func (d *dialectImpl) Options() dialect.Options {
 
 // QuoteIdentifier returns an ANSI-quoted SQL identifier using the configured
 // quote style. Identifiers are quoted only when necessary (mixed case, spaces,
-// or symbols); lowercase unqualified names are returned as-is.
+// symbols or reserved words); lowercase simple names are returned as-is.
+// Qualified names are quoted part by part and embedded quote characters are
+// escaped by doubling them. Options().QuotePolicy can force or disable quoting.
 //
 // Example:
 //
-//	d.QuoteIdentifier("users")    // → users
-//	d.QuoteIdentifier("UserData") // → "UserData"
+//	d.QuoteIdentifier("users")        // → users
+//	d.QuoteIdentifier("UserData")     // → UserData, resolved as userdata
+//	d.QuoteIdentifier(`"UserData"`)   // → "UserData"
+//	d.QuoteIdentifier("order")        // → "order"
+//	d.QuoteIdentifier(`sales."Orders"`) // → sales."Orders"
 func (d *dialectImpl) QuoteIdentifier(name string) string {
-	if name == "" {
-		return ""
-	}
-	if strings.ToLower(name) == name && !strings.ContainsAny(name, " -") {
-		return name
-	}
-	return fmt.Sprintf("%s%s%s", d.opts.QuoteStyle, name, d.opts.QuoteStyle)
+	q := dialect.NewQuoter(d.opts, bareIdentifier.MatchString)
+	q.Reserved = dialect.SQL2016.IsReserved
+	return q.Quote(name)
+}
+
+// Keywords returns the SQL:2016 keyword set, whose reserved words are
+// quoted by QuoteIdentifier.
+func (d *dialectImpl) Keywords() dialect.Keywords {
+	return dialect.SQL2016
 }
 
 // QuoteLiteral safely quotes a literal value for inline use in SQL statements.
@@ -124,14 +146,16 @@ func (d *dialectImpl) QuoteIdentifier(name string) string {
 //   - string  → escaped and wrapped in single quotes
 //   - bool    → "TRUE" or "FALSE"
 //   - numbers → rendered in decimal form
-//   - time.Time → formatted as UTC 'YYYY-MM-DD HH:MM:SS'
+//   - time.Time → TIMESTAMP 'YYYY-MM-DD HH:MM:SS[.ffffff]' in UTC
+//   - []byte  → X'..' (SQL standard)
+//   - others  → dialect.MustLiteral
 //
 // Example:
 //
 //	d.QuoteLiteral("O'Reilly")   // → 'O''Reilly'
 //	d.QuoteLiteral(true)         // → TRUE
 //	d.QuoteLiteral(42)           // → 42
-//	d.QuoteLiteral(time.Now())   // → '2025-09-19 07:32:00'
+//	d.QuoteLiteral(time.Now())   // → TIMESTAMP '2025-09-19 07:32:00'
 func (d *dialectImpl) QuoteLiteral(literal any) string {
 	switch v := literal.(type) {
 	case nil:
@@ -149,13 +173,24 @@ func (d *dialectImpl) QuoteLiteral(literal any) string {
 		return fmt.Sprintf("%d", v)
 	case float32, float64:
 		return strconv.FormatFloat(toFloat64(v), 'f', -1, 64)
+	case []byte:
+		return d.QuoteBinary(v)
 	case time.Time:
-		return fmt.Sprintf("'%s'", v.UTC().Format("2006-01-02 15:04:05"))
+		return dialect.QuoteTime(d, v)
 	default:
-		return fmt.Sprintf("'%v'", v)
+		return dialect.MustLiteral(d, v)
 	}
 }
 
+// QuoteBinary renders data as a SQL standard hexadecimal literal.
+//
+// Example:
+//
+//	d.QuoteBinary([]byte("hi")) // → X'6869'
+func (d *dialectImpl) QuoteBinary(data []byte) string {
+	return "X'" + strings.ToUpper(hex.EncodeToString(data)) + "'"
+}
+
 // PaginationSyntax generates an ANSI-compliant LIMIT/OFFSET clause.
 // If both limit and offset are non-positive, an empty string is returned.
 //
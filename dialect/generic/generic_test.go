@@ -1,9 +1,11 @@
 package generic_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/entiqon/db/dialect"
 	"github.com/entiqon/db/dialect/generic"
 )
 
@@ -32,8 +34,15 @@ func TestGenericDialect(t *testing.T) {
 			want string
 		}{
 			{"users", "users"},
-			{"UserData", `"UserData"`},
+			{"UserData", "UserData"},
+			{`"UserData"`, `"UserData"`},
 			{"order items", `"order items"`},
+			{"order", `"order"`},
+			{"year", `"year"`},
+			{"level", "level"},
+			{"sales.Orders", "sales.Orders"},
+			{`sales."Orders"`, `sales."Orders"`},
+			{`odd"name`, `"odd""name"`},
 			{"", ""},
 		}
 
@@ -59,7 +68,10 @@ func TestGenericDialect(t *testing.T) {
 			{uint(99), "99"},
 			{3.14, "3.14"},        // float64
 			{float32(2.5), "2.5"}, // float32 → toFloat64
-			{now, "'2025-09-19 03:30:00'"},
+			{now, "TIMESTAMP '2025-09-19 03:30:00'"},
+			{now.Add(500 * time.Millisecond), "TIMESTAMP '2025-09-19 03:30:00.5'"},
+			{now.In(time.FixedZone("CEST", 2*3600)), "TIMESTAMP '2025-09-19 03:30:00'"},
+			{[]byte{1, 0xAB}, "X'01AB'"},
 		}
 
 		for _, c := range cases {
@@ -68,16 +80,28 @@ func TestGenericDialect(t *testing.T) {
 			}
 		}
 
-		// Default branch: unhandled type
-		got := d.QuoteLiteral(struct{ Foo string }{"bar"})
-		if got != "'{bar}'" { // fmt.Sprintf("%v") on struct{Foo string}{"bar"} → {bar}
-			t.Errorf("QuoteLiteral(struct) = %q, want %q", got, "'{bar}'")
+		// Default branch: routed through dialect.Literal
+		type status string
+		if got := d.QuoteLiteral(status("O'Reilly")); got != "'O''Reilly'" {
+			t.Errorf("QuoteLiteral(named string) = %q, want %q", got, "'O''Reilly'")
+		}
+		n := 7
+		if got := d.QuoteLiteral(&n); got != "7" {
+			t.Errorf("QuoteLiteral(*int) = %q, want %q", got, "7")
 		}
 
-		// Default branch of toFloat64: unhandled type
-		got = d.QuoteLiteral([]byte{1, 2})
-		if got != "'[1 2]'" {
-			t.Errorf("QuoteLiteral([]byte) = %q, want %q", got, "'[1 2]'")
+		// No safe literal form: refused, never rendered through fmt
+		for _, v := range []any{struct{ Foo string }{"bar"}, map[string]int{"a": 1}} {
+			func() {
+				defer func() {
+					err, _ := recover().(error)
+					if !errors.Is(err, dialect.ErrUnsupportedLiteral) {
+						t.Errorf("QuoteLiteral(%#v) panic = %v, want ErrUnsupportedLiteral", v, err)
+					}
+				}()
+				got := d.QuoteLiteral(v)
+				t.Errorf("QuoteLiteral(%#v) = %q, want a panic", v, got)
+			}()
 		}
 	})
 
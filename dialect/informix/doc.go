@@ -0,0 +1,30 @@
+/*
+Package informix provides the IBM Informix SQL dialect implementation.
+
+# Overview
+
+The Informix dialect renders SQL following Informix 11.50+ rules:
+
+  - Placeholders are unnumbered parameter markers (?).
+  - Identifiers are double-quoted only when required; quoted identifiers
+    are honored only when DELIMIDENT is set on the client.
+  - Pagination uses SKIP n FIRST m, which must directly follow the SELECT
+    keyword. PaginationSyntax therefore returns the bare clause and
+    Paginate inserts it into the statement.
+  - Boolean literals are 't' and 'f'; timestamps are DATETIME literals.
+  - Upserts are rendered as MERGE INTO ... USING (SELECT ... FROM
+    sysmaster:sysdual).
+  - RETURNING and common table expressions are not supported.
+
+# Usage
+
+	d := informix.New()
+	sql := dialect.Paginate(d, "SELECT id FROM users ORDER BY id", 10, 20)
+	// SELECT SKIP 20 FIRST 10 id FROM users ORDER BY id
+
+# Capabilities
+
+Besides dialect.SQLDialect, the Informix dialect implements the optional
+capability interfaces Paginator and Merger declared in the dialect package.
+*/
+package informix
@@ -0,0 +1,23 @@
+package informix_test
+
+import (
+	"fmt"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/informix"
+)
+
+func Example() {
+	d := informix.New()
+	fmt.Println(d.Name())
+	fmt.Println(d.Placeholder(1))
+	// Output:
+	// informix
+	// ?
+}
+
+func Example_paginate() {
+	fmt.Println(dialect.Paginate(informix.New(), "SELECT id FROM users ORDER BY id", 10, 20))
+	// Output:
+	// SELECT SKIP 20 FIRST 10 id FROM users ORDER BY id
+}
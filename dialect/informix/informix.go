@@ -0,0 +1,251 @@
+package informix
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+	dberrors "github.com/entiqon/db/errors"
+)
+
+//
+// Informix Dialect
+//
+
+// dialectImpl provides the IBM Informix implementation of the
+// dialect.SQLDialect interface. It is unexported to prevent direct
+// instantiation; consumers should always use the New() constructor.
+//
+// Key behaviors:
+//   - Identifiers are quoted using double quotes (") only when required;
+//     quoted identifiers require the DELIMIDENT environment setting.
+//   - Placeholders are unnumbered parameter markers "?".
+//   - Pagination uses SKIP n FIRST m right after the SELECT keyword.
+//   - Upserts are rendered as MERGE INTO ... USING (SELECT ... FROM sysmaster:sysdual).
+//   - RETURNING is not supported.
+type dialectImpl struct {
+	opts dialect.Options
+}
+
+// Compile-time checks: ensure dialectImpl implements SQLDialect and the
+// optional capabilities it advertises.
+var (
+	_ dialect.SQLDialect      = (*dialectImpl)(nil)
+	_ dialect.Paginator       = (*dialectImpl)(nil)
+	_ dialect.Merger          = (*dialectImpl)(nil)
+	_ dialect.Keyworder       = (*dialectImpl)(nil)
+	_ dialect.FunctionMapper  = (*dialectImpl)(nil)
+	_ dialect.BinaryQuoter    = (*dialectImpl)(nil)
+	_ dialect.TimeQuoter      = (*dialectImpl)(nil)
+	_ dialect.ErrorClassifier = (*dialectImpl)(nil)
+)
+
+// bareIdentifier matches identifiers that can be emitted without quotes.
+var bareIdentifier = regexp.MustCompile(`^[a-z_][a-z0-9_$]*$`)
+
+//
+// Constructors
+//
+
+// New returns an IBM Informix (11.50+) dialect. The returned value
+// implements the dialect.SQLDialect interface.
+//
+// Example:
+//
+//	d := informix.New()
+//	dialect.Paginate(d, "SELECT id FROM users", 10, 20)
+//	// SELECT SKIP 20 FIRST 10 id FROM users
+func New() dialect.SQLDialect {
+	return &dialectImpl{
+		opts: dialect.Options{
+			Name:                    "informix",
+			QuoteStyle:              `"`,
+			CaseFolding:             dialect.FoldLower,
+			PlaceholderStyle:        "?",
+			AllowMerge:              true,
+			AllowUpsert:             false,
+			ForcedAliasing:          true,
+			EnableReturning:         false,
+			SupportsCTE:             false,
+			SupportsWindowFunctions: true,
+			SupportsNullsOrdering:   true,
+			SupportsILike:           false,
+			SupportsArrayBinding:    false,
+			SupportsValuesList:      false,
+			MaxPlaceholderIndex:     0,
+			MaxIdentifierLength:     128,
+		},
+	}
+}
+
+// NewWithOptions creates an Informix dialect with the given static options.
+// Options are immutable once the dialect is constructed.
+func NewWithOptions(opts dialect.Options) dialect.SQLDialect {
+	return &dialectImpl{opts: opts}
+}
+
+//
+// Interface Implementation
+//
+
+// Name returns the identifier of this dialect, "informix" by default.
+func (d *dialectImpl) Name() string {
+	return d.opts.Name
+}
+
+// Options returns the feature capability matrix for the Informix dialect.
+func (d *dialectImpl) Options() dialect.Options {
+	return d.opts
+}
+
+// QuoteIdentifier returns an Informix identifier. Lowercase simple names are
+// returned as-is; anything else is wrapped in double quotes, with embedded
+// double quotes escaped by doubling them. Quoted identifiers are only
+// honored when DELIMIDENT is set on the client.
+//
+// Owner-qualified names (owner.table) are quoted part by part and
+// reserved words are quoted, subject to Options().QuotePolicy.
+//
+// Example:
+//
+//	d.QuoteIdentifier("users")      // → users
+//	d.QuoteIdentifier("UserData")   // → UserData, resolved as userdata
+//	d.QuoteIdentifier(`"UserData"`) // → "UserData"
+func (d *dialectImpl) QuoteIdentifier(name string) string {
+	q := dialect.NewQuoter(d.opts, bareIdentifier.MatchString)
+	q.Reserved = keywords.IsReserved
+	return q.Quote(name)
+}
+
+// Keywords returns the Informix keyword set. Informix reserves few words
+// outright, so most keywords are reported as non-reserved.
+func (d *dialectImpl) Keywords() dialect.Keywords {
+	return keywords
+}
+
+// Functions returns the Informix spellings of canonical functions. The
+// current timestamp is the CURRENT keyword; DATE_TRUNC is unsupported.
+func (d *dialectImpl) Functions() dialect.FunctionMap {
+	return dialect.FunctionMap{
+		dialect.FuncNow: dialect.Keyword("CURRENT"),
+	}
+}
+
+// QuoteLiteral quotes a literal value for inline use in Informix SQL.
+//
+// Supported types:
+//   - nil       → NULL
+//   - string    → escaped and wrapped in single quotes
+//   - bool      → 't' or 'f' (BOOLEAN literals)
+//   - numbers   → rendered in decimal form
+//   - time.Time → DATETIME (YYYY-MM-DD HH:MM:SS) YEAR TO SECOND in UTC
+//   - others    → dialect.MustLiteral
+func (d *dialectImpl) QuoteLiteral(literal any) string {
+	switch v := literal.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return "'" + strings.ReplaceAll(v, "'", "''") + "'"
+	case bool:
+		if v {
+			return "'t'"
+		}
+		return "'f'"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 64)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case time.Time:
+		return dialect.QuoteTime(d, v)
+	default:
+		return dialect.MustLiteral(d, v)
+	}
+}
+
+// QuoteBinary returns an empty string: Informix has no literal form for
+// BYTE or BLOB values, which must be bound as parameters.
+func (d *dialectImpl) QuoteBinary(_ []byte) string {
+	return ""
+}
+
+// QuoteTime renders t as a DATETIME literal in UTC, qualified YEAR TO
+// SECOND, or YEAR TO FRACTION(5) when t has fractional seconds.
+//
+// Example:
+//
+//	d.QuoteTime(t) // → DATETIME (2025-09-19 03:30:00) YEAR TO SECOND
+func (d *dialectImpl) QuoteTime(t time.Time) string {
+	t = t.UTC()
+	if t.Nanosecond()/10000 == 0 {
+		return fmt.Sprintf("DATETIME (%s) YEAR TO SECOND", t.Format("2006-01-02 15:04:05"))
+	}
+	return fmt.Sprintf("DATETIME (%s) YEAR TO FRACTION(5)", t.Format("2006-01-02 15:04:05.00000"))
+}
+
+// PaginationSyntax returns the SKIP/FIRST clause without leading space.
+// Informix requires it directly after the SELECT keyword, so it cannot be
+// appended to a statement; use Paginate (or dialect.Paginate) instead.
+//
+// Example:
+//
+//	d.PaginationSyntax(10, 20) // → "SKIP 20 FIRST 10"
+func (d *dialectImpl) PaginationSyntax(limit, offset int) string {
+	var parts []string
+	if offset > 0 {
+		parts = append(parts, fmt.Sprintf("SKIP %d", offset))
+	}
+	if limit > 0 {
+		parts = append(parts, fmt.Sprintf("FIRST %d", limit))
+	}
+	return strings.Join(parts, " ")
+}
+
+// Paginate inserts the SKIP/FIRST clause right after the leading SELECT
+// keyword of query (before DISTINCT, as Informix requires).
+//
+// Example:
+//
+//	d.Paginate("SELECT DISTINCT id FROM users", 10, 20)
+//	// SELECT SKIP 20 FIRST 10 DISTINCT id FROM users
+func (d *dialectImpl) Paginate(query string, limit, offset int) string {
+	return dialect.PrefixSelect(query, d.PaginationSyntax(limit, offset))
+}
+
+// Placeholder always returns "?" for Informix. The index parameter is
+// ignored since parameter markers are not numbered.
+func (d *dialectImpl) Placeholder(_ int) string {
+	return d.opts.PlaceholderStyle
+}
+
+// MergeSyntax renders an upsert as a MERGE statement reading a single row
+// of parameter markers from sysmaster:sysdual.
+//
+// Example:
+//
+//	d.MergeSyntax("users", []string{"id", "name"}, []string{"id"})
+//	// MERGE INTO users tgt USING (SELECT ? AS id, ? AS name FROM sysmaster:sysdual) src
+//	//   ON (tgt.id = src.id)
+//	//   WHEN MATCHED THEN UPDATE SET tgt.name = src.name
+//	//   WHEN NOT MATCHED THEN INSERT (id, name) VALUES (src.id, src.name)
+func (d *dialectImpl) MergeSyntax(table string, columns, keys []string) string {
+	return dialect.Merge(d, table, columns, keys, dialect.MergeStyle{Source: dialect.MergeSelect("sysmaster:sysdual")})
+}
+
+// ErrorCodes returns the Informix SQL and ISAM error codes.
+func (d *dialectImpl) ErrorCodes() dialect.ErrorCodes {
+	return dialect.ErrorCodes{
+		"-239": dberrors.ErrUniqueViolation,     // duplicate value in unique index
+		"-268": dberrors.ErrUniqueViolation,     // unique constraint violated
+		"-691": dberrors.ErrForeignKeyViolation, // missing key in referenced table
+		"-692": dberrors.ErrForeignKeyViolation, // key referenced by a dependent table
+		"-391": dberrors.ErrNotNullViolation,    // NULL into NOT NULL column
+		"-143": dberrors.ErrDeadlock,            // ISAM deadlock detected
+		"-154": dberrors.ErrLockTimeout,         // ISAM lock timeout expired
+		"-213": dberrors.ErrQueryCanceled,       // statement interrupted
+	}
+}
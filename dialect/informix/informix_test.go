@@ -0,0 +1,137 @@
+package informix_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/informix"
+)
+
+func TestInformixDialect(t *testing.T) {
+	d := informix.New()
+
+	t.Run("Name", func(t *testing.T) {
+		if got, want := d.Name(), "informix"; got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+		if got := informix.NewWithOptions(dialect.Options{Name: "ids"}).Name(); got != "ids" {
+			t.Errorf("expected %q, got %q", "ids", got)
+		}
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		opts := d.Options()
+		if !opts.AllowMerge {
+			t.Error("expected AllowMerge=true")
+		}
+		if opts.AllowUpsert || opts.EnableReturning {
+			t.Error("expected UPSERT and RETURNING to be disabled")
+		}
+	})
+
+	t.Run("QuoteIdentifier", func(t *testing.T) {
+		cases := []struct {
+			in   string
+			want string
+		}{
+			{"users", "users"},
+			{"_tmp", "_tmp"},
+			{"UserData", "UserData"},
+			{`"UserData"`, `"UserData"`},
+			{`odd"name`, `"odd""name"`},
+			{"", ""},
+		}
+		for _, c := range cases {
+			if got := d.QuoteIdentifier(c.in); got != c.want {
+				t.Errorf("QuoteIdentifier(%q) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("QuoteLiteral", func(t *testing.T) {
+		now := time.Date(2025, 9, 19, 3, 30, 0, 0, time.UTC)
+		cases := []struct {
+			in   any
+			want string
+		}{
+			{nil, "NULL"},
+			{"O'Reilly", "'O''Reilly'"},
+			{true, "'t'"},
+			{false, "'f'"},
+			{int64(12), "12"},
+			{float32(2.5), "2.5"},
+			{1.5, "1.5"},
+			{now, "DATETIME (2025-09-19 03:30:00) YEAR TO SECOND"},
+			{now.Add(123456 * time.Microsecond), "DATETIME (2025-09-19 03:30:00.12345) YEAR TO FRACTION(5)"},
+			{now.In(time.FixedZone("EST", -5*3600)), "DATETIME (2025-09-19 03:30:00) YEAR TO SECOND"},
+		}
+		for _, c := range cases {
+			if got := d.QuoteLiteral(c.in); got != c.want {
+				t.Errorf("QuoteLiteral(%v) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("Placeholder", func(t *testing.T) {
+		if got := d.Placeholder(3); got != "?" {
+			t.Errorf("Placeholder(3) = %q, want '?'", got)
+		}
+	})
+
+	t.Run("PaginationSyntax", func(t *testing.T) {
+		cases := []struct {
+			limit, offset int
+			want          string
+		}{
+			{0, 0, ""},
+			{10, 0, "FIRST 10"},
+			{0, 20, "SKIP 20"},
+			{10, 20, "SKIP 20 FIRST 10"},
+		}
+		for _, c := range cases {
+			if got := d.PaginationSyntax(c.limit, c.offset); got != c.want {
+				t.Errorf("PaginationSyntax(%d,%d) = %q, want %q", c.limit, c.offset, got, c.want)
+			}
+		}
+	})
+
+	t.Run("Paginate", func(t *testing.T) {
+		cases := []struct {
+			query         string
+			limit, offset int
+			want          string
+		}{
+			{"SELECT id FROM users", 0, 0, "SELECT id FROM users"},
+			{"SELECT id FROM users", 10, 20, "SELECT SKIP 20 FIRST 10 id FROM users"},
+			{"SELECT DISTINCT id FROM users", 10, 0, "SELECT FIRST 10 DISTINCT id FROM users"},
+			{"  SELECT\tid FROM users", 0, 5, "SELECT SKIP 5\tid FROM users"},
+			{"SELECTED", 1, 0, "SELECT FIRST 1 * FROM (SELECTED) q_"},
+		}
+		for _, c := range cases {
+			if got := dialect.Paginate(d, c.query, c.limit, c.offset); got != c.want {
+				t.Errorf("Paginate(%q,%d,%d) = %q, want %q", c.query, c.limit, c.offset, got, c.want)
+			}
+		}
+	})
+
+	t.Run("MergeSyntax", func(t *testing.T) {
+		m := d.(dialect.Merger)
+		got := m.MergeSyntax("users", []string{"id", "name"}, []string{"id"})
+		want := "MERGE INTO users tgt USING (SELECT ? AS id, ? AS name FROM sysmaster:sysdual) src ON (tgt.id = src.id)" +
+			" WHEN MATCHED THEN UPDATE SET tgt.name = src.name" +
+			" WHEN NOT MATCHED THEN INSERT (id, name) VALUES (src.id, src.name)"
+		if got != want {
+			t.Errorf("MergeSyntax =\n%s\nwant\n%s", got, want)
+		}
+		got = m.MergeSyntax("tags", []string{"id"}, []string{"id"})
+		want = "MERGE INTO tags tgt USING (SELECT ? AS id FROM sysmaster:sysdual) src ON (tgt.id = src.id)" +
+			" WHEN NOT MATCHED THEN INSERT (id) VALUES (src.id)"
+		if got != want {
+			t.Errorf("MergeSyntax keys only =\n%s\nwant\n%s", got, want)
+		}
+		if got := m.MergeSyntax("users", []string{"id"}, nil); got != "" {
+			t.Errorf("expected empty merge, got %q", got)
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package informix
+
+import "github.com/entiqon/db/dialect"
+
+// keywords holds the Informix keywords that cannot be used as bare
+// identifiers without ambiguity, and the much larger set of keywords that
+// Informix accepts as identifiers because it reserves words by context.
+var keywords = dialect.NewKeywords(
+	[]string{
+		"ALL", "AND", "ANY", "AS", "BETWEEN", "BY", "CASE", "CREATE",
+		"CROSS", "CURRENT", "DATETIME", "DEFAULT", "DELETE", "DISTINCT",
+		"DROP", "ELSE", "END", "EXISTS", "FIRST", "FOR", "FROM", "FULL",
+		"GROUP", "HAVING", "IN", "INNER", "INSERT", "INTERVAL", "INTO", "IS",
+		"JOIN", "LEFT", "LIKE", "LIMIT", "MATCHES", "MIDDLE", "NOT", "NULL",
+		"ON", "OR", "ORDER", "OUTER", "RIGHT", "SELECT", "SET", "SKIP",
+		"TABLE", "THEN", "TODAY", "UNION", "UNIQUE", "UPDATE", "USER",
+		"USING", "VALUES", "WHEN", "WHERE", "WITH",
+	},
+	[]string{
+		"ACCESS", "ADD", "AFTER", "ALTER", "ASC", "AVG", "BEFORE", "BEGIN",
+		"CASCADE", "CHECK", "COLUMN", "COMMIT", "CONSTRAINT", "COUNT",
+		"DATABASE", "DATE", "DAY", "DESC", "EXECUTE", "EXTENT", "FRACTION",
+		"FUNCTION", "HOUR", "INDEX", "KEY", "LEVEL", "LOCK", "MAX", "MERGE",
+		"MIN", "MINUTE", "MODE", "MONTH", "NAME", "PRIMARY", "PROCEDURE",
+		"REFERENCES", "ROLE", "ROW", "ROWID", "SECOND", "SERIAL", "SIZE",
+		"SUM", "SYNONYM", "TEMP", "TEXT", "TRIGGER", "TYPE", "VIEW", "WORK",
+		"YEAR",
+	},
+)
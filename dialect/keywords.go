@@ -0,0 +1,248 @@
+package dialect
+
+import (
+	"sort"
+	"strings"
+)
+
+// coreReserved lists words reserved by the SQL standard and by every
+// major engine. They can never be used as bare identifiers portably.
+var coreReserved = map[string]struct{}{
+	"ALL": {}, "AND": {}, "ANY": {}, "AS": {}, "ASC": {}, "BETWEEN": {},
+	"BY": {}, "CASE": {}, "CAST": {}, "CHECK": {}, "COLUMN": {},
+	"CONSTRAINT": {}, "CREATE": {}, "CROSS": {}, "CURRENT_DATE": {},
+	"CURRENT_TIME": {}, "CURRENT_TIMESTAMP": {}, "CURRENT_USER": {},
+	"DEFAULT": {}, "DELETE": {}, "DESC": {}, "DISTINCT": {}, "DROP": {},
+	"ELSE": {}, "END": {}, "EXCEPT": {}, "EXISTS": {}, "FALSE": {},
+	"FETCH": {}, "FOR": {}, "FOREIGN": {}, "FROM": {}, "FULL": {},
+	"GRANT": {}, "GROUP": {}, "HAVING": {}, "IN": {}, "INNER": {},
+	"INSERT": {}, "INTERSECT": {}, "INTO": {}, "IS": {}, "JOIN": {},
+	"LEFT": {}, "LIKE": {}, "NOT": {}, "NULL": {}, "ON": {}, "OR": {},
+	"ORDER": {}, "OUTER": {}, "PRIMARY": {}, "REFERENCES": {},
+	"RIGHT": {}, "SELECT": {}, "SET": {}, "TABLE": {}, "THEN": {},
+	"TO": {}, "TRUE": {}, "UNION": {}, "UNIQUE": {}, "UPDATE": {},
+	"USER": {}, "USING": {}, "VALUES": {}, "WHEN": {}, "WHERE": {},
+	"WITH": {},
+}
+
+// IsReserved reports whether word is a reserved SQL keyword that must be
+// quoted to be used as an identifier on every engine. The check is
+// case-insensitive. Vendor-specific sets are available through KeywordsOf.
+//
+// Example:
+//
+//	dialect.IsReserved("order") // → true
+//	dialect.IsReserved("users") // → false
+func IsReserved(word string) bool {
+	_, ok := coreReserved[strings.ToUpper(word)]
+	return ok
+}
+
+// Keywords is the keyword set of a dialect, split between reserved words,
+// which must be quoted to be used as identifiers, and non-reserved words,
+// which carry meaning in some contexts but remain valid bare identifiers.
+//
+// The zero value holds no keywords. Lookups are case-insensitive.
+type Keywords struct {
+	reserved    map[string]struct{}
+	nonReserved map[string]struct{}
+}
+
+// NewKeywords builds a keyword set from the given reserved and non-reserved
+// words. A word listed in both is treated as reserved.
+//
+// Example:
+//
+//	kw := dialect.NewKeywords([]string{"LEVEL"}, []string{"NAME"})
+//	kw.IsReserved("level")    // → true
+//	kw.IsNonReserved("name")  // → true
+func NewKeywords(reserved, nonReserved []string) Keywords {
+	k := Keywords{
+		reserved:    make(map[string]struct{}, len(reserved)),
+		nonReserved: make(map[string]struct{}, len(nonReserved)),
+	}
+	for _, w := range reserved {
+		k.reserved[strings.ToUpper(w)] = struct{}{}
+	}
+	for _, w := range nonReserved {
+		w = strings.ToUpper(w)
+		if _, ok := k.reserved[w]; !ok {
+			k.nonReserved[w] = struct{}{}
+		}
+	}
+	return k
+}
+
+// IsReserved reports whether word is reserved in this set.
+func (k Keywords) IsReserved(word string) bool {
+	_, ok := k.reserved[strings.ToUpper(word)]
+	return ok
+}
+
+// IsNonReserved reports whether word is a non-reserved keyword in this set.
+func (k Keywords) IsNonReserved(word string) bool {
+	_, ok := k.nonReserved[strings.ToUpper(word)]
+	return ok
+}
+
+// IsKeyword reports whether word is a keyword of this set, reserved or not.
+func (k Keywords) IsKeyword(word string) bool {
+	return k.IsReserved(word) || k.IsNonReserved(word)
+}
+
+// Reserved returns the reserved words in alphabetical order. The slice is a
+// copy and may be modified freely.
+func (k Keywords) Reserved() []string {
+	return sortedKeys(k.reserved)
+}
+
+// NonReserved returns the non-reserved keywords in alphabetical order. The
+// slice is a copy and may be modified freely.
+func (k Keywords) NonReserved() []string {
+	return sortedKeys(k.nonReserved)
+}
+
+// KeywordsOf returns the keyword set of d. Dialects implementing Keyworder
+// report their own vendor set; any other dialect, including nil, falls back
+// to SQL2016.
+//
+// Example:
+//
+//	dialect.KeywordsOf(oracle.New()).IsReserved("level")  // → true
+//	dialect.KeywordsOf(generic.New()).IsReserved("level") // → false
+func KeywordsOf(d SQLDialect) Keywords {
+	if k, ok := d.(Keyworder); ok {
+		return k.Keywords()
+	}
+	return SQL2016
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	out := make([]string, 0, len(m))
+	for w := range m {
+		out = append(out, w)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// SQL2016 holds the reserved and non-reserved words of ISO/IEC 9075:2016.
+// It is the keyword set of the generic dialect and the fallback returned by
+// KeywordsOf for dialects that do not carry their own.
+var SQL2016 = NewKeywords(
+	[]string{
+		"ABS", "ACOS", "ALL", "ALLOCATE", "ALTER", "AND", "ANY", "ARE",
+		"ARRAY", "ARRAY_AGG", "ARRAY_MAX_CARDINALITY", "AS", "ASENSITIVE",
+		"ASIN", "ASYMMETRIC", "AT", "ATAN", "ATOMIC", "AUTHORIZATION", "AVG",
+		"BEGIN", "BEGIN_FRAME", "BEGIN_PARTITION", "BETWEEN", "BIGINT",
+		"BINARY", "BLOB", "BOOLEAN", "BOTH", "BY", "CALL", "CALLED",
+		"CARDINALITY", "CASCADED", "CASE", "CAST", "CEIL", "CEILING", "CHAR",
+		"CHAR_LENGTH", "CHARACTER", "CHARACTER_LENGTH", "CHECK", "CLASSIFIER",
+		"CLOB", "CLOSE", "COALESCE", "COLLATE", "COLLECT", "COLUMN", "COMMIT",
+		"CONDITION", "CONNECT", "CONSTRAINT", "CONTAINS", "CONVERT", "COPY",
+		"CORR", "CORRESPONDING", "COS", "COSH", "COUNT", "COVAR_POP",
+		"COVAR_SAMP", "CREATE", "CROSS", "CUBE", "CUME_DIST", "CURRENT",
+		"CURRENT_CATALOG", "CURRENT_DATE", "CURRENT_DEFAULT_TRANSFORM_GROUP",
+		"CURRENT_PATH", "CURRENT_ROLE", "CURRENT_ROW", "CURRENT_SCHEMA",
+		"CURRENT_TIME", "CURRENT_TIMESTAMP", "CURRENT_TRANSFORM_GROUP_FOR_TYPE",
+		"CURRENT_USER", "CURSOR", "CYCLE", "DATE", "DAY", "DEALLOCATE", "DEC",
+		"DECFLOAT", "DECIMAL", "DECLARE", "DEFAULT", "DEFINE", "DELETE",
+		"DENSE_RANK", "DEREF", "DESCRIBE", "DETERMINISTIC", "DISCONNECT",
+		"DISTINCT", "DOUBLE", "DROP", "DYNAMIC", "EACH", "ELEMENT", "ELSE",
+		"EMPTY", "END", "END_FRAME", "END_PARTITION", "END-EXEC", "EQUALS",
+		"ESCAPE", "EVERY", "EXCEPT", "EXEC", "EXECUTE", "EXISTS", "EXP",
+		"EXTERNAL", "EXTRACT", "FALSE", "FETCH", "FILTER", "FIRST_VALUE",
+		"FLOAT", "FLOOR", "FOR", "FOREIGN", "FRAME_ROW", "FREE", "FROM",
+		"FULL", "FUNCTION", "FUSION", "GET", "GLOBAL", "GRANT", "GROUP",
+		"GROUPING", "GROUPS", "HAVING", "HOLD", "HOUR", "IDENTITY", "IN",
+		"INDICATOR", "INITIAL", "INNER", "INOUT", "INSENSITIVE", "INSERT",
+		"INT", "INTEGER", "INTERSECT", "INTERSECTION", "INTERVAL", "INTO", "IS",
+		"JOIN", "JSON_ARRAY", "JSON_ARRAYAGG", "JSON_EXISTS", "JSON_OBJECT",
+		"JSON_OBJECTAGG", "JSON_QUERY", "JSON_TABLE", "JSON_TABLE_PRIMITIVE",
+		"JSON_VALUE", "LAG", "LANGUAGE", "LARGE", "LAST_VALUE", "LATERAL",
+		"LEAD", "LEADING", "LEFT", "LIKE", "LIKE_REGEX", "LISTAGG", "LN",
+		"LOCAL", "LOCALTIME", "LOCALTIMESTAMP", "LOG", "LOG10", "LOWER",
+		"MATCH", "MATCH_NUMBER", "MATCH_RECOGNIZE", "MATCHES", "MAX",
+		"MEASURES", "MEMBER", "MERGE", "METHOD", "MIN", "MINUTE", "MOD",
+		"MODIFIES", "MODULE", "MONTH", "MULTISET", "NATIONAL", "NATURAL",
+		"NCHAR", "NCLOB", "NEW", "NO", "NONE", "NORMALIZE", "NOT", "NTH_VALUE",
+		"NTILE", "NULL", "NULLIF", "NUMERIC", "OCCURRENCES_REGEX",
+		"OCTET_LENGTH", "OF", "OFFSET", "OLD", "OMIT", "ON", "ONE", "ONLY",
+		"OPEN", "OR", "ORDER", "OUT", "OUTER", "OVER", "OVERLAPS", "OVERLAY",
+		"PARAMETER", "PARTITION", "PATTERN", "PER", "PERCENT", "PERCENT_RANK",
+		"PERCENTILE_CONT", "PERCENTILE_DISC", "PERIOD", "PORTION", "POSITION",
+		"POSITION_REGEX", "POWER", "PRECEDES", "PRECISION", "PREPARE",
+		"PRIMARY", "PROCEDURE", "PTF", "RANGE", "RANK", "READS", "REAL",
+		"RECURSIVE", "REF", "REFERENCES", "REFERENCING", "REGR_AVGX",
+		"REGR_AVGY", "REGR_COUNT", "REGR_INTERCEPT", "REGR_R2", "REGR_SLOPE",
+		"REGR_SXX", "REGR_SXY", "REGR_SYY", "RELEASE", "RESULT", "RETURN",
+		"RETURNS", "REVOKE", "RIGHT", "ROLLBACK", "ROLLUP", "ROW",
+		"ROW_NUMBER", "ROWS", "RUNNING", "SAVEPOINT", "SCOPE", "SCROLL",
+		"SEARCH", "SECOND", "SEEK", "SELECT", "SENSITIVE", "SESSION_USER",
+		"SET", "SHOW", "SIMILAR", "SIN", "SINH", "SKIP", "SMALLINT", "SOME",
+		"SPECIFIC", "SPECIFICTYPE", "SQL", "SQLEXCEPTION", "SQLSTATE",
+		"SQLWARNING", "SQRT", "START", "STATIC", "STDDEV_POP", "STDDEV_SAMP",
+		"SUBMULTISET", "SUBSET", "SUBSTRING", "SUBSTRING_REGEX", "SUCCEEDS",
+		"SUM", "SYMMETRIC", "SYSTEM", "SYSTEM_TIME", "SYSTEM_USER", "TABLE",
+		"TABLESAMPLE", "TAN", "TANH", "THEN", "TIME", "TIMESTAMP",
+		"TIMEZONE_HOUR", "TIMEZONE_MINUTE", "TO", "TRAILING", "TRANSLATE",
+		"TRANSLATE_REGEX", "TRANSLATION", "TREAT", "TRIGGER", "TRIM",
+		"TRIM_ARRAY", "TRUE", "TRUNCATE", "UESCAPE", "UNION", "UNIQUE",
+		"UNKNOWN", "UNNEST", "UPDATE", "UPPER", "USER", "USING", "VALUE",
+		"VALUE_OF", "VALUES", "VAR_POP", "VAR_SAMP", "VARBINARY", "VARCHAR",
+		"VARYING", "VERSIONING", "WHEN", "WHENEVER", "WHERE", "WIDTH_BUCKET",
+		"WINDOW", "WITH", "WITHIN", "WITHOUT", "YEAR",
+	},
+	[]string{
+		"A", "ABSOLUTE", "ACTION", "ADA", "ADD", "ADMIN", "AFTER", "ALWAYS",
+		"ASC", "ASSERTION", "ASSIGNMENT", "ATTRIBUTE", "ATTRIBUTES", "BEFORE",
+		"BERNOULLI", "BREADTH", "C", "CASCADE", "CATALOG", "CATALOG_NAME",
+		"CHAIN", "CHAINING", "CHARACTER_SET_CATALOG", "CHARACTER_SET_NAME",
+		"CHARACTER_SET_SCHEMA", "CHARACTERISTICS", "CHARACTERS",
+		"CLASS_ORIGIN", "COBOL", "COLLATION", "COLLATION_CATALOG",
+		"COLLATION_NAME", "COLLATION_SCHEMA", "COLUMN_NAME", "COMMAND_FUNCTION",
+		"COMMAND_FUNCTION_CODE", "COMMITTED", "CONDITION_NUMBER", "CONDITIONAL",
+		"CONNECTION", "CONNECTION_NAME", "CONSTRAINT_CATALOG",
+		"CONSTRAINT_NAME", "CONSTRAINT_SCHEMA", "CONSTRAINTS", "CONSTRUCTOR",
+		"CONTINUE", "CURSOR_NAME", "DATA", "DATETIME_INTERVAL_CODE",
+		"DATETIME_INTERVAL_PRECISION", "DEFAULTS", "DEFERRABLE", "DEFERRED",
+		"DEFINED", "DEFINER", "DEGREE", "DEPTH", "DERIVED", "DESC",
+		"DESCRIPTOR", "DIAGNOSTICS", "DISPATCH", "DOMAIN", "DYNAMIC_FUNCTION",
+		"DYNAMIC_FUNCTION_CODE", "ENCODING", "ENFORCED", "ERROR", "EXCLUDE",
+		"EXCLUDING", "EXPRESSION", "FINAL", "FINISH", "FIRST", "FLAG",
+		"FOLLOWING", "FORMAT", "FORTRAN", "FOUND", "FULFILL", "G", "GENERAL",
+		"GENERATED", "GO", "GOTO", "GRANTED", "HIERARCHY", "IGNORE",
+		"IMMEDIATE", "IMMEDIATELY", "IMPLEMENTATION", "INCLUDING", "INCREMENT",
+		"INITIALLY", "INPUT", "INSTANCE", "INSTANTIABLE", "INSTEAD", "INVOKER",
+		"ISOLATION", "K", "KEEP", "KEY", "KEY_MEMBER", "KEY_TYPE", "KEYS",
+		"LAST", "LENGTH", "LEVEL", "LOCATOR", "M", "MAP", "MATCHED",
+		"MAXVALUE", "MESSAGE_LENGTH", "MESSAGE_OCTET_LENGTH", "MESSAGE_TEXT",
+		"MINVALUE", "MORE", "MUMPS", "NAME", "NAMES", "NESTED", "NESTING",
+		"NEXT", "NFC", "NFD", "NFKC", "NFKD", "NORMALIZED", "NULL_ORDERING",
+		"NULLABLE", "NULLS", "NUMBER", "OBJECT", "OCCURRENCE", "OCTETS",
+		"OPTION", "OPTIONS", "ORDERING", "ORDINALITY", "OTHERS", "OUTPUT",
+		"OVERFLOW", "OVERRIDING", "P", "PAD", "PARAMETER_MODE",
+		"PARAMETER_NAME", "PARAMETER_ORDINAL_POSITION",
+		"PARAMETER_SPECIFIC_CATALOG", "PARAMETER_SPECIFIC_NAME",
+		"PARAMETER_SPECIFIC_SCHEMA", "PARTIAL", "PASCAL", "PASS", "PASSING",
+		"PAST", "PATH", "PLACING", "PLAN", "PLI", "PRECEDING", "PRESERVE",
+		"PRIOR", "PRIVATE", "PRIVILEGES", "PRUNE", "PUBLIC", "QUOTES", "READ",
+		"RELATIVE", "REPEATABLE", "RESPECT", "RESTART", "RESTRICT",
+		"RETURNED_CARDINALITY", "RETURNED_LENGTH", "RETURNED_OCTET_LENGTH",
+		"RETURNED_SQLSTATE", "RETURNING", "ROLE", "ROUTINE", "ROUTINE_CATALOG",
+		"ROUTINE_NAME", "ROUTINE_SCHEMA", "ROW_COUNT", "SCALAR", "SCALE",
+		"SCHEMA", "SCHEMA_NAME", "SCOPE_CATALOG", "SCOPE_NAME", "SCOPE_SCHEMA",
+		"SECTION", "SECURITY", "SELF", "SEQUENCE", "SERIALIZABLE",
+		"SERVER_NAME", "SESSION", "SETS", "SIMPLE", "SIZE", "SOURCE", "SPACE",
+		"SPECIFIC_NAME", "STATE", "STATEMENT", "STRING", "STRUCTURE", "STYLE",
+		"SUBCLASS_ORIGIN", "T", "TABLE_NAME", "TEMPORARY", "THROUGH", "TIES",
+		"TOP_LEVEL_COUNT", "TRANSACTION", "TRANSACTION_ACTIVE",
+		"TRANSACTIONS_COMMITTED", "TRANSACTIONS_ROLLED_BACK", "TRANSFORM",
+		"TRANSFORMS", "TRIGGER_CATALOG", "TRIGGER_NAME", "TRIGGER_SCHEMA",
+		"TYPE", "UNBOUNDED", "UNCOMMITTED", "UNCONDITIONAL", "UNDER",
+		"UNNAMED", "USAGE", "USER_DEFINED_TYPE_CATALOG",
+		"USER_DEFINED_TYPE_CODE", "USER_DEFINED_TYPE_NAME",
+		"USER_DEFINED_TYPE_SCHEMA", "UTF16", "UTF32", "UTF8", "VIEW", "WORK",
+		"WRAPPER", "WRITE", "ZONE",
+	},
+)
@@ -0,0 +1,75 @@
+package dialect_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/generic"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/dialect/redshift"
+)
+
+func TestKeywords(t *testing.T) {
+	t.Run("NewKeywords", func(t *testing.T) {
+		kw := dialect.NewKeywords([]string{"level", "ORDER"}, []string{"Name", "level"})
+		if !kw.IsReserved("LEVEL") || !kw.IsReserved("order") {
+			t.Error("expected LEVEL and order to be reserved")
+		}
+		if kw.IsNonReserved("level") {
+			t.Error("expected a reserved word not to be reported as non-reserved")
+		}
+		if !kw.IsNonReserved("name") || !kw.IsKeyword("NAME") {
+			t.Error("expected name to be a non-reserved keyword")
+		}
+		if kw.IsKeyword("users") {
+			t.Error("expected users not to be a keyword")
+		}
+		if got := kw.Reserved(); !reflect.DeepEqual(got, []string{"LEVEL", "ORDER"}) {
+			t.Errorf("Reserved() = %v", got)
+		}
+		if got := kw.NonReserved(); !reflect.DeepEqual(got, []string{"NAME"}) {
+			t.Errorf("NonReserved() = %v", got)
+		}
+	})
+
+	t.Run("ZeroValue", func(t *testing.T) {
+		var kw dialect.Keywords
+		if kw.IsKeyword("select") || len(kw.Reserved()) != 0 {
+			t.Error("expected the zero value to hold no keywords")
+		}
+	})
+
+	t.Run("SQL2016", func(t *testing.T) {
+		if !dialect.SQL2016.IsReserved("year") || !dialect.SQL2016.IsReserved("select") {
+			t.Error("expected year and select to be reserved in SQL:2016")
+		}
+		if !dialect.SQL2016.IsNonReserved("level") || !dialect.SQL2016.IsNonReserved("size") {
+			t.Error("expected level and size to be non-reserved in SQL:2016")
+		}
+	})
+
+	t.Run("KeywordsOf", func(t *testing.T) {
+		cases := []struct {
+			name     string
+			d        dialect.SQLDialect
+			word     string
+			reserved bool
+		}{
+			{"Nil", nil, "year", true},
+			{"Generic", generic.New(), "level", false},
+			{"OracleLevel", oracle.New(), "level", true},
+			{"OracleSize", oracle.New(), "SIZE", true},
+			{"OracleYear", oracle.New(), "year", false},
+			{"RedshiftLevel", redshift.New(), "level", false},
+			{"RedshiftWallet", redshift.New(), "wallet", true},
+		}
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				if got := dialect.KeywordsOf(c.d).IsReserved(c.word); got != c.reserved {
+					t.Errorf("IsReserved(%q) = %v, want %v", c.word, got, c.reserved)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,72 @@
+package dialect
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrTooManyPlaceholders is matched, through errors.Is, by every
+// PlaceholderLimitError.
+var ErrTooManyPlaceholders = errors.New("too many placeholders")
+
+// PlaceholderLimitError reports a statement binding more parameters than
+// its dialect accepts (Options.MaxPlaceholderIndex).
+type PlaceholderLimitError struct {
+	// Dialect is the name of the dialect the statement was rendered for.
+	Dialect string
+
+	// Count is the number of placeholders in the statement.
+	Count int
+
+	// Max is the dialect's limit.
+	Max int
+}
+
+// Error implements error.
+func (e *PlaceholderLimitError) Error() string {
+	return fmt.Sprintf("%v: %d exceed the %s limit of %d", ErrTooManyPlaceholders, e.Count, e.Dialect, e.Max)
+}
+
+// Unwrap returns ErrTooManyPlaceholders.
+func (e *PlaceholderLimitError) Unwrap() error {
+	return ErrTooManyPlaceholders
+}
+
+// CheckPlaceholders returns a *PlaceholderLimitError when count exceeds
+// d's MaxPlaceholderIndex, and nil otherwise or for a nil dialect.
+//
+// Example:
+//
+//	dialect.CheckPlaceholders(db2.New(), 40000)
+//	// too many placeholders: 40000 exceed the db2 limit of 32767
+func CheckPlaceholders(d SQLDialect, count int) error {
+	if d == nil {
+		return nil
+	}
+	if max := d.Options().MaxPlaceholderIndex; max > 0 && count > max {
+		return &PlaceholderLimitError{Dialect: d.Name(), Count: count, Max: max}
+	}
+	return nil
+}
+
+// RowsPerStatement returns how many rows binding perRow parameters each
+// fit in one statement for d, or 0 when d has no limit. It is at least 1
+// when a limit applies, so a single row that overflows is still reported
+// by CheckPlaceholders rather than silently dropped.
+//
+// Example:
+//
+//	dialect.RowsPerStatement(db2.New(), 4) // 8191
+func RowsPerStatement(d SQLDialect, perRow int) int {
+	if d == nil || perRow <= 0 {
+		return 0
+	}
+	max := d.Options().MaxPlaceholderIndex
+	if max <= 0 {
+		return 0
+	}
+	if n := max / perRow; n > 0 {
+		return n
+	}
+	return 1
+}
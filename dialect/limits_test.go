@@ -0,0 +1,57 @@
+package dialect_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/db2"
+	"github.com/entiqon/db/dialect/generic"
+)
+
+func TestLimits(t *testing.T) {
+	t.Run("CheckPlaceholders", func(t *testing.T) {
+		if err := dialect.CheckPlaceholders(db2.New(), 32767); err != nil {
+			t.Errorf("expected no error at the limit, got %v", err)
+		}
+		if err := dialect.CheckPlaceholders(generic.New(), 1<<20); err != nil {
+			t.Errorf("expected no limit for generic, got %v", err)
+		}
+		if err := dialect.CheckPlaceholders(nil, 1<<20); err != nil {
+			t.Errorf("expected no limit without a dialect, got %v", err)
+		}
+
+		err := dialect.CheckPlaceholders(db2.New(), 40000)
+		var limit *dialect.PlaceholderLimitError
+		if !errors.As(err, &limit) || !errors.Is(err, dialect.ErrTooManyPlaceholders) {
+			t.Fatalf("expected PlaceholderLimitError, got %v", err)
+		}
+		if limit.Dialect != "db2" || limit.Count != 40000 || limit.Max != 32767 {
+			t.Errorf("unexpected error fields %+v", *limit)
+		}
+		if want := "too many placeholders: 40000 exceed the db2 limit of 32767"; err.Error() != want {
+			t.Errorf("expected %q, got %q", want, err.Error())
+		}
+	})
+
+	t.Run("RowsPerStatement", func(t *testing.T) {
+		capped := generic.NewWithOptions(dialect.Options{Name: "capped", MaxPlaceholderIndex: 10})
+		cases := []struct {
+			d      dialect.SQLDialect
+			perRow int
+			want   int
+		}{
+			{db2.New(), 4, 8191},
+			{capped, 3, 3},
+			{capped, 20, 1},
+			{capped, 0, 0},
+			{generic.New(), 3, 0},
+			{nil, 3, 0},
+		}
+		for _, c := range cases {
+			if got := dialect.RowsPerStatement(c.d, c.perRow); got != c.want {
+				t.Errorf("RowsPerStatement(%d) = %d, want %d", c.perRow, got, c.want)
+			}
+		}
+	})
+}
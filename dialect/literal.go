@@ -0,0 +1,405 @@
+package dialect
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrUnsupportedLiteral is returned by Literal and Interpolate for values
+// that have no safe literal form.
+var ErrUnsupportedLiteral = errors.New("unsupported literal")
+
+// TimestampLayout is the layout of the standard timestamp literal used by
+// QuoteTime. Fractional seconds are kept up to microseconds and trailing
+// zeros are dropped.
+const TimestampLayout = "2006-01-02 15:04:05.999999"
+
+// Literal renders v as a SQL literal for d. Unlike QuoteLiteral, it is
+// strict: values without a safe literal form yield ErrUnsupportedLiteral
+// instead of falling back to their fmt representation.
+//
+// Supported values:
+//   - nil and nil pointers → NULL
+//   - strings, booleans and integers → d.QuoteLiteral
+//   - finite floats, json.Number, *big.Int, *big.Float, *big.Rat → numbers
+//   - []byte → binary literal (QuoteBinary)
+//   - time.Time → typed timestamp literal in UTC (QuoteTime)
+//   - driver.Valuer (uuid, decimal, ...) → the literal of its Value
+//   - named types over the kinds above (type Status string)
+//   - non-nil pointers to any of the above
+//
+// Example:
+//
+//	dialect.Literal(generic.New(), "O'Reilly")      // 'O''Reilly', nil
+//	dialect.Literal(generic.New(), []byte{0xCA})    // X'CA', nil
+//	dialect.Literal(generic.New(), struct{}{})      // "", unsupported literal: struct {}
+func Literal(d SQLDialect, v any) (string, error) {
+	switch x := v.(type) {
+	case nil:
+		return d.QuoteLiteral(nil), nil
+	case driver.Valuer:
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+			return d.QuoteLiteral(nil), nil
+		}
+		value, err := x.Value()
+		if err != nil {
+			return "", fmt.Errorf("%T: %w", v, err)
+		}
+		return Literal(d, value)
+	case string, bool,
+		int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64:
+		return d.QuoteLiteral(x), nil
+	case float32:
+		return quoteFloat(d, float64(x))
+	case float64:
+		return quoteFloat(d, x)
+	case json.Number:
+		if _, err := strconv.ParseFloat(string(x), 64); err != nil {
+			return "", fmt.Errorf("%w: json.Number %q", ErrUnsupportedLiteral, string(x))
+		}
+		return string(x), nil
+	case *big.Int:
+		if x == nil {
+			return d.QuoteLiteral(nil), nil
+		}
+		return x.String(), nil
+	case *big.Float:
+		if x == nil {
+			return d.QuoteLiteral(nil), nil
+		}
+		if x.IsInf() {
+			return "", fmt.Errorf("%w: %v", ErrUnsupportedLiteral, x)
+		}
+		return x.Text('f', -1), nil
+	case *big.Rat:
+		if x == nil {
+			return d.QuoteLiteral(nil), nil
+		}
+		return x.FloatString(decimalDigits(x)), nil
+	case []byte:
+		if x == nil {
+			return d.QuoteLiteral(nil), nil
+		}
+		return QuoteBinary(d, x)
+	case time.Time:
+		return QuoteTime(d, x), nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return d.QuoteLiteral(nil), nil
+		}
+		return Literal(d, rv.Elem().Interface())
+	case reflect.String:
+		return d.QuoteLiteral(rv.String()), nil
+	case reflect.Bool:
+		return d.QuoteLiteral(rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return d.QuoteLiteral(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return d.QuoteLiteral(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return quoteFloat(d, rv.Float())
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return Literal(d, rv.Bytes())
+		}
+	}
+	return "", fmt.Errorf("%w: %T", ErrUnsupportedLiteral, v)
+}
+
+// MustLiteral is like Literal but panics when v has no safe literal form.
+// The QuoteLiteral methods of the dialect packages fall back to it for
+// values outside their own cases (named types, pointers, driver.Valuer,
+// ...), so a struct, map or other unsupported value is refused rather
+// than written into a statement. Use Literal to get the error instead.
+//
+// Example:
+//
+//	dialect.MustLiteral(postgres.New(), Status("active")) // 'active'
+//	dialect.MustLiteral(postgres.New(), struct{}{})       // panics: unsupported literal: struct {}
+func MustLiteral(d SQLDialect, v any) string {
+	lit, err := Literal(d, v)
+	if err != nil {
+		panic(err)
+	}
+	return lit
+}
+
+// QuoteBinary renders data as a binary literal for d.
+//
+// If d implements BinaryQuoter, rendering is delegated to it; otherwise
+// the SQL standard hexadecimal form X'..' is used. Dialects without a
+// binary literal yield ErrUnsupportedLiteral.
+//
+// Example:
+//
+//	dialect.QuoteBinary(generic.New(), []byte("hi")) // X'6869'
+func QuoteBinary(d SQLDialect, data []byte) (string, error) {
+	if q, ok := d.(BinaryQuoter); ok {
+		if s := q.QuoteBinary(data); s != "" {
+			return s, nil
+		}
+		return "", fmt.Errorf("%w: %s has no binary literal", ErrUnsupportedLiteral, d.Name())
+	}
+	return "X'" + strings.ToUpper(hex.EncodeToString(data)) + "'", nil
+}
+
+// QuoteTime renders t as a typed timestamp literal for d.
+//
+// If d implements TimeQuoter, rendering is delegated to it; otherwise the
+// SQL standard form TIMESTAMP '..' is used. Times are converted to UTC
+// first, so the literal denotes the same instant whatever the zone of t.
+//
+// Example:
+//
+//	dialect.QuoteTime(generic.New(), t) // TIMESTAMP '2025-09-19 03:30:00.5'
+func QuoteTime(d SQLDialect, t time.Time) string {
+	if q, ok := d.(TimeQuoter); ok {
+		return q.QuoteTime(t)
+	}
+	return "TIMESTAMP '" + t.UTC().Format(TimestampLayout) + "'"
+}
+
+// Interpolate replaces the placeholders of query, as rendered by
+// d.Placeholder, with the literals of args. The result is meant for logs,
+// EXPLAIN and DDL defaults, never for execution of untrusted input.
+//
+// Unnumbered placeholders ("?") are bound in order; numbered ones (":1",
+// "$1", "@p1") by index. Placeholders inside quoted strings, quoted
+// identifiers and comments are left alone. Interpolate fails on a nil
+// dialect, on values Literal refuses, and when placeholders and args do
+// not match.
+//
+// Example:
+//
+//	dialect.Interpolate(oracle.New(), "SELECT * FROM users WHERE id = :1", []any{42})
+//	// SELECT * FROM users WHERE id = 42
+func Interpolate(d SQLDialect, query string, args []any) (string, error) {
+	if d == nil {
+		return "", errors.New("interpolate: no dialect")
+	}
+
+	literals := make([]string, len(args))
+	for i, a := range args {
+		lit, err := Literal(d, a)
+		if err != nil {
+			return "", fmt.Errorf("interpolate: argument %d: %w", i+1, err)
+		}
+		literals[i] = lit
+	}
+
+	marker := d.Placeholder(1)
+	numbered := marker != d.Placeholder(2)
+	if numbered {
+		marker = strings.TrimSuffix(marker, "1")
+	}
+	if marker == "" {
+		return "", fmt.Errorf("interpolate: %s has no placeholder marker", d.Name())
+	}
+	backslash, brackets := lexing(d)
+
+	var sb strings.Builder
+	next := 0
+	used := make([]bool, len(args))
+	for i := 0; i < len(query); {
+		if end := skipQuoted(query, i, backslash, brackets); end > i {
+			sb.WriteString(query[i:end])
+			i = end
+			continue
+		}
+		if !strings.HasPrefix(query[i:], marker) {
+			sb.WriteByte(query[i])
+			i++
+			continue
+		}
+
+		if !numbered {
+			if next >= len(literals) {
+				return "", fmt.Errorf("interpolate: more placeholders than the %d args", len(args))
+			}
+			sb.WriteString(literals[next])
+			used[next] = true
+			next++
+			i += len(marker)
+			continue
+		}
+
+		j := i + len(marker)
+		for j < len(query) && query[j] >= '0' && query[j] <= '9' {
+			j++
+		}
+		if j == i+len(marker) {
+			sb.WriteString(marker)
+			i = j
+			continue
+		}
+		n, _ := strconv.Atoi(query[i+len(marker) : j])
+		if n < 1 || n > len(literals) {
+			return "", fmt.Errorf("interpolate: placeholder %s out of range for %d args", query[i:j], len(args))
+		}
+		sb.WriteString(literals[n-1])
+		used[n-1] = true
+		i = j
+	}
+
+	for i, ok := range used {
+		if !ok {
+			return "", fmt.Errorf("interpolate: argument %d has no placeholder", i+1)
+		}
+	}
+	return sb.String(), nil
+}
+
+// Sanitize replaces the literals of query, as rendered for d, with "?":
+// quoted strings, prefixed ones such as N'..' or X'..', and numbers.
+// Identifiers, placeholders and comments are kept. It is the reverse of
+// Interpolate, hiding the values written into a statement rather than
+// bound, for instance before it is traced. A nil d reads standard SQL.
+//
+// Example:
+//
+//	dialect.Sanitize(oracle.New(), "SELECT * FROM users WHERE name = 'bob' AND age > 30 AND id = :1")
+//	// SELECT * FROM users WHERE name = ? AND age > ? AND id = :1
+func Sanitize(d SQLDialect, query string) string {
+	backslash, brackets := lexing(d)
+
+	var sb strings.Builder
+	for i := 0; i < len(query); {
+		c := query[i]
+		if end := skipQuoted(query, i, backslash, brackets); end > i {
+			if c == '\'' {
+				sb.WriteByte('?')
+			} else {
+				sb.WriteString(query[i:end])
+			}
+			i = end
+			continue
+		}
+
+		bare := i == 0 || (!isIdentByte(query[i-1]) && strings.IndexByte(":@?", query[i-1]) < 0)
+		switch {
+		case bare && strings.IndexByte("BbEeNnXx", c) >= 0 && i+1 < len(query) && query[i+1] == '\'':
+			i++ // prefix of the string that follows
+		case bare && c >= '0' && c <= '9':
+			sb.WriteByte('?')
+			i = numberEnd(query, i)
+		default:
+			sb.WriteByte(c)
+			i++
+		}
+	}
+	return sb.String()
+}
+
+// numberEnd returns the end of the number starting at query[i], exponent
+// included.
+func numberEnd(query string, i int) int {
+	for i++; i < len(query); i++ {
+		c, prev := query[i], query[i-1]
+		exponent := (c == '+' || c == '-') && (prev == 'e' || prev == 'E')
+		if !isIdentByte(c) && c != '.' && !exponent {
+			break
+		}
+	}
+	return i
+}
+
+// lexing reports how query text for d is scanned by skipQuoted: backslash
+// is set when d escapes backslashes in string literals, as MySQL does, and
+// brackets when it quotes identifiers as [name]. A nil d reads standard SQL.
+func lexing(d SQLDialect) (backslash, brackets bool) {
+	if d == nil {
+		return false, false
+	}
+	return d.QuoteLiteral(`\`) != `'\'`, d.Options().QuoteStyle == "["
+}
+
+// skipQuoted returns the end of the quoted string, quoted identifier or
+// comment starting at query[i], or i when none starts there. Inside
+// string literals, a backslash escapes the next character when backslash
+// is set; [..] is an identifier only when brackets is set.
+func skipQuoted(query string, i int, backslash, brackets bool) int {
+	switch c := query[i]; {
+	case c == '\'' || c == '"' || c == '`':
+		for j := i + 1; j < len(query); j++ {
+			switch {
+			case backslash && c == '\'' && query[j] == '\\':
+				j++
+			case query[j] == c:
+				if j+1 < len(query) && query[j+1] == c {
+					j++
+					continue
+				}
+				return j + 1
+			}
+		}
+		return len(query)
+	case c == '[' && brackets:
+		if j := strings.IndexByte(query[i:], ']'); j > 0 {
+			return i + j + 1
+		}
+	case strings.HasPrefix(query[i:], "--"):
+		if j := strings.IndexByte(query[i:], '\n'); j > 0 {
+			return i + j
+		}
+		return len(query)
+	case strings.HasPrefix(query[i:], "/*"):
+		if j := strings.Index(query[i+2:], "*/"); j >= 0 {
+			return i + 2 + j + 2
+		}
+		return len(query)
+	}
+	return i
+}
+
+// quoteFloat renders a finite float; NaN and infinities have no portable
+// literal.
+func quoteFloat(d SQLDialect, f float64) (string, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", fmt.Errorf("%w: %v", ErrUnsupportedLiteral, f)
+	}
+	return d.QuoteLiteral(f), nil
+}
+
+// decimalDigits returns the number of decimals needed to render r exactly,
+// capped at 30 for non-terminating fractions.
+func decimalDigits(r *big.Rat) int {
+	if r.IsInt() {
+		return 0
+	}
+	den := new(big.Int).Set(r.Denom())
+	two, five := big.NewInt(2), big.NewInt(5)
+	digits := 0
+	for _, p := range []*big.Int{two, five} {
+		n := 0
+		m := new(big.Int)
+		for {
+			q, rem := new(big.Int).QuoRem(den, p, m)
+			if rem.Sign() != 0 {
+				break
+			}
+			den = q
+			n++
+		}
+		if n > digits {
+			digits = n
+		}
+	}
+	if den.Cmp(big.NewInt(1)) != 0 {
+		return 30
+	}
+	return digits
+}
@@ -0,0 +1,233 @@
+package dialect_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/bigquery"
+	"github.com/entiqon/db/dialect/clickhouse"
+	"github.com/entiqon/db/dialect/db2"
+	"github.com/entiqon/db/dialect/firebird"
+	"github.com/entiqon/db/dialect/generic"
+	"github.com/entiqon/db/dialect/informix"
+	"github.com/entiqon/db/dialect/mssql"
+	"github.com/entiqon/db/dialect/mysql"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/dialect/postgres"
+	"github.com/entiqon/db/dialect/redshift"
+	"github.com/entiqon/db/dialect/snowflake"
+	"github.com/entiqon/db/dialect/sqlite"
+)
+
+// status is a named string type, as used for enums.
+type status string
+
+// uuid mimics a UUID type bound through driver.Valuer.
+type uuid [2]byte
+
+func (u uuid) Value() (driver.Value, error) { return "0a0b", nil }
+
+// broken is a driver.Valuer that fails.
+type broken struct{}
+
+func (broken) Value() (driver.Value, error) { return nil, errors.New("boom") }
+
+func TestLiteral(t *testing.T) {
+	d := generic.New()
+	now := time.Date(2025, 9, 19, 3, 30, 0, 0, time.UTC)
+	name := "O'Reilly"
+	var nilPtr *int
+	var nilUUID *uuid
+
+	t.Run("Supported", func(t *testing.T) {
+		cases := []struct {
+			in   any
+			want string
+		}{
+			{nil, "NULL"},
+			{nilPtr, "NULL"},
+			{nilUUID, "NULL"},
+			{[]byte(nil), "NULL"},
+			{"O'Reilly", "'O''Reilly'"},
+			{&name, "'O''Reilly'"},
+			{status("active"), "'active'"},
+			{true, "TRUE"},
+			{42, "42"},
+			{int8(-3), "-3"},
+			{uint64(7), "7"},
+			{2.5, "2.5"},
+			{json.Number("12.50"), "12.50"},
+			{big.NewInt(12), "12"},
+			{big.NewFloat(1.25), "1.25"},
+			{big.NewRat(1, 8), "0.125"},
+			{big.NewRat(1, 3), "0.333333333333333333333333333333"},
+			{[]byte{0xCA, 0xFE}, "X'CAFE'"},
+			{json.RawMessage(`{}`), "X'7B7D'"},
+			{now.In(time.FixedZone("CEST", 2*3600)), "TIMESTAMP '2025-09-19 03:30:00'"},
+			{uuid{}, "'0a0b'"},
+		}
+		for _, c := range cases {
+			got, err := dialect.Literal(d, c.in)
+			if err != nil || got != c.want {
+				t.Errorf("Literal(%#v) = %q (%v), want %q", c.in, got, err, c.want)
+			}
+		}
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		cases := []any{
+			struct{}{},
+			[]int{1, 2},
+			map[string]int{},
+			math.NaN(),
+			math.Inf(1),
+			json.Number("abc"),
+			[16]byte{},
+		}
+		for _, c := range cases {
+			if _, err := dialect.Literal(d, c); !errors.Is(err, dialect.ErrUnsupportedLiteral) {
+				t.Errorf("Literal(%#v) error = %v, want ErrUnsupportedLiteral", c, err)
+			}
+		}
+
+		if _, err := dialect.Literal(d, broken{}); err == nil || !strings.Contains(err.Error(), "boom") {
+			t.Errorf("expected Valuer error, got %v", err)
+		}
+		if _, err := dialect.Literal(informix.New(), []byte{1}); !errors.Is(err, dialect.ErrUnsupportedLiteral) {
+			t.Errorf("expected Informix binary literal to be refused, got %v", err)
+		}
+	})
+
+	t.Run("MustLiteral", func(t *testing.T) {
+		if got := dialect.MustLiteral(d, status("on")); got != "'on'" {
+			t.Errorf("MustLiteral(status) = %q, want %q", got, "'on'")
+		}
+		defer func() {
+			if err, _ := recover().(error); !errors.Is(err, dialect.ErrUnsupportedLiteral) {
+				t.Errorf("MustLiteral(struct) panic = %v, want ErrUnsupportedLiteral", err)
+			}
+		}()
+		dialect.MustLiteral(d, struct{}{})
+		t.Error("MustLiteral(struct) did not panic")
+	})
+
+	t.Run("QuoteLiteralRefuses", func(t *testing.T) {
+		dialects := []dialect.SQLDialect{
+			bigquery.New(), clickhouse.New(), db2.New(), firebird.New(),
+			generic.New(), informix.New(), mssql.New(), mysql.New(), oracle.New(),
+			postgres.New(), redshift.New(), snowflake.New(), sqlite.New(),
+		}
+		for _, d := range dialects {
+			func() {
+				defer func() {
+					if err, _ := recover().(error); !errors.Is(err, dialect.ErrUnsupportedLiteral) {
+						t.Errorf("%s: QuoteLiteral([]int) panic = %v, want ErrUnsupportedLiteral", d.Name(), err)
+					}
+				}()
+				got := d.QuoteLiteral([]int{1})
+				t.Errorf("%s: QuoteLiteral([]int) = %q, want a panic", d.Name(), got)
+			}()
+		}
+	})
+
+	t.Run("PerDialect", func(t *testing.T) {
+		if got, _ := dialect.Literal(oracle.New(), []byte("hi")); got != "HEXTORAW('6869')" {
+			t.Errorf("unexpected oracle binary %q", got)
+		}
+		if got, _ := dialect.Literal(oracle.New(), true); got != "1" {
+			t.Errorf("unexpected oracle boolean %q", got)
+		}
+		if got, _ := dialect.Literal(clickhouse.New(), `it's a \ test`); got != `'it\'s a \\ test'` {
+			t.Errorf("unexpected clickhouse string %q", got)
+		}
+		if got, _ := dialect.Literal(informix.New(), now); got != "DATETIME (2025-09-19 03:30:00) YEAR TO SECOND" {
+			t.Errorf("unexpected informix timestamp %q", got)
+		}
+	})
+}
+
+func TestInterpolate(t *testing.T) {
+	t.Run("Unnumbered", func(t *testing.T) {
+		got, err := dialect.Interpolate(generic.New(),
+			"SELECT '?' AS q, \"a?\" FROM t -- ?\nWHERE a = ? AND b IN (?, ?) /* ? */",
+			[]any{"x'y", 1, nil})
+		want := "SELECT '?' AS q, \"a?\" FROM t -- ?\nWHERE a = 'x''y' AND b IN (1, NULL) /* ? */"
+		if err != nil || got != want {
+			t.Errorf("got %q (%v), want %q", got, err, want)
+		}
+	})
+
+	t.Run("Numbered", func(t *testing.T) {
+		got, err := dialect.Interpolate(oracle.New(),
+			"SELECT * FROM t WHERE a = :2 AND b = :1 AND c = :2 AND d = ':1'",
+			[]any{"b", 2})
+		want := "SELECT * FROM t WHERE a = 2 AND b = 'b' AND c = 2 AND d = ':1'"
+		if err != nil || got != want {
+			t.Errorf("got %q (%v), want %q", got, err, want)
+		}
+	})
+
+	t.Run("Backslash", func(t *testing.T) {
+		got, err := dialect.Interpolate(clickhouse.New(), `SELECT 'a\'?' WHERE x = ?`, []any{1})
+		if err != nil || got != `SELECT 'a\'?' WHERE x = 1` {
+			t.Errorf("got %q (%v)", got, err)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		if _, err := dialect.Interpolate(nil, "SELECT 1", nil); err == nil {
+			t.Error("expected error for nil dialect")
+		}
+		if _, err := dialect.Interpolate(generic.New(), "a = ? AND b = ?", []any{1}); err == nil {
+			t.Error("expected error for missing argument")
+		}
+		if _, err := dialect.Interpolate(generic.New(), "a = ?", []any{1, 2}); err == nil {
+			t.Error("expected error for unused argument")
+		}
+		if _, err := dialect.Interpolate(oracle.New(), "a = :3", []any{1}); err == nil {
+			t.Error("expected error for out of range placeholder")
+		}
+		_, err := dialect.Interpolate(generic.New(), "a = ?", []any{struct{}{}})
+		if !errors.Is(err, dialect.ErrUnsupportedLiteral) || !strings.Contains(err.Error(), "argument 1") {
+			t.Errorf("expected unsupported argument error, got %v", err)
+		}
+	})
+}
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name  string
+		d     dialect.SQLDialect
+		query string
+		want  string
+	}{
+		{"Strings", generic.New(),
+			"SELECT * FROM t WHERE a = 'x''y' AND b = N'z' AND c = X'CA'",
+			"SELECT * FROM t WHERE a = ? AND b = ? AND c = ?"},
+		{"Numbers", generic.New(),
+			"SELECT a1, t2.b FROM t2 WHERE a > 30 AND b < -1.5e-3 LIMIT 10",
+			"SELECT a1, t2.b FROM t2 WHERE a > ? AND b < -? LIMIT ?"},
+		{"Placeholders", oracle.New(),
+			"SELECT * FROM t WHERE a = :1 AND b = 'c' AND c = ?",
+			"SELECT * FROM t WHERE a = :1 AND b = ? AND c = ?"},
+		{"Quoted", generic.New(),
+			`SELECT "a'1" FROM t -- 'x' 1` + "\n" + `/* 2 */ WHERE x = 'it''s'`,
+			`SELECT "a'1" FROM t -- 'x' 1` + "\n" + `/* 2 */ WHERE x = ?`},
+		{"Backslash", clickhouse.New(), `SELECT 'a\'b', 1`, `SELECT ?, ?`},
+		{"Neutral", nil, "SELECT * FROM t WHERE a = :a AND b = 5", "SELECT * FROM t WHERE a = :a AND b = ?"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dialect.Sanitize(tt.d, tt.query); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
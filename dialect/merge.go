@@ -0,0 +1,122 @@
+// File: db/dialect/merge.go
+
+package dialect
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MergeSource renders the USING clause of a single-row MERGE upsert: the
+// row of placeholders, aliased src, whose columns are named columns.
+// Both slices are in column order; columns are already quoted.
+type MergeSource func(columns, placeholders []string) string
+
+// MergeSelect returns a MergeSource selecting the row of placeholders
+// from table, such as Oracle's dual or Firebird's RDB$DATABASE. An empty
+// table selects the row without FROM.
+//
+// Example:
+//
+//	dialect.MergeSelect("dual")([]string{"id"}, []string{":1"})
+//	// (SELECT :1 AS id FROM dual) src
+func MergeSelect(table string) MergeSource {
+	return func(columns, placeholders []string) string {
+		selects := make([]string, len(columns))
+		for i, c := range columns {
+			selects[i] = fmt.Sprintf("%s AS %s", placeholders[i], c)
+		}
+		from := ""
+		if table != "" {
+			from = " FROM " + table
+		}
+		return fmt.Sprintf("(SELECT %s%s) src", strings.Join(selects, ", "), from)
+	}
+}
+
+// MergeValues is the MergeSource reading the row from a VALUES table
+// constructor, as Db2 does.
+//
+// Example:
+//
+//	dialect.MergeValues([]string{"id", "name"}, []string{"?", "?"})
+//	// (VALUES (?, ?)) AS src (id, name)
+func MergeValues(columns, placeholders []string) string {
+	return fmt.Sprintf("(VALUES (%s)) AS src (%s)",
+		strings.Join(placeholders, ", "), strings.Join(columns, ", "))
+}
+
+// MergeStyle describes how a dialect spells a single-row MERGE upsert.
+type MergeStyle struct {
+	// Source renders the USING clause.
+	Source MergeSource
+
+	// UnqualifiedSet leaves the target alias out of the UPDATE SET
+	// assignments, as BigQuery requires.
+	UnqualifiedSet bool
+}
+
+// Merge renders the MERGE upsert shared by the dialects implementing
+// Merger: a row of columns, bound positionally in column order through
+// d.Placeholder, is read from style.Source and matched on keys. Non-key
+// columns are updated when a matching row exists; when every column is a
+// key, only the insert branch is rendered. Names are quoted with
+// d.QuoteIdentifier. It returns "" when table, columns or keys are empty.
+//
+// Example:
+//
+//	dialect.Merge(d, "users", []string{"id", "name"}, []string{"id"},
+//	    dialect.MergeStyle{Source: dialect.MergeSelect("dual")})
+//	// MERGE INTO users tgt USING (SELECT :1 AS id, :2 AS name FROM dual) src
+//	//   ON (tgt.id = src.id)
+//	//   WHEN MATCHED THEN UPDATE SET tgt.name = src.name
+//	//   WHEN NOT MATCHED THEN INSERT (id, name) VALUES (src.id, src.name)
+func Merge(d SQLDialect, table string, columns, keys []string, style MergeStyle) string {
+	if table == "" || len(columns) == 0 || len(keys) == 0 {
+		return ""
+	}
+
+	isKey := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		isKey[k] = true
+	}
+
+	set := "tgt."
+	if style.UnqualifiedSet {
+		set = ""
+	}
+
+	cols := make([]string, len(columns))
+	marks := make([]string, len(columns))
+	values := make([]string, len(columns))
+	var updates []string
+	for i, c := range columns {
+		q := d.QuoteIdentifier(c)
+		cols[i] = q
+		marks[i] = d.Placeholder(i + 1)
+		values[i] = "src." + q
+		if !isKey[c] {
+			updates = append(updates, fmt.Sprintf("%s%s = src.%s", set, q, q))
+		}
+	}
+
+	on := make([]string, len(keys))
+	for i, k := range keys {
+		q := d.QuoteIdentifier(k)
+		on[i] = fmt.Sprintf("tgt.%s = src.%s", q, q)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(
+		"MERGE INTO %s tgt USING %s ON (%s)",
+		d.QuoteIdentifier(table), style.Source(cols, marks), strings.Join(on, " AND "),
+	))
+	if len(updates) > 0 {
+		sb.WriteString(" WHEN MATCHED THEN UPDATE SET " + strings.Join(updates, ", "))
+	}
+	sb.WriteString(fmt.Sprintf(
+		" WHEN NOT MATCHED THEN INSERT (%s) VALUES (%s)",
+		strings.Join(cols, ", "), strings.Join(values, ", "),
+	))
+	return sb.String()
+}
@@ -0,0 +1,74 @@
+package dialect_test
+
+import (
+	"testing"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/generic"
+)
+
+func TestMerge(t *testing.T) {
+	d := generic.New()
+	columns, keys := []string{"id", "name"}, []string{"id"}
+
+	t.Run("Select", func(t *testing.T) {
+		got := dialect.Merge(d, "users", columns, keys, dialect.MergeStyle{Source: dialect.MergeSelect("dual")})
+		want := "MERGE INTO users tgt USING (SELECT ? AS id, ? AS name FROM dual) src ON (tgt.id = src.id)" +
+			" WHEN MATCHED THEN UPDATE SET tgt.name = src.name" +
+			" WHEN NOT MATCHED THEN INSERT (id, name) VALUES (src.id, src.name)"
+		if got != want {
+			t.Errorf("Merge = %q\nwant    %q", got, want)
+		}
+
+		got = dialect.Merge(d, "users", columns, keys, dialect.MergeStyle{Source: dialect.MergeSelect("")})
+		want = "MERGE INTO users tgt USING (SELECT ? AS id, ? AS name) src ON (tgt.id = src.id)" +
+			" WHEN MATCHED THEN UPDATE SET tgt.name = src.name" +
+			" WHEN NOT MATCHED THEN INSERT (id, name) VALUES (src.id, src.name)"
+		if got != want {
+			t.Errorf("Merge without FROM = %q\nwant    %q", got, want)
+		}
+	})
+
+	t.Run("Values", func(t *testing.T) {
+		got := dialect.Merge(d, "users", columns, keys, dialect.MergeStyle{Source: dialect.MergeValues})
+		want := "MERGE INTO users tgt USING (VALUES (?, ?)) AS src (id, name) ON (tgt.id = src.id)" +
+			" WHEN MATCHED THEN UPDATE SET tgt.name = src.name" +
+			" WHEN NOT MATCHED THEN INSERT (id, name) VALUES (src.id, src.name)"
+		if got != want {
+			t.Errorf("Merge = %q\nwant    %q", got, want)
+		}
+	})
+
+	t.Run("UnqualifiedSet", func(t *testing.T) {
+		style := dialect.MergeStyle{Source: dialect.MergeSelect(""), UnqualifiedSet: true}
+		got := dialect.Merge(d, "users", []string{"id", "name", "order"}, keys, style)
+		want := `MERGE INTO users tgt USING (SELECT ? AS id, ? AS name, ? AS "order") src ON (tgt.id = src.id)` +
+			` WHEN MATCHED THEN UPDATE SET name = src.name, "order" = src."order"` +
+			` WHEN NOT MATCHED THEN INSERT (id, name, "order") VALUES (src.id, src.name, src."order")`
+		if got != want {
+			t.Errorf("Merge = %q\nwant    %q", got, want)
+		}
+	})
+
+	t.Run("KeysOnly", func(t *testing.T) {
+		got := dialect.Merge(d, "tags", []string{"id"}, keys, dialect.MergeStyle{Source: dialect.MergeValues})
+		want := "MERGE INTO tags tgt USING (VALUES (?)) AS src (id) ON (tgt.id = src.id)" +
+			" WHEN NOT MATCHED THEN INSERT (id) VALUES (src.id)"
+		if got != want {
+			t.Errorf("Merge = %q\nwant    %q", got, want)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		style := dialect.MergeStyle{Source: dialect.MergeValues}
+		if got := dialect.Merge(d, "", columns, keys, style); got != "" {
+			t.Errorf("expected empty statement without table, got %q", got)
+		}
+		if got := dialect.Merge(d, "users", nil, keys, style); got != "" {
+			t.Errorf("expected empty statement without columns, got %q", got)
+		}
+		if got := dialect.Merge(d, "users", columns, nil, style); got != "" {
+			t.Errorf("expected empty statement without keys, got %q", got)
+		}
+	})
+}
@@ -0,0 +1,33 @@
+/*
+Package mssql provides the Microsoft SQL Server dialect implementation.
+
+# Overview
+
+The SQL Server dialect renders SQL following T-SQL rules:
+
+  - Identifiers are quoted with brackets ([Order Items]); regular names
+    are emitted bare and are not folded.
+  - Placeholders are numbered (@p1, @p2, ...), up to 2099 per statement.
+  - Pagination uses OFFSET n ROWS FETCH NEXT m ROWS ONLY (SQL Server
+    2012+). It requires an ORDER BY, so Paginate adds ORDER BY (SELECT
+    NULL) to queries without one.
+  - Booleans are BIT values (1 / 0); timestamps are DATETIME2.
+  - Savepoints use SAVE TRANSACTION and ROLLBACK TRANSACTION, and cannot
+    be released.
+  - Upserts use MERGE, terminated by a semicolon; RETURNING is not
+    supported.
+
+# Usage
+
+	d := mssql.New()
+	sql := dialect.Paginate(d, "SELECT id FROM users", 10, 0)
+	// SELECT id FROM users ORDER BY (SELECT NULL) OFFSET 0 ROWS FETCH NEXT 10 ROWS ONLY
+
+# Capabilities
+
+Besides dialect.SQLDialect, the SQL Server dialect implements the optional
+dialect.Paginator, dialect.Merger, dialect.Savepointer,
+dialect.ErrorClassifier, dialect.BinaryQuoter and dialect.TimeQuoter
+interfaces.
+*/
+package mssql
@@ -0,0 +1,34 @@
+package mssql_test
+
+import (
+	"fmt"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/mssql"
+)
+
+func Example() {
+	d := mssql.New()
+	fmt.Println(d.Placeholder(1))
+	fmt.Println(d.QuoteIdentifier("Order Items"))
+	// Output:
+	// @p1
+	// [Order Items]
+}
+
+func Example_paginate() {
+	d := mssql.New()
+	fmt.Println(dialect.Paginate(d, "SELECT id FROM users", 10, 20))
+	// Output:
+	// SELECT id FROM users ORDER BY (SELECT NULL) OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY
+}
+
+func Example_savepoint() {
+	d := mssql.New()
+	set, _ := dialect.Savepoint(d, "sp_1")
+	fmt.Println(set)
+	fmt.Println(dialect.RollbackToSavepoint(d, "sp_1"))
+	// Output:
+	// SAVE TRANSACTION sp_1
+	// ROLLBACK TRANSACTION sp_1
+}
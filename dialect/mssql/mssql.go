@@ -0,0 +1,308 @@
+package mssql
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+)
+
+//
+// SQL Server Dialect
+//
+
+// dialectImpl provides the Microsoft SQL Server implementation of the
+// dialect.SQLDialect interface. It is unexported to prevent direct
+// instantiation; consumers should always use the New() constructor.
+//
+// Key behaviors:
+//   - Identifiers are quoted with brackets ([order]); names are not folded.
+//   - Placeholders are "@p1", "@p2", ..., up to 2099 per statement (the
+//     2100 parameters of sp_executesql, less the statement itself).
+//   - Pagination uses OFFSET n ROWS FETCH NEXT m ROWS ONLY (2012+), which
+//     requires an ORDER BY.
+//   - Savepoints use SAVE TRANSACTION and cannot be released.
+//   - Upserts use MERGE; RETURNING is not supported (OUTPUT differs).
+type dialectImpl struct {
+	opts dialect.Options
+}
+
+// Compile-time checks: ensure dialectImpl implements SQLDialect and the
+// optional capabilities it advertises.
+var (
+	_ dialect.SQLDialect      = (*dialectImpl)(nil)
+	_ dialect.Paginator       = (*dialectImpl)(nil)
+	_ dialect.Merger          = (*dialectImpl)(nil)
+	_ dialect.Savepointer     = (*dialectImpl)(nil)
+	_ dialect.ErrorClassifier = (*dialectImpl)(nil)
+	_ dialect.BinaryQuoter    = (*dialectImpl)(nil)
+	_ dialect.TimeQuoter      = (*dialectImpl)(nil)
+)
+
+// bareIdentifier matches identifiers that can be emitted without quotes.
+var bareIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_@#$]*$`)
+
+//
+// Constructors
+//
+
+// New returns a Microsoft SQL Server dialect. The returned value implements
+// the dialect.SQLDialect interface.
+//
+// Example:
+//
+//	d := mssql.New()
+//	d.Placeholder(2) // → "@p2"
+func New() dialect.SQLDialect {
+	return &dialectImpl{
+		opts: dialect.Options{
+			Name:                    "mssql",
+			QuoteStyle:              "[",
+			PlaceholderStyle:        "@p%d",
+			AllowMerge:              true,
+			AllowUpsert:             false,
+			ForcedAliasing:          true,
+			EnableReturning:         false,
+			SupportsCTE:             true,
+			SupportsWindowFunctions: true,
+			SupportsNullsOrdering:   false,
+			SupportsILike:           false,
+			SupportsArrayBinding:    false,
+			SupportsValuesList:      true,
+			MaxPlaceholderIndex:     2099,
+			MaxIdentifierLength:     128,
+		},
+	}
+}
+
+// NewWithOptions creates a SQL Server dialect with the given static
+// options. Options are immutable once the dialect is constructed.
+func NewWithOptions(opts dialect.Options) dialect.SQLDialect {
+	return &dialectImpl{opts: opts}
+}
+
+//
+// Interface Implementation
+//
+
+// Name returns the identifier of this dialect, "mssql" by default.
+func (d *dialectImpl) Name() string {
+	return d.opts.Name
+}
+
+// Options returns the feature capability matrix for the SQL Server dialect.
+func (d *dialectImpl) Options() dialect.Options {
+	return d.opts
+}
+
+// QuoteIdentifier returns a SQL Server identifier. Regular names are
+// returned as-is; anything else is wrapped in brackets, with embedded
+// closing brackets escaped by doubling them.
+//
+// Schema-qualified names are quoted part by part and reserved words
+// are quoted, subject to Options().QuotePolicy.
+//
+// Example:
+//
+//	d.QuoteIdentifier("dbo.Users")   // → dbo.Users
+//	d.QuoteIdentifier("Order Items") // → [Order Items]
+func (d *dialectImpl) QuoteIdentifier(name string) string {
+	return dialect.NewQuoter(d.opts, bareIdentifier.MatchString).Quote(name)
+}
+
+// QuoteLiteral quotes a literal value for inline use in SQL Server SQL.
+//
+// Supported types:
+//   - nil       → NULL
+//   - string    → escaped and wrapped in single quotes
+//   - bool      → 1 or 0 (BIT, there is no boolean literal)
+//   - numbers   → rendered in decimal form
+//   - time.Time → CAST('YYYY-MM-DD HH:MM:SS' AS DATETIME2) in UTC
+//   - []byte    → 0x..
+//   - others    → dialect.MustLiteral
+func (d *dialectImpl) QuoteLiteral(literal any) string {
+	switch v := literal.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return "'" + strings.ReplaceAll(v, "'", "''") + "'"
+	case bool:
+		if v {
+			return "1"
+		}
+		return "0"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 64)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return dialect.MustLiteral(d, v)
+	}
+}
+
+// QuoteBinary renders data as a binary constant.
+//
+// Example:
+//
+//	d.QuoteBinary([]byte("hi")) // → 0x6869
+func (d *dialectImpl) QuoteBinary(data []byte) string {
+	return "0x" + strings.ToUpper(hex.EncodeToString(data))
+}
+
+// QuoteTime renders t as a DATETIME2 in UTC. TIMESTAMP is a row version
+// type in SQL Server, so the standard literal cannot be used.
+//
+// Example:
+//
+//	d.QuoteTime(t) // → CAST('2025-09-19 03:30:00' AS DATETIME2)
+func (d *dialectImpl) QuoteTime(t time.Time) string {
+	return fmt.Sprintf("CAST('%s' AS DATETIME2)", t.UTC().Format(dialect.TimestampLayout))
+}
+
+// PaginationSyntax renders the SQL Server OFFSET/FETCH clause. OFFSET is
+// mandatory, so limit-only pagination starts at OFFSET 0 ROWS. The clause
+// is only valid after ORDER BY; use Paginate to add one when missing.
+//
+// Example:
+//
+//	d.PaginationSyntax(10, 20) // → " OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY"
+//	d.PaginationSyntax(0, 20)  // → " OFFSET 20 ROWS"
+func (d *dialectImpl) PaginationSyntax(limit, offset int) string {
+	if limit <= 0 && offset <= 0 {
+		return ""
+	}
+	clause := fmt.Sprintf(" OFFSET %d ROWS", max(offset, 0))
+	if limit > 0 {
+		clause += fmt.Sprintf(" FETCH NEXT %d ROWS ONLY", limit)
+	}
+	return clause
+}
+
+// Paginate appends the OFFSET/FETCH clause to query, preceded by
+// ORDER BY (SELECT NULL) when query has no top-level ORDER BY, which SQL
+// Server requires.
+//
+// Example:
+//
+//	d.Paginate("SELECT id FROM users", 10, 0)
+//	// SELECT id FROM users ORDER BY (SELECT NULL) OFFSET 0 ROWS FETCH NEXT 10 ROWS ONLY
+func (d *dialectImpl) Paginate(query string, limit, offset int) string {
+	clause := d.PaginationSyntax(limit, offset)
+	if clause == "" {
+		return query
+	}
+	if !hasOrderBy(query) {
+		query += " ORDER BY (SELECT NULL)"
+	}
+	return query + clause
+}
+
+// Placeholder returns the numbered placeholder for the given index, in
+// Options().PlaceholderStyle, or "@p%d" when the style has no %d verb, as
+// with options built without one.
+//
+// Example:
+//
+//	d.Placeholder(1) // → "@p1"
+func (d *dialectImpl) Placeholder(index int) string {
+	style := d.opts.PlaceholderStyle
+	if !strings.Contains(style, "%d") {
+		style = "@p%d"
+	}
+	return fmt.Sprintf(style, index)
+}
+
+// MergeSyntax renders an upsert as a MERGE statement reading a single row
+// of placeholders from a VALUES table constructor. SQL Server requires
+// MERGE to be terminated by a semicolon.
+//
+// Example:
+//
+//	d.MergeSyntax("users", []string{"id", "name"}, []string{"id"})
+//	// MERGE INTO users tgt USING (VALUES (@p1, @p2)) AS src (id, name)
+//	//   ON (tgt.id = src.id)
+//	//   WHEN MATCHED THEN UPDATE SET tgt.name = src.name
+//	//   WHEN NOT MATCHED THEN INSERT (id, name) VALUES (src.id, src.name);
+func (d *dialectImpl) MergeSyntax(table string, columns, keys []string) string {
+	sql := dialect.Merge(d, table, columns, keys, dialect.MergeStyle{Source: dialect.MergeValues})
+	if sql == "" {
+		return ""
+	}
+	return sql + ";"
+}
+
+// SavepointSyntax renders SAVE TRANSACTION.
+//
+// Example:
+//
+//	d.SavepointSyntax("sp_1") // → SAVE TRANSACTION sp_1
+func (d *dialectImpl) SavepointSyntax(name string) string {
+	return "SAVE TRANSACTION " + name
+}
+
+// ReleaseSavepointSyntax returns an empty string: SQL Server savepoints
+// cannot be released and end with the transaction.
+func (d *dialectImpl) ReleaseSavepointSyntax(string) string {
+	return ""
+}
+
+// RollbackToSavepointSyntax renders ROLLBACK TRANSACTION to the savepoint.
+//
+// Example:
+//
+//	d.RollbackToSavepointSyntax("sp_1") // → ROLLBACK TRANSACTION sp_1
+func (d *dialectImpl) RollbackToSavepointSyntax(name string) string {
+	return "ROLLBACK TRANSACTION " + name
+}
+
+// ErrorCodes returns the SQL Server error numbers (see
+// dialect.SQLServerErrorCodes).
+func (d *dialectImpl) ErrorCodes() dialect.ErrorCodes {
+	return dialect.SQLServerErrorCodes
+}
+
+// hasOrderBy reports whether query has an ORDER BY outside parentheses,
+// string literals and bracketed or double-quoted identifiers.
+func hasOrderBy(query string) bool {
+	depth := 0
+	var quote byte
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[':
+			quote = ']'
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case depth == 0 && (c == 'O' || c == 'o') && isWordStart(query, i) &&
+			len(query) >= i+5 && strings.EqualFold(query[i:i+5], "ORDER"):
+			rest := strings.TrimLeft(query[i+5:], " \t\r\n")
+			if len(rest) < len(query)-i-5 && len(rest) >= 2 && strings.EqualFold(rest[:2], "BY") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isWordStart reports whether the byte at i does not continue a word.
+func isWordStart(s string, i int) bool {
+	if i == 0 {
+		return true
+	}
+	c := s[i-1]
+	return !(c == '_' || c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z')
+}
@@ -0,0 +1,151 @@
+package mssql_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/mssql"
+	dberrors "github.com/entiqon/db/errors"
+)
+
+// numberError is a go-mssqldb style error carrying a SQL Server number.
+type numberError struct{ Number int32 }
+
+func (e numberError) Error() string { return "mssql: error" }
+
+func TestMSSQLDialect(t *testing.T) {
+	d := mssql.New()
+
+	t.Run("Name", func(t *testing.T) {
+		if got, want := d.Name(), "mssql"; got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+		if got := mssql.NewWithOptions(dialect.Options{Name: "sqlserver", PlaceholderStyle: "@p%d"}).Name(); got != "sqlserver" {
+			t.Errorf("expected %q, got %q", "sqlserver", got)
+		}
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		opts := d.Options()
+		if !opts.AllowMerge {
+			t.Error("expected AllowMerge=true")
+		}
+		if opts.AllowUpsert || opts.EnableReturning || opts.SupportsArrayBinding {
+			t.Error("expected UPSERT, RETURNING and array binding to be disabled")
+		}
+		if opts.MaxPlaceholderIndex != 2099 {
+			t.Errorf("unexpected MaxPlaceholderIndex = %d", opts.MaxPlaceholderIndex)
+		}
+	})
+
+	t.Run("QuoteIdentifier", func(t *testing.T) {
+		cases := []struct {
+			in   string
+			want string
+		}{
+			{"Users", "Users"},
+			{"dbo.Users", "dbo.Users"},
+			{"Order Items", "[Order Items]"},
+			{"order", "[order]"},
+			{"odd]name", "[odd]]name]"},
+			{"", ""},
+		}
+		for _, c := range cases {
+			if got := d.QuoteIdentifier(c.in); got != c.want {
+				t.Errorf("QuoteIdentifier(%q) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("QuoteLiteral", func(t *testing.T) {
+		now := time.Date(2025, 9, 19, 3, 30, 0, 0, time.UTC)
+		cases := []struct {
+			in   any
+			want string
+		}{
+			{nil, "NULL"},
+			{"O'Reilly", "'O''Reilly'"},
+			{true, "1"},
+			{false, "0"},
+			{uint64(5), "5"},
+			{0.25, "0.25"},
+			{now, "CAST('2025-09-19 03:30:00' AS DATETIME2)"},
+			{now.Add(500 * time.Millisecond), "CAST('2025-09-19 03:30:00.5' AS DATETIME2)"},
+			{[]byte("hi"), "0x6869"},
+		}
+		for _, c := range cases {
+			if got := d.QuoteLiteral(c.in); got != c.want {
+				t.Errorf("QuoteLiteral(%v) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("Placeholder", func(t *testing.T) {
+		if got := d.Placeholder(3); got != "@p3" {
+			t.Errorf("Placeholder(3) = %q, want '@p3'", got)
+		}
+		if got := mssql.NewWithOptions(dialect.Options{Name: "mssql"}).Placeholder(3); got != "@p3" {
+			t.Errorf("expected @p3 without a PlaceholderStyle, got %q", got)
+		}
+	})
+
+	t.Run("Golden", func(t *testing.T) {
+		m := d.(dialect.Merger)
+		cases := []struct {
+			name string
+			got  string
+			want string
+		}{
+			{"paginate", dialect.Paginate(d, "SELECT id FROM users ORDER BY id", 10, 20),
+				"SELECT id FROM users ORDER BY id OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY"},
+			{"paginate limit", dialect.Paginate(d, "SELECT id FROM users", 10, 0),
+				"SELECT id FROM users ORDER BY (SELECT NULL) OFFSET 0 ROWS FETCH NEXT 10 ROWS ONLY"},
+			{"paginate offset", dialect.Paginate(d, "SELECT id FROM users ORDER BY LOWER(name)", 0, 20),
+				"SELECT id FROM users ORDER BY LOWER(name) OFFSET 20 ROWS"},
+			{"paginate nested order", dialect.Paginate(d,
+				"SELECT id FROM (SELECT TOP 5 id FROM users ORDER BY id) t WHERE note = 'order by'", 10, 0),
+				"SELECT id FROM (SELECT TOP 5 id FROM users ORDER BY id) t WHERE note = 'order by'" +
+					" ORDER BY (SELECT NULL) OFFSET 0 ROWS FETCH NEXT 10 ROWS ONLY"},
+			{"paginate none", dialect.Paginate(d, "SELECT id FROM users", 0, 0),
+				"SELECT id FROM users"},
+			{"merge", m.MergeSyntax("users", []string{"id", "name"}, []string{"id"}),
+				"MERGE INTO users tgt USING (VALUES (@p1, @p2)) AS src (id, name) ON (tgt.id = src.id)" +
+					" WHEN MATCHED THEN UPDATE SET tgt.name = src.name" +
+					" WHEN NOT MATCHED THEN INSERT (id, name) VALUES (src.id, src.name);"},
+			{"merge empty", m.MergeSyntax("users", nil, []string{"id"}), ""},
+		}
+		for _, c := range cases {
+			if c.got != c.want {
+				t.Errorf("%s:\n got %q\nwant %q", c.name, c.got, c.want)
+			}
+		}
+	})
+
+	t.Run("Savepoints", func(t *testing.T) {
+		set, err := dialect.Savepoint(d, "sp_1")
+		if err != nil || set != "SAVE TRANSACTION sp_1" {
+			t.Errorf("unexpected savepoint %q, %v", set, err)
+		}
+		if got := dialect.ReleaseSavepoint(d, "sp_1"); got != "" {
+			t.Errorf("expected no release statement, got %q", got)
+		}
+		if got := dialect.RollbackToSavepoint(d, "sp_1"); got != "ROLLBACK TRANSACTION sp_1" {
+			t.Errorf("unexpected rollback %q", got)
+		}
+	})
+
+	t.Run("ClassifyError", func(t *testing.T) {
+		err := dialect.ClassifyError(d, numberError{Number: 2627})
+		if !errors.Is(err, dberrors.ErrUniqueViolation) {
+			t.Errorf("expected a unique violation, got %v", err)
+		}
+	})
+
+	t.Run("Placeholders", func(t *testing.T) {
+		if err := dialect.CheckPlaceholders(d, 2100); err == nil {
+			t.Error("expected an error beyond 2099 placeholders")
+		}
+	})
+}
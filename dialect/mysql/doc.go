@@ -0,0 +1,31 @@
+/*
+Package mysql provides the MySQL SQL dialect implementation.
+
+# Overview
+
+The MySQL dialect renders SQL following MySQL rules:
+
+  - Identifiers are backtick-quoted when needed; names are not folded.
+  - Placeholders are "?", bound in order, up to 65535 per statement.
+  - String literals escape backslashes as well as single quotes, so
+    dialect.Interpolate and SelectBuilder.BuildInlined produce literals
+    MySQL reads back unchanged, and placeholders after an escaped quote
+    are still found.
+  - Pagination uses LIMIT n OFFSET m.
+  - Strings are joined with CONCAT(), || being a logical OR.
+  - Upserts use INSERT ... ON DUPLICATE KEY UPDATE col = VALUES(col);
+    RETURNING is not supported.
+
+# Usage
+
+	d := mysql.New()
+	sql, _ := dialect.Interpolate(d, "SELECT id FROM files WHERE path = ?", []any{`C:\temp`})
+	// SELECT id FROM files WHERE path = 'C:\\temp'
+
+# Capabilities
+
+Besides dialect.SQLDialect, the MySQL dialect implements the optional
+dialect.Upserter, dialect.FunctionMapper and dialect.ErrorClassifier
+interfaces. Savepoints use the standard statements.
+*/
+package mysql
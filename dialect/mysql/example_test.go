@@ -0,0 +1,25 @@
+package mysql_test
+
+import (
+	"fmt"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/mysql"
+)
+
+func Example() {
+	d := mysql.New()
+	fmt.Println(d.Placeholder(1))
+	fmt.Println(d.QuoteIdentifier("user data"))
+	// Output:
+	// ?
+	// `user data`
+}
+
+func Example_interpolate() {
+	d := mysql.New()
+	sql, _ := dialect.Interpolate(d, "SELECT id FROM files WHERE path = ?", []any{`C:\temp`})
+	fmt.Println(sql)
+	// Output:
+	// SELECT id FROM files WHERE path = 'C:\\temp'
+}
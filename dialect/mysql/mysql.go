@@ -0,0 +1,238 @@
+package mysql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/entiqon/db/dialect"
+)
+
+//
+// MySQL Dialect
+//
+
+// dialectImpl provides the MySQL implementation of the dialect.SQLDialect
+// interface. It is unexported to prevent direct instantiation; consumers
+// should always use the New() constructor.
+//
+// Key behaviors:
+//   - Identifiers are backtick-quoted when needed; names are not folded.
+//   - Placeholders are "?", up to 65535 per statement.
+//   - String literals escape backslashes, which MySQL reads as escapes
+//     unless NO_BACKSLASH_ESCAPES is set.
+//   - Pagination uses LIMIT n OFFSET m; offset-only pagination uses the
+//     largest LIMIT MySQL accepts.
+//   - Upserts use INSERT ... ON DUPLICATE KEY UPDATE; RETURNING is not
+//     supported.
+type dialectImpl struct {
+	opts dialect.Options
+}
+
+// Compile-time checks: ensure dialectImpl implements SQLDialect and the
+// optional capabilities it advertises.
+var (
+	_ dialect.SQLDialect      = (*dialectImpl)(nil)
+	_ dialect.Upserter        = (*dialectImpl)(nil)
+	_ dialect.FunctionMapper  = (*dialectImpl)(nil)
+	_ dialect.ErrorClassifier = (*dialectImpl)(nil)
+)
+
+// bareIdentifier matches identifiers that can be emitted without quotes.
+var bareIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*$`)
+
+// maxLimit is the largest row count MySQL accepts in LIMIT, used for
+// offset-only pagination.
+const maxLimit = "18446744073709551615"
+
+//
+// Constructors
+//
+
+// New returns a MySQL dialect. The returned value implements the
+// dialect.SQLDialect interface.
+//
+// Example:
+//
+//	d := mysql.New()
+//	d.Placeholder(2) // → "?"
+func New() dialect.SQLDialect {
+	return &dialectImpl{
+		opts: dialect.Options{
+			Name:                    "mysql",
+			QuoteStyle:              "`",
+			PlaceholderStyle:        "?",
+			AllowMerge:              false,
+			AllowUpsert:             true,
+			ForcedAliasing:          true,
+			EnableReturning:         false,
+			SupportsCTE:             true,
+			SupportsWindowFunctions: true,
+			SupportsNullsOrdering:   false,
+			SupportsILike:           false,
+			SupportsArrayBinding:    false,
+			SupportsValuesList:      false,
+			MaxPlaceholderIndex:     65535,
+			MaxIdentifierLength:     64,
+		},
+	}
+}
+
+// NewWithOptions creates a MySQL dialect with the given static options,
+// for example a MariaDB name. Options are immutable once the dialect is
+// constructed.
+func NewWithOptions(opts dialect.Options) dialect.SQLDialect {
+	return &dialectImpl{opts: opts}
+}
+
+//
+// Interface Implementation
+//
+
+// Name returns the identifier of this dialect, "mysql" by default.
+func (d *dialectImpl) Name() string {
+	return d.opts.Name
+}
+
+// Options returns the feature capability matrix for the MySQL dialect.
+func (d *dialectImpl) Options() dialect.Options {
+	return d.opts
+}
+
+// QuoteIdentifier returns a MySQL identifier. Regular names are returned
+// as-is; anything else is wrapped in backticks, with embedded backticks
+// escaped by doubling them.
+//
+// Schema-qualified names are quoted part by part and reserved words
+// are quoted, subject to Options().QuotePolicy.
+//
+// Example:
+//
+//	d.QuoteIdentifier("shop.users") // → shop.users
+//	d.QuoteIdentifier("user data")  // → `user data`
+func (d *dialectImpl) QuoteIdentifier(name string) string {
+	return dialect.NewQuoter(d.opts, bareIdentifier.MatchString).Quote(name)
+}
+
+// Functions returns the MySQL spellings of canonical functions: || is a
+// logical OR unless PIPES_AS_CONCAT is set, so strings are joined with
+// CONCAT().
+func (d *dialectImpl) Functions() dialect.FunctionMap {
+	return dialect.FunctionMap{
+		dialect.FuncConcat: dialect.Call("CONCAT"),
+	}
+}
+
+// QuoteLiteral quotes a literal value for inline use in MySQL SQL.
+//
+// Supported types:
+//   - nil       → NULL
+//   - string    → wrapped in single quotes, with single quotes doubled
+//     and backslashes escaped
+//   - bool      → TRUE or FALSE
+//   - numbers   → rendered in decimal form
+//   - time.Time → TIMESTAMP 'YYYY-MM-DD HH:MM:SS' in UTC
+//   - []byte    → X'..'
+//   - others    → dialect.MustLiteral
+//
+// Example:
+//
+//	d.QuoteLiteral(`C:\temp`) // → 'C:\\temp'
+func (d *dialectImpl) QuoteLiteral(literal any) string {
+	switch v := literal.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return "'" + strings.NewReplacer(`\`, `\\`, `'`, `''`).Replace(v) + "'"
+	case bool:
+		if v {
+			return "TRUE"
+		}
+		return "FALSE"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 64)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return dialect.MustLiteral(d, v)
+	}
+}
+
+// PaginationSyntax renders the MySQL LIMIT/OFFSET clause. OFFSET is only
+// valid after LIMIT, so offset-only pagination uses the largest limit.
+//
+// Example:
+//
+//	d.PaginationSyntax(10, 20) // → " LIMIT 10 OFFSET 20"
+//	d.PaginationSyntax(0, 20)  // → " LIMIT 18446744073709551615 OFFSET 20"
+func (d *dialectImpl) PaginationSyntax(limit, offset int) string {
+	switch {
+	case limit > 0 && offset > 0:
+		return fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+	case limit > 0:
+		return fmt.Sprintf(" LIMIT %d", limit)
+	case offset > 0:
+		return fmt.Sprintf(" LIMIT %s OFFSET %d", maxLimit, offset)
+	default:
+		return ""
+	}
+}
+
+// Placeholder always returns "?" for MySQL. The index parameter is
+// ignored since parameters are bound in order.
+func (d *dialectImpl) Placeholder(_ int) string {
+	return d.opts.PlaceholderStyle
+}
+
+// UpsertSyntax renders an INSERT ... ON DUPLICATE KEY UPDATE upsert. The
+// non-key columns are updated from VALUES(col); MySQL matches any unique
+// key, so keys only select the columns left untouched. When every column
+// is a key, the first one is assigned to itself so the duplicate is
+// ignored without the error suppression of INSERT IGNORE. It returns ""
+// when table, columns or keys are empty.
+//
+// Example:
+//
+//	d.UpsertSyntax("users", []string{"id", "name"}, []string{"id"})
+//	// INSERT INTO users (id, name) VALUES (?, ?)
+//	//   ON DUPLICATE KEY UPDATE name = VALUES(name)
+func (d *dialectImpl) UpsertSyntax(table string, columns, keys []string) string {
+	if table == "" || len(columns) == 0 || len(keys) == 0 {
+		return ""
+	}
+
+	isKey := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		isKey[k] = true
+	}
+
+	cols := make([]string, len(columns))
+	marks := make([]string, len(columns))
+	var updates []string
+	for i, c := range columns {
+		q := d.QuoteIdentifier(c)
+		cols[i] = q
+		marks[i] = d.Placeholder(i + 1)
+		if !isKey[c] {
+			updates = append(updates, fmt.Sprintf("%s = VALUES(%s)", q, q))
+		}
+	}
+	if len(updates) == 0 {
+		q := d.QuoteIdentifier(keys[0])
+		updates = append(updates, q+" = "+q)
+	}
+	return fmt.Sprintf(
+		"INSERT INTO %s (%s) VALUES (%s) ON DUPLICATE KEY UPDATE %s",
+		d.QuoteIdentifier(table), strings.Join(cols, ", "), strings.Join(marks, ", "),
+		strings.Join(updates, ", "),
+	)
+}
+
+// ErrorCodes returns the MySQL error numbers (see
+// dialect.MySQLErrorCodes).
+func (d *dialectImpl) ErrorCodes() dialect.ErrorCodes {
+	return dialect.MySQLErrorCodes
+}
@@ -0,0 +1,139 @@
+package mysql_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/mysql"
+)
+
+func TestMySQLDialect(t *testing.T) {
+	d := mysql.New()
+
+	t.Run("Name", func(t *testing.T) {
+		if got, want := d.Name(), "mysql"; got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+		if got := mysql.NewWithOptions(dialect.Options{Name: "mariadb", PlaceholderStyle: "?"}).Name(); got != "mariadb" {
+			t.Errorf("expected %q, got %q", "mariadb", got)
+		}
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		opts := d.Options()
+		if !opts.AllowUpsert {
+			t.Error("expected UPSERT to be enabled")
+		}
+		if opts.AllowMerge || opts.EnableReturning || opts.SupportsArrayBinding {
+			t.Error("expected MERGE, RETURNING and array binding to be disabled")
+		}
+		if opts.MaxPlaceholderIndex != 65535 {
+			t.Errorf("unexpected MaxPlaceholderIndex = %d", opts.MaxPlaceholderIndex)
+		}
+	})
+
+	t.Run("QuoteIdentifier", func(t *testing.T) {
+		cases := []struct {
+			in   string
+			want string
+		}{
+			{"users", "users"},
+			{"UserData", "UserData"},
+			{"shop.users", "shop.users"},
+			{"user data", "`user data`"},
+			{"order", "`order`"},
+			{"odd`name", "`odd``name`"},
+			{"", ""},
+		}
+		for _, c := range cases {
+			if got := d.QuoteIdentifier(c.in); got != c.want {
+				t.Errorf("QuoteIdentifier(%q) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("QuoteLiteral", func(t *testing.T) {
+		now := time.Date(2025, 9, 19, 3, 30, 0, 0, time.UTC)
+		cases := []struct {
+			in   any
+			want string
+		}{
+			{nil, "NULL"},
+			{"O'Reilly", "'O''Reilly'"},
+			{`C:\temp`, `'C:\\temp'`},
+			{`\'`, `'\\'''`},
+			{true, "TRUE"},
+			{false, "FALSE"},
+			{uint64(5), "5"},
+			{0.25, "0.25"},
+			{now, "TIMESTAMP '2025-09-19 03:30:00'"},
+			{[]byte("hi"), "X'6869'"},
+		}
+		for _, c := range cases {
+			if got := d.QuoteLiteral(c.in); got != c.want {
+				t.Errorf("QuoteLiteral(%v) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("Placeholder", func(t *testing.T) {
+		if got := d.Placeholder(3); got != "?" {
+			t.Errorf("Placeholder(3) = %q, want '?'", got)
+		}
+	})
+
+	t.Run("Backslashes", func(t *testing.T) {
+		query := `SELECT id FROM files WHERE note = 'it\'s ?' AND path = ?`
+		got, err := dialect.Interpolate(d, query, []any{`C:\temp`})
+		want := `SELECT id FROM files WHERE note = 'it\'s ?' AND path = 'C:\\temp'`
+		if err != nil || got != want {
+			t.Errorf("Interpolate:\n got %q (%v)\nwant %q", got, err, want)
+		}
+		if params := dialect.Params(d, query); len(params) != 1 || params[0].Column != "path" {
+			t.Errorf("Params = %+v, want the path placeholder only", params)
+		}
+		if got, want := dialect.Sanitize(d, query), "SELECT id FROM files WHERE note = ? AND path = ?"; got != want {
+			t.Errorf("Sanitize = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("Functions", func(t *testing.T) {
+		got, err := dialect.RenderFunction(d, dialect.FuncConcat, []string{"a", "b"})
+		if err != nil || got != "CONCAT(a, b)" {
+			t.Errorf("CONCAT = %q (%v), want %q", got, err, "CONCAT(a, b)")
+		}
+	})
+
+	t.Run("Golden", func(t *testing.T) {
+		u := d.(dialect.Upserter)
+		cases := []struct {
+			name string
+			got  string
+			want string
+		}{
+			{"paginate", dialect.Paginate(d, "SELECT id FROM users", 10, 20),
+				"SELECT id FROM users LIMIT 10 OFFSET 20"},
+			{"paginate limit", dialect.Paginate(d, "SELECT id FROM users", 10, 0),
+				"SELECT id FROM users LIMIT 10"},
+			{"paginate offset", dialect.Paginate(d, "SELECT id FROM users", 0, 20),
+				"SELECT id FROM users LIMIT 18446744073709551615 OFFSET 20"},
+			{"upsert", u.UpsertSyntax("users", []string{"id", "name"}, []string{"id"}),
+				"INSERT INTO users (id, name) VALUES (?, ?) ON DUPLICATE KEY UPDATE name = VALUES(name)"},
+			{"upsert keys only", u.UpsertSyntax("tags", []string{"id"}, []string{"id"}),
+				"INSERT INTO tags (id) VALUES (?) ON DUPLICATE KEY UPDATE id = id"},
+			{"upsert empty", u.UpsertSyntax("users", nil, []string{"id"}), ""},
+		}
+		for _, c := range cases {
+			if c.got != c.want {
+				t.Errorf("%s:\n got %q\nwant %q", c.name, c.got, c.want)
+			}
+		}
+	})
+
+	t.Run("Placeholders", func(t *testing.T) {
+		if err := dialect.CheckPlaceholders(d, 65536); err == nil {
+			t.Error("expected an error beyond 65535 placeholders")
+		}
+	})
+}
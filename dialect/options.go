@@ -2,6 +2,10 @@ package dialect
 
 // Options defines the shared capabilities and behaviors
 // across all SQL dialect implementations.
+//
+// Options is comparable. The function and error code tables it carries
+// are set with WithFunctions and WithErrorCodes and held behind a pointer,
+// so two Options are equal only when they share those tables.
 type Options struct {
 	// Name of the dialect ("generic", "postgres", "mysql", "sqlite", etc.)
 	Name string
@@ -10,6 +14,15 @@ type Options struct {
 	// Examples: `"` (ANSI/SQLDialect, Postgres), "`" (MySQL), "[" (SQL Server).
 	QuoteStyle string
 
+	// QuotePolicy controls when identifiers are quoted: only when needed
+	// (the zero value), always, or never.
+	QuotePolicy QuotePolicy
+
+	// CaseFolding is how the server folds unquoted identifiers: not at all
+	// (the zero value), to lower case, or to upper case. Quoting and name
+	// comparisons follow it.
+	CaseFolding CaseFolding
+
 	// PlaceholderStyle defines how parameters are rendered.
 	// Examples:
 	//   "?"    (SQLDialect, MySQL, SQLite),
@@ -38,8 +51,94 @@ type Options struct {
 	// SupportsWindowFunctions indicates support for OVER() / windowed expressions.
 	SupportsWindowFunctions bool
 
-	// MaxPlaceholderIndex defines the maximum index supported for placeholders
-	// (useful for dialects like Oracle where :1..:N may be limited).
+	// SupportsNullsOrdering indicates support for NULLS FIRST / NULLS LAST
+	// in ORDER BY. Builders emulate it with a CASE sort key when false.
+	SupportsNullsOrdering bool
+
+	// SupportsILike indicates a native case-insensitive ILIKE operator.
+	// Builders emulate it with LOWER(...) LIKE LOWER(...) when false.
+	SupportsILike bool
+
+	// SupportsArrayBinding indicates that an array can be bound to a single
+	// placeholder and compared with = ANY(?) / <> ALL(?), as in PostgreSQL.
+	SupportsArrayBinding bool
+
+	// SupportsValuesList indicates support for VALUES row lists as derived
+	// tables: (VALUES (1), (2)) AS t (v).
+	SupportsValuesList bool
+
+	// MaxPlaceholderIndex defines the maximum number of placeholders a
+	// statement may bind (65535 for Oracle, 32767 for Db2). Builders fail
+	// with a PlaceholderLimitError beyond it.
 	// Zero or negative → no limit.
 	MaxPlaceholderIndex int
+
+	// MaxIdentifierLength defines the maximum length, in bytes, of a single
+	// identifier part (e.g. 30 for Oracle before 12.2, 128 afterwards).
+	// Zero or negative → no limit.
+	MaxIdentifierLength int
+
+	// tables holds the Functions and ErrorCodes set through WithFunctions
+	// and WithErrorCodes.
+	tables *tables
+}
+
+// tables are the per-instance lookup tables of Options, kept behind a
+// pointer so that Options stays comparable.
+type tables struct {
+	functions  FunctionMap
+	errorCodes ErrorCodes
+}
+
+// Functions returns the translations of canonical functions set with
+// WithFunctions, or nil.
+func (o Options) Functions() FunctionMap {
+	if o.tables == nil {
+		return nil
+	}
+	return o.tables.functions
+}
+
+// WithFunctions returns a copy of o that overrides or extends the
+// dialect's translations of canonical functions (see RenderFunction).
+// Entries take precedence over the dialect's own mapping; a nil entry
+// disables a function.
+//
+// Example:
+//
+//	opts := dialect.Options{Name: "sqlite"}.WithFunctions(dialect.FunctionMap{
+//		dialect.FuncCoalesce: dialect.Call("IFNULL"),
+//	})
+func (o Options) WithFunctions(functions FunctionMap) Options {
+	t := tables{functions: functions}
+	if o.tables != nil {
+		t.errorCodes = o.tables.errorCodes
+	}
+	o.tables = &t
+	return o
+}
+
+// ErrorCodes returns the driver error codes set with WithErrorCodes, or
+// nil.
+func (o Options) ErrorCodes() ErrorCodes {
+	if o.tables == nil {
+		return nil
+	}
+	return o.tables.errorCodes
+}
+
+// WithErrorCodes returns a copy of o that classifies driver error codes
+// (see ClassifyError) ahead of the dialect's own codes, for example
+// MySQLErrorCodes on a dialect configured for a MySQL server.
+//
+// Example:
+//
+//	opts := dialect.Options{Name: "tidb"}.WithErrorCodes(dialect.MySQLErrorCodes)
+func (o Options) WithErrorCodes(codes ErrorCodes) Options {
+	t := tables{errorCodes: codes}
+	if o.tables != nil {
+		t.functions = o.tables.functions
+	}
+	o.tables = &t
+	return o
 }
@@ -0,0 +1,40 @@
+/*
+Package oracle provides the Oracle Database SQL dialect implementation.
+
+# Overview
+
+The Oracle dialect renders SQL following Oracle-specific rules:
+
+  - Placeholders are positional bind variables (:1, :2, ...) or named
+    bind variables (:name).
+  - Table aliases are rendered without the AS keyword, in FROM and in joins.
+  - Pagination uses OFFSET ... ROWS FETCH NEXT ... ROWS ONLY (12c+), and
+    falls back to ROWNUM wrapping for 11g and older.
+  - RETURNING ... INTO is rendered with positional out-binds.
+  - Upserts are rendered as MERGE INTO ... USING (SELECT ... FROM dual).
+  - Identifiers are limited to 30 bytes before 12.2 and 128 bytes after.
+
+# Usage
+
+Call New() for the current server generation, or NewWithVersion() to
+target an older release:
+
+	d := oracle.New()
+	sql := dialect.Paginate(d, "SELECT id FROM users ORDER BY id", 10, 20)
+	// SELECT id FROM users ORDER BY id OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY
+
+	legacy := oracle.NewWithVersion(11, 2)
+	sql = dialect.Paginate(legacy, "SELECT id FROM users ORDER BY id", 10, 0)
+	// SELECT * FROM (SELECT id FROM users ORDER BY id) WHERE ROWNUM <= 10
+
+# Capabilities
+
+Besides dialect.SQLDialect, the Oracle dialect implements the optional
+capability interfaces Paginator, TableAliaser, IdentifierValidator,
+NamedBinder, Returner and Merger declared in the dialect package:
+
+	if v, ok := d.(dialect.IdentifierValidator); ok {
+	    err := v.ValidateIdentifier("customer_orders_archive_2024_q1")
+	}
+*/
+package oracle
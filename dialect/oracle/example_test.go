@@ -0,0 +1,46 @@
+package oracle_test
+
+import (
+	"fmt"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/oracle"
+)
+
+func Example() {
+	d := oracle.New()
+	fmt.Println(d.Name())
+	fmt.Println(d.Placeholder(1))
+	// Output:
+	// oracle
+	// :1
+}
+
+func Example_paginate() {
+	q := "SELECT id FROM users ORDER BY id"
+	fmt.Println(dialect.Paginate(oracle.New(), q, 10, 20))
+	fmt.Println(dialect.Paginate(oracle.NewWithVersion(11, 2), q, 10, 0))
+	// Output:
+	// SELECT id FROM users ORDER BY id OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY
+	// SELECT * FROM (SELECT id FROM users ORDER BY id) WHERE ROWNUM <= 10
+}
+
+func Example_aliasTable() {
+	fmt.Println(dialect.AliasTable(oracle.New(), "users", "u"))
+	// Output:
+	// users u
+}
+
+func Example_returning() {
+	d := oracle.New().(dialect.Returner)
+	fmt.Println(d.ReturningSyntax([]string{"id"}, 3))
+	// Output:
+	// RETURNING id INTO :3
+}
+
+func Example_merge() {
+	d := oracle.New().(dialect.Merger)
+	fmt.Println(d.MergeSyntax("users", []string{"id", "name"}, []string{"id"}))
+	// Output:
+	// MERGE INTO users tgt USING (SELECT :1 AS id, :2 AS name FROM dual) src ON (tgt.id = src.id) WHEN MATCHED THEN UPDATE SET tgt.name = src.name WHEN NOT MATCHED THEN INSERT (id, name) VALUES (src.id, src.name)
+}
@@ -0,0 +1,39 @@
+package oracle
+
+import "github.com/entiqon/db/dialect"
+
+// keywords holds the Oracle reserved words, as listed in the SQL Language
+// Reference and V$RESERVED_WORDS, and the keywords Oracle accepts as bare
+// identifiers.
+var keywords = dialect.NewKeywords(
+	[]string{
+		"ACCESS", "ADD", "ALL", "ALTER", "AND", "ANY", "AS", "ASC", "AUDIT",
+		"BETWEEN", "BY", "CHAR", "CHECK", "CLUSTER", "COLUMN", "COLUMN_VALUE",
+		"COMMENT", "COMPRESS", "CONNECT", "CREATE", "CURRENT", "DATE",
+		"DECIMAL", "DEFAULT", "DELETE", "DESC", "DISTINCT", "DROP", "ELSE",
+		"EXCLUSIVE", "EXISTS", "FILE", "FLOAT", "FOR", "FROM", "GRANT",
+		"GROUP", "HAVING", "IDENTIFIED", "IMMEDIATE", "IN", "INCREMENT",
+		"INDEX", "INITIAL", "INSERT", "INTEGER", "INTERSECT", "INTO", "IS",
+		"LEVEL", "LIKE", "LOCK", "LONG", "MAXEXTENTS", "MINUS", "MLSLABEL",
+		"MODE", "MODIFY", "NESTED_TABLE_ID", "NOAUDIT", "NOCOMPRESS", "NOT",
+		"NOWAIT", "NULL", "NUMBER", "OF", "OFFLINE", "ON", "ONLINE", "OPTION",
+		"OR", "ORDER", "PCTFREE", "PRIOR", "PUBLIC", "RAW", "RENAME",
+		"RESOURCE", "REVOKE", "ROW", "ROWID", "ROWNUM", "ROWS", "SELECT",
+		"SESSION", "SET", "SHARE", "SIZE", "SMALLINT", "START", "SUCCESSFUL",
+		"SYNONYM", "SYSDATE", "TABLE", "THEN", "TO", "TRIGGER", "UID", "UNION",
+		"UNIQUE", "UPDATE", "USER", "VALIDATE", "VALUES", "VARCHAR",
+		"VARCHAR2", "VIEW", "WHENEVER", "WHERE", "WITH",
+	},
+	[]string{
+		"ADMIN", "AFTER", "ANALYZE", "ARCHIVE", "BEFORE", "BEGIN", "BODY",
+		"CACHE", "CASCADE", "CASE", "CAST", "COMMIT", "CONSTRAINT", "CROSS",
+		"CURSOR", "CYCLE", "DATA", "DAY", "END", "ESCAPE", "EXCEPT", "FETCH",
+		"FIRST", "FULL", "FUNCTION", "INNER", "INTERVAL", "JOIN", "KEY",
+		"LAST", "LEFT", "LIMIT", "MERGE", "MINUTE", "MONTH", "NAME", "NATURAL",
+		"NEXT", "NOCYCLE", "NULLS", "OFFSET", "ONLY", "OUTER", "PACKAGE",
+		"PARTITION", "PRIMARY", "PROCEDURE", "RANGE", "REFERENCES",
+		"RETURNING", "RIGHT", "ROLE", "ROLLBACK", "SAVEPOINT", "SCHEMA",
+		"SECOND", "SEQUENCE", "SYSTIMESTAMP", "TIME", "TIMESTAMP", "TYPE",
+		"USING", "WHEN", "YEAR", "ZONE",
+	},
+)
@@ -0,0 +1,427 @@
+package oracle
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/errors"
+)
+
+//
+// Oracle Dialect
+//
+
+// dialectImpl provides the Oracle implementation of the dialect.SQLDialect
+// interface. It is unexported to prevent direct instantiation; consumers should
+// always use one of the constructors.
+//
+// Key behaviors:
+//   - Identifiers are quoted using double quotes (") only when required.
+//   - Placeholders are positional ":1", ":2", ... or named ":name".
+//   - Table aliases never use the AS keyword.
+//   - Pagination uses OFFSET/FETCH (12c+) or ROWNUM wrapping (11g and older).
+//   - RETURNING ... INTO is rendered with positional out-binds.
+//   - Upserts are rendered as MERGE INTO ... USING (SELECT ... FROM dual).
+//   - Identifier length is limited to 30 bytes before 12.2, 128 afterwards.
+type dialectImpl struct {
+	opts  dialect.Options
+	major int
+	minor int
+}
+
+// Compile-time checks: ensure dialectImpl implements SQLDialect and the
+// optional capabilities it advertises.
+var (
+	_ dialect.SQLDialect          = (*dialectImpl)(nil)
+	_ dialect.Paginator           = (*dialectImpl)(nil)
+	_ dialect.TableAliaser        = (*dialectImpl)(nil)
+	_ dialect.IdentifierValidator = (*dialectImpl)(nil)
+	_ dialect.NamedBinder         = (*dialectImpl)(nil)
+	_ dialect.Returner            = (*dialectImpl)(nil)
+	_ dialect.Merger              = (*dialectImpl)(nil)
+	_ dialect.Keyworder           = (*dialectImpl)(nil)
+	_ dialect.FunctionMapper      = (*dialectImpl)(nil)
+	_ dialect.BinaryQuoter        = (*dialectImpl)(nil)
+	_ dialect.Savepointer         = (*dialectImpl)(nil)
+	_ dialect.ErrorClassifier     = (*dialectImpl)(nil)
+)
+
+// bareIdentifier matches identifiers that can be emitted without quotes.
+var bareIdentifier = regexp.MustCompile(`^[a-z][a-z0-9_$#]*$`)
+
+const (
+	// DefaultMajor is the Oracle major version assumed by New().
+	DefaultMajor = 19
+
+	// DefaultMinor is the Oracle minor version assumed by New().
+	DefaultMinor = 0
+)
+
+//
+// Constructors
+//
+
+// New returns an Oracle dialect targeting Oracle Database 19c, which
+// supports OFFSET/FETCH pagination and 128-byte identifiers.
+//
+// Example:
+//
+//	d := oracle.New()
+//	d.Placeholder(1)              // → :1
+//	d.PaginationSyntax(10, 20)    // → " OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY"
+func New() dialect.SQLDialect {
+	return NewWithVersion(DefaultMajor, DefaultMinor)
+}
+
+// NewWithVersion returns an Oracle dialect targeting the given server
+// version. The version drives two behaviors:
+//
+//   - Pagination: versions before 12 lack OFFSET/FETCH, so queries are
+//     wrapped using ROWNUM instead.
+//   - Identifier length: versions before 12.2 limit identifiers to 30 bytes;
+//     later versions allow 128 bytes.
+//
+// Example:
+//
+//	d := oracle.NewWithVersion(11, 2)
+//	dialect.Paginate(d, "SELECT id FROM users", 10, 0)
+//	// SELECT * FROM (SELECT id FROM users) WHERE ROWNUM <= 10
+func NewWithVersion(major, minor int) dialect.SQLDialect {
+	maxLen := 128
+	if major < 12 || (major == 12 && minor < 2) {
+		maxLen = 30
+	}
+	return &dialectImpl{
+		opts: dialect.Options{
+			Name:                    "oracle",
+			QuoteStyle:              `"`,
+			CaseFolding:             dialect.FoldUpper,
+			PlaceholderStyle:        ":%d",
+			AllowMerge:              true,
+			AllowUpsert:             false,
+			ForcedAliasing:          false,
+			EnableReturning:         true,
+			SupportsCTE:             true,
+			SupportsWindowFunctions: true,
+			SupportsNullsOrdering:   true,
+			SupportsILike:           false,
+			SupportsArrayBinding:    false,
+			SupportsValuesList:      false,
+			MaxPlaceholderIndex:     65535,
+			MaxIdentifierLength:     maxLen,
+		},
+		major: major,
+		minor: minor,
+	}
+}
+
+// NewWithOptions creates an Oracle dialect with the given static options,
+// using the rules of the default server version. Like the generic dialect,
+// options are immutable once the dialect is constructed.
+func NewWithOptions(opts dialect.Options) dialect.SQLDialect {
+	return &dialectImpl{opts: opts, major: DefaultMajor, minor: DefaultMinor}
+}
+
+//
+// Interface Implementation
+//
+
+// Name returns the identifier of this dialect, "oracle" by default.
+func (d *dialectImpl) Name() string {
+	return d.opts.Name
+}
+
+// Options returns the feature capability matrix for the Oracle dialect.
+func (d *dialectImpl) Options() dialect.Options {
+	return d.opts
+}
+
+// QuoteIdentifier returns an Oracle identifier. Lowercase simple names are
+// returned as-is; anything else is wrapped in double quotes, with embedded
+// double quotes escaped by doubling them.
+//
+// Qualified names are split on dots and each part is quoted separately;
+// reserved words are quoted too. Options().QuotePolicy overrides this.
+//
+// Example:
+//
+//	d.QuoteIdentifier("users")      // → users
+//	d.QuoteIdentifier("UserData")   // → UserData (bare, per the quoting policy)
+//	d.QuoteIdentifier(`"UserData"`) // → "UserData"
+func (d *dialectImpl) QuoteIdentifier(name string) string {
+	q := dialect.NewQuoter(d.opts, bareIdentifier.MatchString)
+	q.Reserved = keywords.IsReserved
+	return q.Quote(name)
+}
+
+// Keywords returns the Oracle keyword set. Words such as LEVEL, SIZE and
+// UID are reserved here even though other engines accept them bare.
+func (d *dialectImpl) Keywords() dialect.Keywords {
+	return keywords
+}
+
+// Functions returns the Oracle spellings of canonical functions: SUBSTR,
+// LENGTH and TRUNC with a format model for DATE_TRUNC. TRUNC has no
+// format model for seconds, so DATE_TRUNC by second is unsupported.
+func (d *dialectImpl) Functions() dialect.FunctionMap {
+	return dialect.FunctionMap{
+		dialect.FuncSubstring: dialect.Call("SUBSTR"),
+		dialect.FuncLength:    dialect.Call("LENGTH"),
+		dialect.FuncDateTrunc: func(args []string) (string, error) {
+			model, ok := truncModels[args[0]]
+			if !ok {
+				return "", fmt.Errorf("%w: DATE_TRUNC by %s for %s", dialect.ErrNoTranslation, args[0], d.Name())
+			}
+			return fmt.Sprintf("TRUNC(%s, '%s')", args[1], model), nil
+		},
+	}
+}
+
+// truncModels maps DATE_TRUNC units to TRUNC format models.
+var truncModels = map[string]string{
+	"year":    "YYYY",
+	"quarter": "Q",
+	"month":   "MM",
+	"week":    "IW",
+	"day":     "DD",
+	"hour":    "HH24",
+	"minute":  "MI",
+}
+
+// QuoteLiteral quotes a literal value for inline use in Oracle SQL.
+//
+// Supported types:
+//   - nil       → NULL
+//   - string    → escaped and wrapped in single quotes
+//   - bool      → 1 or 0 (no SQL BOOLEAN before 23ai)
+//   - numbers   → rendered in decimal form
+//   - time.Time → TIMESTAMP 'YYYY-MM-DD HH:MM:SS' in UTC
+//   - []byte    → HEXTORAW('..')
+//   - others    → dialect.MustLiteral
+func (d *dialectImpl) QuoteLiteral(literal any) string {
+	switch v := literal.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return "'" + strings.ReplaceAll(v, "'", "''") + "'"
+	case bool:
+		if v {
+			return "1"
+		}
+		return "0"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 64)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []byte:
+		return d.QuoteBinary(v)
+	case time.Time:
+		return dialect.QuoteTime(d, v)
+	default:
+		return dialect.MustLiteral(d, v)
+	}
+}
+
+// QuoteBinary renders data as a RAW value.
+//
+// Example:
+//
+//	d.QuoteBinary([]byte("hi")) // → HEXTORAW('6869')
+func (d *dialectImpl) QuoteBinary(data []byte) string {
+	return "HEXTORAW('" + strings.ToUpper(hex.EncodeToString(data)) + "')"
+}
+
+// PaginationSyntax renders the OFFSET/FETCH row-limiting clause available
+// since Oracle 12c. For older versions it returns an empty string because
+// ROWNUM pagination requires wrapping the statement; use Paginate instead.
+//
+// Example:
+//
+//	d.PaginationSyntax(10, 0)  // → " FETCH FIRST 10 ROWS ONLY"
+//	d.PaginationSyntax(10, 20) // → " OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY"
+//	d.PaginationSyntax(0, 20)  // → " OFFSET 20 ROWS"
+func (d *dialectImpl) PaginationSyntax(limit, offset int) string {
+	if !d.supportsFetch() || (limit <= 0 && offset <= 0) {
+		return ""
+	}
+	switch {
+	case limit > 0 && offset > 0:
+		return fmt.Sprintf(" OFFSET %d ROWS FETCH NEXT %d ROWS ONLY", offset, limit)
+	case limit > 0:
+		return fmt.Sprintf(" FETCH FIRST %d ROWS ONLY", limit)
+	default:
+		return fmt.Sprintf(" OFFSET %d ROWS", offset)
+	}
+}
+
+// Paginate applies limit and offset to query.
+//
+// On 12c and later the OFFSET/FETCH clause is appended. On earlier versions
+// the query is wrapped and filtered with ROWNUM. When an offset is present,
+// the wrapper exposes an additional dialect.RowNumberColumn ("rn_") after
+// the columns of query, holding the row number; exec.ScanAll skips it.
+//
+// Example (11g):
+//
+//	d.Paginate("SELECT id FROM users", 10, 20)
+//	// SELECT * FROM (SELECT q_.*, ROWNUM rn_ FROM (SELECT id FROM users) q_
+//	//   WHERE ROWNUM <= 30) WHERE rn_ > 20
+func (d *dialectImpl) Paginate(query string, limit, offset int) string {
+	if limit <= 0 && offset <= 0 {
+		return query
+	}
+	if d.supportsFetch() {
+		return query + d.PaginationSyntax(limit, offset)
+	}
+	switch {
+	case offset <= 0:
+		return fmt.Sprintf("SELECT * FROM (%s) WHERE ROWNUM <= %d", query, limit)
+	case limit <= 0:
+		return fmt.Sprintf(
+			"SELECT * FROM (SELECT q_.*, ROWNUM %[3]s FROM (%[1]s) q_) WHERE %[3]s > %[2]d",
+			query, offset, dialect.RowNumberColumn,
+		)
+	default:
+		return fmt.Sprintf(
+			"SELECT * FROM (SELECT q_.*, ROWNUM %[4]s FROM (%[1]s) q_ WHERE ROWNUM <= %[2]d) WHERE %[4]s > %[3]d",
+			query, offset+limit, offset, dialect.RowNumberColumn,
+		)
+	}
+}
+
+// Placeholder returns the positional bind variable for index, e.g. ":1".
+func (d *dialectImpl) Placeholder(index int) string {
+	return fmt.Sprintf(":%d", index)
+}
+
+// PlaceholderNamed returns the named bind variable, e.g. ":id".
+func (d *dialectImpl) PlaceholderNamed(name string) string {
+	return ":" + name
+}
+
+// AliasTable renders a table alias without the AS keyword, which Oracle
+// rejects in FROM clauses.
+//
+// Example:
+//
+//	d.AliasTable("users", "u") // → users u
+func (d *dialectImpl) AliasTable(name, alias string) string {
+	if alias == "" {
+		return name
+	}
+	return name + " " + alias
+}
+
+// ValidateIdentifier checks every dot-separated part of name against the
+// identifier length limit of the targeted version.
+//
+// Errors wrap errors.InvalidIdentifierError.
+//
+// Example:
+//
+//	oracle.NewWithVersion(11, 2).(dialect.IdentifierValidator).
+//	    ValidateIdentifier(strings.Repeat("a", 31))
+//	// invalid identifier: "aaa…" exceeds 30 bytes
+func (d *dialectImpl) ValidateIdentifier(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("%w: empty identifier", errors.InvalidIdentifierError)
+	}
+	limit := d.opts.MaxIdentifierLength
+	for _, part := range dialect.SplitQualified(name, `"`, `"`) {
+		part = strings.Trim(part, `"`)
+		if part == "" {
+			return fmt.Errorf("%w: empty part in %q", errors.InvalidIdentifierError, name)
+		}
+		if limit > 0 && len(part) > limit {
+			return fmt.Errorf(
+				"%w: %q exceeds %d bytes", errors.InvalidIdentifierError, part, limit,
+			)
+		}
+	}
+	return nil
+}
+
+// ReturningSyntax renders "RETURNING ... INTO ..." using positional
+// out-binds starting at nextIndex. Callers must append one out argument
+// (e.g. sql.Out) per column, in order.
+//
+// Example:
+//
+//	d.ReturningSyntax([]string{"id", "created_at"}, 3)
+//	// RETURNING id, created_at INTO :3, :4
+func (d *dialectImpl) ReturningSyntax(columns []string, nextIndex int) string {
+	if len(columns) == 0 {
+		return ""
+	}
+	if nextIndex < 1 {
+		nextIndex = 1
+	}
+	cols := make([]string, len(columns))
+	binds := make([]string, len(columns))
+	for i, c := range columns {
+		cols[i] = d.QuoteIdentifier(c)
+		binds[i] = d.Placeholder(nextIndex + i)
+	}
+	return fmt.Sprintf("RETURNING %s INTO %s", strings.Join(cols, ", "), strings.Join(binds, ", "))
+}
+
+// MergeSyntax renders an upsert as a MERGE statement reading a single row
+// of positional binds from dual. Non-key columns are updated when a row
+// matching keys exists; when every column is a key, only the insert branch
+// is rendered.
+//
+// Example:
+//
+//	d.MergeSyntax("users", []string{"id", "name"}, []string{"id"})
+//	// MERGE INTO users tgt USING (SELECT :1 AS id, :2 AS name FROM dual) src
+//	//   ON (tgt.id = src.id)
+//	//   WHEN MATCHED THEN UPDATE SET tgt.name = src.name
+//	//   WHEN NOT MATCHED THEN INSERT (id, name) VALUES (src.id, src.name)
+func (d *dialectImpl) MergeSyntax(table string, columns, keys []string) string {
+	return dialect.Merge(d, table, columns, keys, dialect.MergeStyle{Source: dialect.MergeSelect("dual")})
+}
+
+// supportsFetch reports whether the targeted version offers OFFSET/FETCH.
+func (d *dialectImpl) supportsFetch() bool {
+	return d.major >= 12
+}
+
+// SavepointSyntax renders "SAVEPOINT name".
+func (d *dialectImpl) SavepointSyntax(name string) string {
+	return "SAVEPOINT " + name
+}
+
+// ReleaseSavepointSyntax returns an empty string: Oracle has no RELEASE
+// SAVEPOINT, savepoints end with the transaction.
+func (d *dialectImpl) ReleaseSavepointSyntax(string) string {
+	return ""
+}
+
+// RollbackToSavepointSyntax renders "ROLLBACK TO SAVEPOINT name".
+func (d *dialectImpl) RollbackToSavepointSyntax(name string) string {
+	return "ROLLBACK TO SAVEPOINT " + name
+}
+
+// ErrorCodes returns the Oracle error numbers, as reported by godror and
+// go-ora (1 for ORA-00001).
+func (d *dialectImpl) ErrorCodes() dialect.ErrorCodes {
+	return dialect.ErrorCodes{
+		"1":     errors.ErrUniqueViolation,     // ORA-00001 unique constraint violated
+		"2291":  errors.ErrForeignKeyViolation, // ORA-02291 parent key not found
+		"2292":  errors.ErrForeignKeyViolation, // ORA-02292 child record found
+		"1400":  errors.ErrNotNullViolation,    // ORA-01400 cannot insert NULL
+		"1407":  errors.ErrNotNullViolation,    // ORA-01407 cannot update to NULL
+		"8177":  errors.ErrSerialization,       // ORA-08177 can't serialize access
+		"60":    errors.ErrDeadlock,            // ORA-00060 deadlock detected
+		"54":    errors.ErrLockTimeout,         // ORA-00054 resource busy, NOWAIT
+		"30006": errors.ErrLockTimeout,         // ORA-30006 WAIT timeout expired
+		"1013":  errors.ErrQueryCanceled,       // ORA-01013 user requested cancel
+	}
+}
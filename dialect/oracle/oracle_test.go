@@ -0,0 +1,256 @@
+package oracle_test
+
+import (
+	stdErr "errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/entiqon/db/builder/selects"
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/errors"
+)
+
+func TestOracleDialect(t *testing.T) {
+	d := oracle.New()
+
+	t.Run("Name", func(t *testing.T) {
+		if got, want := d.Name(), "oracle"; got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		opts := d.Options()
+		if opts.Name != "oracle" {
+			t.Errorf("unexpected Options.Name = %q", opts.Name)
+		}
+		if !opts.AllowMerge || !opts.EnableReturning {
+			t.Errorf("expected MERGE and RETURNING to be enabled")
+		}
+		if opts.ForcedAliasing {
+			t.Errorf("expected ForcedAliasing=false")
+		}
+		if opts.MaxIdentifierLength != 128 {
+			t.Errorf("unexpected MaxIdentifierLength = %d", opts.MaxIdentifierLength)
+		}
+		if got := oracle.NewWithVersion(12, 1).Options().MaxIdentifierLength; got != 30 {
+			t.Errorf("12.1 MaxIdentifierLength = %d, want 30", got)
+		}
+		if got := oracle.NewWithVersion(12, 2).Options().MaxIdentifierLength; got != 128 {
+			t.Errorf("12.2 MaxIdentifierLength = %d, want 128", got)
+		}
+	})
+
+	t.Run("NewWithOptions", func(t *testing.T) {
+		custom := oracle.NewWithOptions(dialect.Options{Name: "oracle-custom"})
+		if got := custom.Name(); got != "oracle-custom" {
+			t.Errorf("expected %q, got %q", "oracle-custom", got)
+		}
+		if got := custom.PaginationSyntax(5, 0); got != " FETCH FIRST 5 ROWS ONLY" {
+			t.Errorf("unexpected pagination %q", got)
+		}
+	})
+
+	t.Run("QuoteIdentifier", func(t *testing.T) {
+		cases := []struct {
+			in   string
+			want string
+		}{
+			{"users", "users"},
+			{"order$items#1", "order$items#1"},
+			{"UserData", "UserData"},
+			{`"UserData"`, `"UserData"`},
+			{"order items", `"order items"`},
+			{`odd"name`, `"odd""name"`},
+			{"level", `"LEVEL"`},
+			{"size", `"SIZE"`},
+			{`"level"`, `"level"`},
+			{"year", "year"},
+			{"", ""},
+		}
+		for _, c := range cases {
+			if got := d.QuoteIdentifier(c.in); got != c.want {
+				t.Errorf("QuoteIdentifier(%q) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("QuoteLiteral", func(t *testing.T) {
+		now := time.Date(2025, 9, 19, 3, 30, 0, 0, time.UTC)
+		cases := []struct {
+			in   any
+			want string
+		}{
+			{nil, "NULL"},
+			{"O'Reilly", "'O''Reilly'"},
+			{true, "1"},
+			{false, "0"},
+			{42, "42"},
+			{uint8(7), "7"},
+			{float32(2.5), "2.5"},
+			{3.14, "3.14"},
+			{now, "TIMESTAMP '2025-09-19 03:30:00'"},
+			{now.Add(123456 * time.Microsecond), "TIMESTAMP '2025-09-19 03:30:00.123456'"},
+			{[]byte("hi"), "HEXTORAW('6869')"},
+		}
+		for _, c := range cases {
+			if got := d.QuoteLiteral(c.in); got != c.want {
+				t.Errorf("QuoteLiteral(%v) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("Placeholder", func(t *testing.T) {
+		if got := d.Placeholder(1); got != ":1" {
+			t.Errorf("Placeholder(1) = %q, want ':1'", got)
+		}
+		if got := d.Placeholder(42); got != ":42" {
+			t.Errorf("Placeholder(42) = %q, want ':42'", got)
+		}
+		nb, ok := d.(dialect.NamedBinder)
+		if !ok {
+			t.Fatal("expected oracle to implement dialect.NamedBinder")
+		}
+		if got := nb.PlaceholderNamed("id"); got != ":id" {
+			t.Errorf("PlaceholderNamed(id) = %q, want ':id'", got)
+		}
+	})
+
+	t.Run("PaginationSyntax", func(t *testing.T) {
+		cases := []struct {
+			limit, offset int
+			want          string
+		}{
+			{0, 0, ""},
+			{10, 0, " FETCH FIRST 10 ROWS ONLY"},
+			{0, 20, " OFFSET 20 ROWS"},
+			{10, 20, " OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY"},
+		}
+		for _, c := range cases {
+			if got := d.PaginationSyntax(c.limit, c.offset); got != c.want {
+				t.Errorf("PaginationSyntax(%d,%d) = %q, want %q", c.limit, c.offset, got, c.want)
+			}
+		}
+		if got := oracle.NewWithVersion(11, 2).PaginationSyntax(10, 0); got != "" {
+			t.Errorf("11g PaginationSyntax = %q, want empty", got)
+		}
+	})
+
+	t.Run("Paginate", func(t *testing.T) {
+		const q = "SELECT id FROM users ORDER BY id"
+		cases := []struct {
+			name          string
+			d             dialect.SQLDialect
+			limit, offset int
+			want          string
+		}{
+			{"19c/none", d, 0, 0, q},
+			{"19c/limit", d, 10, 0, q + " FETCH FIRST 10 ROWS ONLY"},
+			{"19c/both", d, 10, 20, q + " OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY"},
+			{"11g/none", oracle.NewWithVersion(11, 2), 0, 0, q},
+			{"11g/limit", oracle.NewWithVersion(11, 2), 10, 0,
+				"SELECT * FROM (" + q + ") WHERE ROWNUM <= 10"},
+			{"11g/offset", oracle.NewWithVersion(11, 2), 0, 20,
+				"SELECT * FROM (SELECT q_.*, ROWNUM rn_ FROM (" + q + ") q_) WHERE rn_ > 20"},
+			{"11g/both", oracle.NewWithVersion(11, 2), 10, 20,
+				"SELECT * FROM (SELECT q_.*, ROWNUM rn_ FROM (" + q + ") q_ WHERE ROWNUM <= 30) WHERE rn_ > 20"},
+		}
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				if got := dialect.Paginate(c.d, q, c.limit, c.offset); got != c.want {
+					t.Errorf("Paginate = %q\nwant      %q", got, c.want)
+				}
+			})
+		}
+	})
+
+	t.Run("AliasTable", func(t *testing.T) {
+		if got := dialect.AliasTable(d, "users", "u"); got != "users u" {
+			t.Errorf("AliasTable = %q, want %q", got, "users u")
+		}
+		if got := d.(dialect.TableAliaser).AliasTable("users", ""); got != "users" {
+			t.Errorf("AliasTable without alias = %q, want %q", got, "users")
+		}
+	})
+
+	t.Run("Joins", func(t *testing.T) {
+		sb := selects.New(d).Fields("u.id, o.total").From("users u").
+			InnerJoin("users u", "orders o", "o.user_id = u.id").
+			LeftJoin("users u", "teams t", "t.id = u.team_id").
+			NaturalJoin("regions r")
+		sql, _, err := sb.Build()
+		want := "SELECT u.id, o.total FROM users u INNER JOIN orders o ON o.user_id = u.id " +
+			"LEFT JOIN teams t ON t.id = u.team_id NATURAL JOIN regions r"
+		if err != nil || sql != want {
+			t.Errorf("Build = %q (%v)\nwant    %q", sql, err, want)
+		}
+		if strings.Contains(sql, " AS ") {
+			t.Errorf("expected no AS on table aliases, got %q", sql)
+		}
+	})
+
+	t.Run("ValidateIdentifier", func(t *testing.T) {
+		legacy := oracle.NewWithVersion(11, 2).(dialect.IdentifierValidator)
+		modern := d.(dialect.IdentifierValidator)
+
+		name30 := strings.Repeat("a", 30)
+		name31 := strings.Repeat("a", 31)
+
+		if err := legacy.ValidateIdentifier(name30); err != nil {
+			t.Errorf("expected 30 bytes to be valid on 11g, got %v", err)
+		}
+		if err := legacy.ValidateIdentifier("hr." + name31); !stdErr.Is(err, errors.InvalidIdentifierError) {
+			t.Errorf("expected InvalidIdentifierError for 31 bytes on 11g, got %v", err)
+		}
+		if err := modern.ValidateIdentifier(name31); err != nil {
+			t.Errorf("expected 31 bytes to be valid on 19c, got %v", err)
+		}
+		if err := modern.ValidateIdentifier(strings.Repeat("a", 129)); err == nil {
+			t.Error("expected error for 129 bytes on 19c")
+		}
+		if err := modern.ValidateIdentifier(" "); !stdErr.Is(err, errors.InvalidIdentifierError) {
+			t.Errorf("expected error for empty identifier, got %v", err)
+		}
+		if err := modern.ValidateIdentifier("hr..users"); err == nil {
+			t.Error("expected error for empty part")
+		}
+	})
+
+	t.Run("ReturningSyntax", func(t *testing.T) {
+		r := d.(dialect.Returner)
+		if got := r.ReturningSyntax([]string{"id", `"CreatedAt"`}, 3); got != `RETURNING id, "CreatedAt" INTO :3, :4` {
+			t.Errorf("unexpected returning %q", got)
+		}
+		if got := r.ReturningSyntax([]string{"id"}, 0); got != "RETURNING id INTO :1" {
+			t.Errorf("unexpected returning %q", got)
+		}
+		if got := r.ReturningSyntax(nil, 1); got != "" {
+			t.Errorf("expected empty returning, got %q", got)
+		}
+	})
+
+	t.Run("MergeSyntax", func(t *testing.T) {
+		m := d.(dialect.Merger)
+		got := m.MergeSyntax("users", []string{"id", "name", "email"}, []string{"id"})
+		want := "MERGE INTO users tgt USING (SELECT :1 AS id, :2 AS name, :3 AS email FROM dual) src" +
+			" ON (tgt.id = src.id)" +
+			" WHEN MATCHED THEN UPDATE SET tgt.name = src.name, tgt.email = src.email" +
+			" WHEN NOT MATCHED THEN INSERT (id, name, email) VALUES (src.id, src.name, src.email)"
+		if got != want {
+			t.Errorf("MergeSyntax =\n%s\nwant\n%s", got, want)
+		}
+
+		got = m.MergeSyntax("tags", []string{"id"}, []string{"id"})
+		want = "MERGE INTO tags tgt USING (SELECT :1 AS id FROM dual) src ON (tgt.id = src.id)" +
+			" WHEN NOT MATCHED THEN INSERT (id) VALUES (src.id)"
+		if got != want {
+			t.Errorf("MergeSyntax keys only =\n%s\nwant\n%s", got, want)
+		}
+
+		if got := m.MergeSyntax("users", nil, []string{"id"}); got != "" {
+			t.Errorf("expected empty merge, got %q", got)
+		}
+	})
+}
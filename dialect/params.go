@@ -0,0 +1,166 @@
+package dialect
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Param is a placeholder of a statement, as found by Params.
+type Param struct {
+	// Arg is the index, in the statement's args, of the value bound to
+	// the placeholder.
+	Arg int
+
+	// Column is the column the value is compared with or assigned to: id
+	// in "id = ?", "id IN (?, ?)", "id BETWEEN ? AND ?" or "SET id = ?",
+	// as written in the statement but unquoted. It is empty when there is
+	// no such column, as in VALUES lists or function arguments.
+	Column string
+}
+
+// paramOperators are the words that may stand between a column and its
+// placeholder.
+var paramOperators = map[string]bool{
+	"AND": true, "ALL": true, "ANY": true, "BETWEEN": true, "ESCAPE": true, "ILIKE": true,
+	"IN": true, "IS": true, "LIKE": true, "NOT": true, "SIMILAR": true, "SOME": true, "TO": true,
+}
+
+// Params returns the placeholders of query, rendered for d, in order, with
+// the argument and the column each one binds. Placeholders inside quoted
+// strings, quoted identifiers and comments are skipped, as by Interpolate.
+// A nil d reads the dialect-neutral :name placeholders of builders.
+//
+// Params lets callers reason about args by column, for instance to redact
+// sensitive values from logs, without parsing SQL.
+//
+// Example:
+//
+//	dialect.Params(oracle.New(), "SELECT * FROM users WHERE email = :1 AND id IN (:2, :3)")
+//	// [{0 email} {1 id} {2 id}]
+func Params(d SQLDialect, query string) []Param {
+	marker, numbered := ":", false
+	backslash, brackets := lexing(d)
+	if d != nil {
+		marker = d.Placeholder(1)
+		numbered = marker != d.Placeholder(2)
+		if numbered {
+			marker = strings.TrimSuffix(marker, "1")
+		}
+	}
+	if marker == "" {
+		return nil
+	}
+
+	var params []Param
+	var spans [][2]int
+	next := 0
+	for i := 0; i < len(query); {
+		if end := skipQuoted(query, i, backslash, brackets); end > i {
+			i = end
+			continue
+		}
+		if !strings.HasPrefix(query[i:], marker) {
+			i++
+			continue
+		}
+
+		j := i + len(marker)
+		arg := next
+		switch {
+		case d == nil:
+			for j < len(query) && isIdentByte(query[j]) {
+				j++
+			}
+			if j == i+len(marker) || (i > 0 && query[i-1] == ':') {
+				i = j
+				continue
+			}
+			next++
+		case numbered:
+			for j < len(query) && query[j] >= '0' && query[j] <= '9' {
+				j++
+			}
+			if j == i+len(marker) {
+				i = j
+				continue
+			}
+			n, _ := strconv.Atoi(query[i+len(marker) : j])
+			arg = n - 1
+		default:
+			next++
+		}
+
+		params = append(params, Param{Arg: arg, Column: paramColumn(query, i, spans)})
+		spans = append(spans, [2]int{i, j})
+		i = j
+	}
+	return params
+}
+
+// paramColumn returns the column the placeholder at query[i] binds, by
+// reading backwards over operators, parentheses and the placeholders in
+// spans. A word right before an opening parenthesis is a function name,
+// not a column.
+func paramColumn(query string, i int, spans [][2]int) string {
+	call := false
+	for i > 0 {
+		if n := len(spans); n > 0 && spans[n-1][1] == i {
+			i, spans = spans[n-1][0], spans[:n-1]
+			call = false
+			continue
+		}
+		switch c := query[i-1]; {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i--
+		case c == '(':
+			call = true
+			i--
+		case strings.IndexByte("=<>!,", c) >= 0:
+			call = false
+			i--
+		case c == '"' || c == '`' || c == ']':
+			if call {
+				return ""
+			}
+			open := c
+			if c == ']' {
+				open = '['
+			}
+			for j := i - 2; j >= 0; j-- {
+				if query[j] != open {
+					continue
+				}
+				if open != '[' && j > 0 && query[j-1] == open {
+					j--
+					continue
+				}
+				double := string(c) + string(c)
+				return strings.ReplaceAll(query[j+1:i-1], double, string(c))
+			}
+			return ""
+		case isIdentByte(c):
+			j := i
+			for j > 0 && (isIdentByte(query[j-1]) || query[j-1] == '.') {
+				j--
+			}
+			word := query[j:i]
+			if paramOperators[strings.ToUpper(word)] {
+				i, call = j, false
+				continue
+			}
+			if call || IsReserved(word) || (word[0] >= '0' && word[0] <= '9') {
+				return ""
+			}
+			return word
+		default:
+			return ""
+		}
+	}
+	return ""
+}
+
+// isIdentByte reports whether c may appear in an unquoted identifier.
+func isIdentByte(c byte) bool {
+	return c == '_' || c == '$' || c == '#' ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c >= 0x80
+}
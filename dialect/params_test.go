@@ -0,0 +1,58 @@
+package dialect_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/generic"
+	"github.com/entiqon/db/dialect/oracle"
+)
+
+func TestParams(t *testing.T) {
+	p := func(pairs ...any) []dialect.Param {
+		var out []dialect.Param
+		for i := 0; i < len(pairs); i += 2 {
+			out = append(out, dialect.Param{Arg: pairs[i].(int), Column: pairs[i+1].(string)})
+		}
+		return out
+	}
+	cases := []struct {
+		name  string
+		d     dialect.SQLDialect
+		query string
+		want  []dialect.Param
+	}{
+		{"Comparisons", generic.New(),
+			"SELECT * FROM users WHERE email = ? AND age >= ? AND name <> ?",
+			p(0, "email", 1, "age", 2, "name")},
+		{"Lists", generic.New(),
+			"SELECT * FROM users WHERE id IN (?, ?) AND age NOT BETWEEN ? AND ? AND tags = ANY(?)",
+			p(0, "id", 1, "id", 2, "age", 3, "age", 4, "tags")},
+		{"Like", generic.New(),
+			"SELECT * FROM users WHERE name ILIKE ? ESCAPE ?",
+			p(0, "name", 1, "name")},
+		{"Quoted", generic.New(),
+			`SELECT * FROM users u WHERE u.ssn = ? AND "Pass""word" = ? AND note = 'a = ?' /* ? */`,
+			p(0, "u.ssn", 1, `Pass"word`)},
+		{"Set", generic.New(),
+			"UPDATE users SET password = ?, updated_at = NOW() WHERE id = ?",
+			p(0, "password", 1, "id")},
+		{"NoColumn", generic.New(),
+			"INSERT INTO users (email) VALUES (?); SELECT LOWER(?) FROM t WHERE 1 = ?",
+			p(0, "", 1, "", 2, "")},
+		{"Numbered", oracle.New(),
+			"SELECT * FROM users WHERE token = :2 AND id = :1",
+			p(1, "token", 0, "id")},
+		{"Neutral", nil,
+			"SELECT * FROM users WHERE password = :password AND id IN :id AND x::int = 1",
+			p(0, "password", 1, "id")},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := dialect.Params(tc.d, tc.query); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
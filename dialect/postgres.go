@@ -4,10 +4,15 @@ package dialect
 
 import (
 	"fmt"
-	"strings"
+	"regexp"
 )
 
+// regularIdentifier matches the names PostgreSQL accepts unquoted.
+var regularIdentifier = regexp.MustCompile(`^[a-z_][a-z0-9_$]*$`)
+
 // PostgresDialect implements Dialect interface for PostgreSQL.
+// It is not an SQLDialect; builders and executors take postgres.New()
+// from the dialect/postgres package instead.
 type PostgresDialect struct {
 	BaseDialect
 }
@@ -19,9 +24,16 @@ func (d *PostgresDialect) Name() string {
 
 // QuoteIdentifier quotes an identifier with double quotes,
 // and escapes embedded double quotes by doubling them.
+// Schema-qualified names are quoted part by part, and unquoted regular
+// names are folded to lower case first, as PostgreSQL would resolve them.
 func (d *PostgresDialect) QuoteIdentifier(name string) string {
-	escaped := strings.ReplaceAll(name, `"`, `""`)
-	return `"` + escaped + `"`
+	return Quoter{
+		Open:    `"`,
+		Close:   `"`,
+		Policy:  QuoteAlways,
+		Bare:    regularIdentifier.MatchString,
+		Folding: FoldLower,
+	}.Quote(name)
 }
 
 // Placeholder returns the PostgreSQL-style positional parameter placeholder, e.g., $1, $2, ...
@@ -0,0 +1,30 @@
+/*
+Package postgres provides the PostgreSQL SQL dialect implementation.
+
+# Overview
+
+The PostgreSQL dialect renders SQL following PostgreSQL rules:
+
+  - Unquoted identifiers are folded to lower case. Lowercase simple names
+    are emitted bare; anything else is double-quoted.
+  - Placeholders are numbered ($1, $2, ...), up to 65535 per statement.
+  - Pagination uses LIMIT n OFFSET m.
+  - Arrays bind to a single placeholder, so long IN lists can be rendered
+    as = ANY($1) (see builder.InArray).
+  - Upserts use INSERT ... ON CONFLICT; RETURNING is supported.
+
+# Usage
+
+	d := postgres.New()
+	u := d.(dialect.Upserter)
+	sql := u.UpsertSyntax("users", []string{"id", "name"}, []string{"id"})
+	// INSERT INTO users (id, name) VALUES ($1, $2)
+	//   ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name
+
+# Capabilities
+
+Besides dialect.SQLDialect, the PostgreSQL dialect implements the optional
+dialect.Returner, dialect.Upserter and dialect.BinaryQuoter interfaces.
+Savepoints use the standard statements.
+*/
+package postgres
@@ -0,0 +1,24 @@
+package postgres_test
+
+import (
+	"fmt"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/postgres"
+)
+
+func Example() {
+	d := postgres.New()
+	fmt.Println(d.Placeholder(1))
+	fmt.Println(d.Options().SupportsArrayBinding)
+	// Output:
+	// $1
+	// true
+}
+
+func Example_upsert() {
+	d := postgres.New().(dialect.Upserter)
+	fmt.Println(d.UpsertSyntax("users", []string{"id", "name"}, []string{"id"}))
+	// Output:
+	// INSERT INTO users (id, name) VALUES ($1, $2) ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name
+}
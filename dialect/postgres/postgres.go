@@ -0,0 +1,213 @@
+package postgres
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/entiqon/db/dialect"
+)
+
+//
+// PostgreSQL Dialect
+//
+
+// dialectImpl provides the PostgreSQL implementation of the
+// dialect.SQLDialect interface. It is unexported to prevent direct
+// instantiation; consumers should always use the New() constructor.
+//
+// Key behaviors:
+//   - Unquoted identifiers are folded to lower case. Lowercase simple names
+//     are emitted bare; anything else is double-quoted.
+//   - Placeholders are numbered "$1", "$2", ...
+//   - Pagination uses LIMIT n OFFSET m.
+//   - Arrays bind to a single placeholder (= ANY($1)).
+//   - Upserts use INSERT ... ON CONFLICT; RETURNING is supported.
+type dialectImpl struct {
+	opts dialect.Options
+}
+
+// Compile-time checks: ensure dialectImpl implements SQLDialect and the
+// optional capabilities it advertises.
+var (
+	_ dialect.SQLDialect   = (*dialectImpl)(nil)
+	_ dialect.Returner     = (*dialectImpl)(nil)
+	_ dialect.Upserter     = (*dialectImpl)(nil)
+	_ dialect.BinaryQuoter = (*dialectImpl)(nil)
+)
+
+// bareIdentifier matches identifiers that can be emitted without quotes.
+var bareIdentifier = regexp.MustCompile(`^[a-z_][a-z0-9_$]*$`)
+
+//
+// Constructors
+//
+
+// New returns a PostgreSQL dialect. The returned value implements the
+// dialect.SQLDialect interface.
+//
+// Example:
+//
+//	d := postgres.New()
+//	d.Placeholder(2) // → "$2"
+func New() dialect.SQLDialect {
+	return &dialectImpl{
+		opts: dialect.Options{
+			Name:                    "postgres",
+			QuoteStyle:              `"`,
+			CaseFolding:             dialect.FoldLower,
+			PlaceholderStyle:        "$%d",
+			AllowMerge:              false,
+			AllowUpsert:             true,
+			ForcedAliasing:          true,
+			EnableReturning:         true,
+			SupportsCTE:             true,
+			SupportsWindowFunctions: true,
+			SupportsNullsOrdering:   true,
+			SupportsILike:           true,
+			SupportsArrayBinding:    true,
+			SupportsValuesList:      true,
+			MaxPlaceholderIndex:     65535,
+			MaxIdentifierLength:     63,
+		},
+	}
+}
+
+// NewWithOptions creates a PostgreSQL dialect with the given static
+// options. Options are immutable once the dialect is constructed.
+func NewWithOptions(opts dialect.Options) dialect.SQLDialect {
+	return &dialectImpl{opts: opts}
+}
+
+//
+// Interface Implementation
+//
+
+// Name returns the identifier of this dialect, "postgres" by default.
+func (d *dialectImpl) Name() string {
+	return d.opts.Name
+}
+
+// Options returns the feature capability matrix for the PostgreSQL dialect.
+func (d *dialectImpl) Options() dialect.Options {
+	return d.opts
+}
+
+// QuoteIdentifier returns a PostgreSQL identifier. Lowercase simple names
+// are returned as-is; anything else is wrapped in double quotes, with
+// embedded double quotes escaped by doubling them.
+//
+// Schema-qualified names are quoted part by part and reserved words
+// are quoted, subject to Options().QuotePolicy.
+//
+// Example:
+//
+//	d.QuoteIdentifier("users")     // → users
+//	d.QuoteIdentifier("user data") // → "user data"
+func (d *dialectImpl) QuoteIdentifier(name string) string {
+	return dialect.NewQuoter(d.opts, bareIdentifier.MatchString).Quote(name)
+}
+
+// QuoteLiteral quotes a literal value for inline use in PostgreSQL SQL.
+//
+// Supported types:
+//   - nil       → NULL
+//   - string    → escaped and wrapped in single quotes
+//     (standard_conforming_strings, the default since 9.1)
+//   - bool      → TRUE or FALSE
+//   - numbers   → rendered in decimal form
+//   - time.Time → TIMESTAMP 'YYYY-MM-DD HH:MM:SS' in UTC
+//   - []byte    → '\x..'::bytea
+//   - others    → dialect.MustLiteral
+func (d *dialectImpl) QuoteLiteral(literal any) string {
+	switch v := literal.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return "'" + strings.ReplaceAll(v, "'", "''") + "'"
+	case bool:
+		if v {
+			return "TRUE"
+		}
+		return "FALSE"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 64)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return dialect.MustLiteral(d, v)
+	}
+}
+
+// QuoteBinary renders data as a bytea in hex format.
+//
+// Example:
+//
+//	d.QuoteBinary([]byte("hi")) // → '\x6869'::bytea
+func (d *dialectImpl) QuoteBinary(data []byte) string {
+	return `'\x` + hex.EncodeToString(data) + "'::bytea"
+}
+
+// PaginationSyntax renders the PostgreSQL LIMIT/OFFSET clause.
+//
+// Example:
+//
+//	d.PaginationSyntax(10, 20) // → " LIMIT 10 OFFSET 20"
+//	d.PaginationSyntax(0, 20)  // → " OFFSET 20"
+func (d *dialectImpl) PaginationSyntax(limit, offset int) string {
+	var sb strings.Builder
+	if limit > 0 {
+		sb.WriteString(fmt.Sprintf(" LIMIT %d", limit))
+	}
+	if offset > 0 {
+		sb.WriteString(fmt.Sprintf(" OFFSET %d", offset))
+	}
+	return sb.String()
+}
+
+// Placeholder returns the numbered placeholder for the given index, in
+// Options().PlaceholderStyle, or "$%d" when the style has no %d verb, as
+// with options built without one.
+//
+// Example:
+//
+//	d.Placeholder(1) // → "$1"
+func (d *dialectImpl) Placeholder(index int) string {
+	style := d.opts.PlaceholderStyle
+	if !strings.Contains(style, "%d") {
+		style = "$%d"
+	}
+	return fmt.Sprintf(style, index)
+}
+
+// ReturningSyntax renders "RETURNING ..." for the given columns.
+// PostgreSQL returns values as a result set, so nextIndex is ignored.
+//
+// Example:
+//
+//	d.ReturningSyntax([]string{"id"}, 1) // → RETURNING id
+func (d *dialectImpl) ReturningSyntax(columns []string, _ int) string {
+	if len(columns) == 0 {
+		return ""
+	}
+	cols := make([]string, len(columns))
+	for i, c := range columns {
+		cols[i] = d.QuoteIdentifier(c)
+	}
+	return "RETURNING " + strings.Join(cols, ", ")
+}
+
+// UpsertSyntax renders an INSERT ... ON CONFLICT upsert.
+//
+// Example:
+//
+//	d.UpsertSyntax("users", []string{"id", "name"}, []string{"id"})
+//	// INSERT INTO users (id, name) VALUES ($1, $2)
+//	//   ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name
+func (d *dialectImpl) UpsertSyntax(table string, columns, keys []string) string {
+	return dialect.OnConflict(d, table, columns, keys)
+}
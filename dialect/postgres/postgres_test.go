@@ -0,0 +1,126 @@
+package postgres_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/postgres"
+)
+
+func TestPostgresDialect(t *testing.T) {
+	d := postgres.New()
+
+	t.Run("Name", func(t *testing.T) {
+		if got, want := d.Name(), "postgres"; got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+		if got := postgres.NewWithOptions(dialect.Options{Name: "pg", PlaceholderStyle: "$%d"}).Name(); got != "pg" {
+			t.Errorf("expected %q, got %q", "pg", got)
+		}
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		opts := d.Options()
+		if !opts.AllowUpsert || !opts.EnableReturning {
+			t.Error("expected UPSERT and RETURNING to be enabled")
+		}
+		if !opts.SupportsArrayBinding || !opts.SupportsValuesList {
+			t.Error("expected array binding and VALUES lists to be supported")
+		}
+		if opts.MaxPlaceholderIndex != 65535 {
+			t.Errorf("unexpected MaxPlaceholderIndex = %d", opts.MaxPlaceholderIndex)
+		}
+		if opts.MaxIdentifierLength != 63 {
+			t.Errorf("unexpected MaxIdentifierLength = %d", opts.MaxIdentifierLength)
+		}
+	})
+
+	t.Run("QuoteIdentifier", func(t *testing.T) {
+		cases := []struct {
+			in   string
+			want string
+		}{
+			{"users", "users"},
+			{"UserData", "UserData"},
+			{`"UserData"`, `"UserData"`},
+			{"public.users", "public.users"},
+			{"user data", `"user data"`},
+			{"order", `"order"`},
+			{`odd"name`, `"odd""name"`},
+			{"", ""},
+		}
+		for _, c := range cases {
+			if got := d.QuoteIdentifier(c.in); got != c.want {
+				t.Errorf("QuoteIdentifier(%q) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("QuoteLiteral", func(t *testing.T) {
+		now := time.Date(2025, 9, 19, 3, 30, 0, 0, time.UTC)
+		cases := []struct {
+			in   any
+			want string
+		}{
+			{nil, "NULL"},
+			{"O'Reilly", "'O''Reilly'"},
+			{`C:\tmp`, `'C:\tmp'`},
+			{true, "TRUE"},
+			{false, "FALSE"},
+			{uint64(5), "5"},
+			{float32(2.5), "2.5"},
+			{0.25, "0.25"},
+			{now, "TIMESTAMP '2025-09-19 03:30:00'"},
+			{[]byte("hi"), `'\x6869'::bytea`},
+		}
+		for _, c := range cases {
+			if got := d.QuoteLiteral(c.in); got != c.want {
+				t.Errorf("QuoteLiteral(%v) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("Placeholder", func(t *testing.T) {
+		if got := d.Placeholder(3); got != "$3" {
+			t.Errorf("Placeholder(3) = %q, want '$3'", got)
+		}
+		if got := postgres.NewWithOptions(dialect.Options{Name: "postgres"}).Placeholder(3); got != "$3" {
+			t.Errorf("expected $3 without a PlaceholderStyle, got %q", got)
+		}
+	})
+
+	t.Run("Golden", func(t *testing.T) {
+		r := d.(dialect.Returner)
+		u := d.(dialect.Upserter)
+		cases := []struct {
+			name string
+			got  string
+			want string
+		}{
+			{"paginate", dialect.Paginate(d, "SELECT id FROM users", 10, 20),
+				"SELECT id FROM users LIMIT 10 OFFSET 20"},
+			{"paginate offset", dialect.Paginate(d, "SELECT id FROM users", 0, 20),
+				"SELECT id FROM users OFFSET 20"},
+			{"returning", r.ReturningSyntax([]string{"id", "created_at"}, 3),
+				"RETURNING id, created_at"},
+			{"returning empty", r.ReturningSyntax(nil, 1), ""},
+			{"upsert", u.UpsertSyntax("users", []string{"id", "name"}, []string{"id"}),
+				"INSERT INTO users (id, name) VALUES ($1, $2) ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name"},
+		}
+		for _, c := range cases {
+			if c.got != c.want {
+				t.Errorf("%s:\n got %q\nwant %q", c.name, c.got, c.want)
+			}
+		}
+	})
+
+	t.Run("Placeholders", func(t *testing.T) {
+		if err := dialect.CheckPlaceholders(d, 65535); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if err := dialect.CheckPlaceholders(d, 65536); err == nil {
+			t.Error("expected an error beyond 65535 placeholders")
+		}
+	})
+}
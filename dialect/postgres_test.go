@@ -23,6 +23,16 @@ func TestPostgresDialect(t *testing.T) {
 		t.Errorf("QuoteIdentifier(%q) = %q; want %q", input, got, want)
 	}
 
+	// Test QuoteIdentifier with schema-qualified names
+	if got := pg.QuoteIdentifier("public.users"); got != `"public"."users"` {
+		t.Errorf("QuoteIdentifier(%q) = %q; want %q", "public.users", got, `"public"."users"`)
+	}
+
+	// Test QuoteIdentifier folding: unquoted names resolve in lower case
+	if got := pg.QuoteIdentifier(`UserId."OrderId"`); got != `"userid"."OrderId"` {
+		t.Errorf("QuoteIdentifier(%q) = %q; want %q", `UserId."OrderId"`, got, `"userid"."OrderId"`)
+	}
+
 	// Test Placeholder
 	for i, want := range []string{"$1", "$2", "$3"} {
 		if got := pg.Placeholder(i + 1); got != want {
@@ -0,0 +1,225 @@
+package dialect
+
+import "strings"
+
+// QuotePolicy controls when identifiers are delimited by a dialect.
+//
+// The zero value is QuoteAsNeeded, so dialects constructed without an
+// explicit policy keep quoting only the identifiers that require it.
+type QuotePolicy uint8
+
+const (
+	// QuoteAsNeeded delimits an identifier only when it cannot be written
+	// bare: it contains characters outside the dialect's bare pattern, its
+	// case would be folded by a dialect without a CaseFolding rule, or it
+	// is a reserved word.
+	QuoteAsNeeded QuotePolicy = iota
+
+	// QuoteAlways delimits every identifier part. Under a CaseFolding
+	// rule, unquoted regular names are folded first, so they still refer
+	// to the identifier the server would resolve.
+	QuoteAlways
+
+	// QuoteNever emits identifiers exactly as given. Callers are then
+	// responsible for producing valid SQL.
+	QuoteNever
+)
+
+// String returns the name of the policy.
+func (p QuotePolicy) String() string {
+	switch p {
+	case QuoteAsNeeded:
+		return "as-needed"
+	case QuoteAlways:
+		return "always"
+	case QuoteNever:
+		return "never"
+	default:
+		return "unknown"
+	}
+}
+
+// Quoter quotes identifiers following a dialect's delimiting rules.
+//
+// Qualified names such as schema.table are split on dots outside of
+// delimiters and every part is quoted on its own, so "sales.Orders"
+// renders as sales."Orders" rather than "sales.Orders". Parts that are
+// already delimited and the "*" wildcard are kept as they are.
+//
+// Dialect implementations usually build one with NewQuoter in
+// QuoteIdentifier:
+//
+//	func (d *dialectImpl) QuoteIdentifier(name string) string {
+//	    return dialect.NewQuoter(d.opts, bareIdentifier.MatchString).Quote(name)
+//	}
+type Quoter struct {
+	// Open and Close are the opening and closing delimiters, e.g. `"` and
+	// `"`, "`" and "`", or "[" and "]".
+	Open, Close string
+
+	// Backslash escapes embedded delimiters and backslashes with a
+	// backslash (ClickHouse, BigQuery) instead of doubling the closing
+	// delimiter (ANSI, MySQL, SQL Server).
+	Backslash bool
+
+	// Policy decides when parts are delimited.
+	Policy QuotePolicy
+
+	// Bare reports whether a part may be written without delimiters under
+	// QuoteAsNeeded. A nil Bare treats every part as requiring delimiters.
+	Bare func(part string) bool
+
+	// Reserved reports whether a part is a reserved word that must be
+	// delimited under QuoteAsNeeded. A nil Reserved reserves nothing.
+	Reserved func(part string) bool
+
+	// Folding is the dialect's case folding rule. Under FoldLower and
+	// FoldUpper, an unquoted part is regular when its lower-case form is
+	// bare, so mixed-case names are left to the server to fold instead
+	// of being delimited with their case preserved.
+	Folding CaseFolding
+}
+
+// NewQuoter returns a Quoter for the QuoteStyle and QuotePolicy of opts.
+// The closing delimiter matches the opening one, except for "[" which is
+// closed by "]". Reserved words are those reported by IsReserved, and the
+// case folding rule is opts.CaseFolding.
+//
+// Example:
+//
+//	q := dialect.NewQuoter(dialect.Options{QuoteStyle: "["}, nil)
+//	q.Quote("dbo.Order Items") // → [dbo].[Order Items]
+func NewQuoter(opts Options, bare func(part string) bool) Quoter {
+	closing := opts.QuoteStyle
+	if closing == "[" {
+		closing = "]"
+	}
+	return Quoter{
+		Open:     opts.QuoteStyle,
+		Close:    closing,
+		Policy:   opts.QuotePolicy,
+		Bare:     bare,
+		Reserved: IsReserved,
+		Folding:  opts.CaseFolding,
+	}
+}
+
+// Quote quotes name part by part according to the Quoter rules.
+// An empty name returns an empty string.
+//
+// Example:
+//
+//	q := dialect.Quoter{Open: `"`, Close: `"`, Policy: dialect.QuoteAlways}
+//	q.Quote(`sales.odd"name`) // → "sales"."odd""name"
+func (q Quoter) Quote(name string) string {
+	if name == "" {
+		return ""
+	}
+	parts := SplitQualified(name, q.Open, q.Close)
+	for i, p := range parts {
+		parts[i] = q.quotePart(p)
+	}
+	return strings.Join(parts, ".")
+}
+
+// Delimit wraps a single identifier part in the delimiters, escaping any
+// embedded closing delimiter. It ignores Policy.
+//
+// Example:
+//
+//	dialect.Quoter{Open: "[", Close: "]"}.Delimit("a]b") // → [a]]b]
+func (q Quoter) Delimit(part string) string {
+	if q.Open == "" && q.Close == "" {
+		return part
+	}
+	var escaped string
+	if q.Backslash {
+		escaped = strings.NewReplacer(`\`, `\\`, q.Close, `\`+q.Close).Replace(part)
+	} else {
+		escaped = strings.ReplaceAll(part, q.Close, q.Close+q.Close)
+	}
+	return q.Open + escaped + q.Close
+}
+
+// quotePart applies the policy to a single part.
+//
+// A regular part (one the server accepts unquoted) is the author asking
+// for the folded identifier: it is left bare when possible and folded
+// when it must be delimited. Other parts are delimited as written. Parts
+// in ANSI double quotes are re-delimited in the dialect's own style.
+func (q Quoter) quotePart(part string) string {
+	if part == "" || part == "*" || q.isDelimited(part) {
+		return part
+	}
+	if name, ok := Unquote(part); ok && q.Open != "" {
+		return q.Delimit(name)
+	}
+
+	bare := part
+	if q.Folding != FoldNone {
+		bare = strings.ToLower(part)
+	}
+	regular := q.Bare != nil && q.Bare(bare)
+
+	switch q.Policy {
+	case QuoteNever:
+		return part
+	case QuoteAlways:
+		if regular {
+			return q.Delimit(q.Folding.Fold(part))
+		}
+		return q.Delimit(part)
+	}
+	if !regular {
+		return q.Delimit(part)
+	}
+	if q.Reserved != nil && q.Reserved(part) {
+		return q.Delimit(q.Folding.Fold(part))
+	}
+	return part
+}
+
+// isDelimited reports whether part is already wrapped in the delimiters.
+func (q Quoter) isDelimited(part string) bool {
+	return q.Open != "" && len(part) >= len(q.Open)+len(q.Close) &&
+		strings.HasPrefix(part, q.Open) && strings.HasSuffix(part, q.Close)
+}
+
+// SplitQualified splits a qualified identifier on dots that appear outside
+// of open/close delimiters. Doubled closing delimiters inside a delimited
+// part are treated as escapes.
+//
+// Example:
+//
+//	dialect.SplitQualified(`"my.schema".users`, `"`, `"`)
+//	// → ["\"my.schema\"", "users"]
+func SplitQualified(name, open, close string) []string {
+	if open == "" {
+		return strings.Split(name, ".")
+	}
+	var parts []string
+	var sb strings.Builder
+	inside := false
+	for i := 0; i < len(name); i++ {
+		rest := name[i:]
+		switch {
+		case !inside && strings.HasPrefix(rest, open):
+			inside = true
+			sb.WriteString(open)
+			i += len(open) - 1
+		case inside && strings.HasPrefix(rest, close+close):
+			sb.WriteString(close + close)
+			i += 2*len(close) - 1
+		case inside && strings.HasPrefix(rest, close):
+			inside = false
+			sb.WriteString(close)
+			i += len(close) - 1
+		case !inside && name[i] == '.':
+			parts = append(parts, sb.String())
+			sb.Reset()
+		default:
+			sb.WriteByte(name[i])
+		}
+	}
+	return append(parts, sb.String())
+}
@@ -0,0 +1,142 @@
+package dialect_test
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/entiqon/db/dialect"
+)
+
+func TestQuoter(t *testing.T) {
+	bare := regexp.MustCompile(`^[a-z_][a-z0-9_]*$`).MatchString
+
+	t.Run("Policies", func(t *testing.T) {
+		cases := []struct {
+			policy dialect.QuotePolicy
+			in     string
+			want   string
+		}{
+			{dialect.QuoteAsNeeded, "users", "users"},
+			{dialect.QuoteAsNeeded, "UserData", `"UserData"`},
+			{dialect.QuoteAsNeeded, "order", `"order"`},
+			{dialect.QuoteAsNeeded, "sales.Orders", `sales."Orders"`},
+			{dialect.QuoteAsNeeded, "u.*", "u.*"},
+			{dialect.QuoteAsNeeded, `odd"name`, `"odd""name"`},
+			{dialect.QuoteAsNeeded, `"my.schema".users`, `"my.schema".users`},
+			{dialect.QuoteAlways, "sales.orders", `"sales"."orders"`},
+			{dialect.QuoteAlways, `"Sales".orders`, `"Sales"."orders"`},
+			{dialect.QuoteNever, "Sales.Order Items", "Sales.Order Items"},
+			{dialect.QuoteNever, "", ""},
+		}
+		for _, c := range cases {
+			q := dialect.Quoter{Open: `"`, Close: `"`, Policy: c.policy, Bare: bare, Reserved: dialect.IsReserved}
+			if got := q.Quote(c.in); got != c.want {
+				t.Errorf("[%s] Quote(%q) = %q, want %q", c.policy, c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("Styles", func(t *testing.T) {
+		cases := []struct {
+			quoter dialect.Quoter
+			in     string
+			want   string
+		}{
+			{dialect.Quoter{Open: "`", Close: "`", Policy: dialect.QuoteAlways}, "db.odd`name", "`db`.`odd``name`"},
+			{dialect.Quoter{Open: "[", Close: "]", Policy: dialect.QuoteAlways}, "dbo.a]b", "[dbo].[a]]b]"},
+			{dialect.Quoter{Open: "[", Close: "]", Policy: dialect.QuoteAlways}, "[my.db].dbo", "[my.db].[dbo]"},
+			{dialect.Quoter{Open: "`", Close: "`", Backslash: true, Policy: dialect.QuoteAlways}, `odd` + "`" + `na\me`, "`odd\\`na\\\\me`"},
+			{dialect.Quoter{Policy: dialect.QuoteAlways}, "a.b", "a.b"},
+			{dialect.Quoter{Open: `"`, Close: `"`}, "users", `"users"`},
+		}
+		for _, c := range cases {
+			if got := c.quoter.Quote(c.in); got != c.want {
+				t.Errorf("Quote(%q) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("Folding", func(t *testing.T) {
+		cases := []struct {
+			folding dialect.CaseFolding
+			policy  dialect.QuotePolicy
+			in      string
+			want    string
+		}{
+			{dialect.FoldLower, dialect.QuoteAsNeeded, "UserData", "UserData"},
+			{dialect.FoldLower, dialect.QuoteAsNeeded, `"UserData"`, `"UserData"`},
+			{dialect.FoldLower, dialect.QuoteAsNeeded, "Order", `"order"`},
+			{dialect.FoldLower, dialect.QuoteAsNeeded, "Order Items", `"Order Items"`},
+			{dialect.FoldLower, dialect.QuoteAlways, "Sales.UserData", `"sales"."userdata"`},
+			{dialect.FoldUpper, dialect.QuoteAlways, `Sales."UserData"`, `"SALES"."UserData"`},
+			{dialect.FoldUpper, dialect.QuoteAsNeeded, "order", `"ORDER"`},
+			{dialect.FoldNone, dialect.QuoteAsNeeded, "UserData", `"UserData"`},
+		}
+		for _, c := range cases {
+			q := dialect.Quoter{Open: `"`, Close: `"`, Policy: c.policy, Bare: bare, Reserved: dialect.IsReserved, Folding: c.folding}
+			if got := q.Quote(c.in); got != c.want {
+				t.Errorf("[%s/%s] Quote(%q) = %q, want %q", c.folding, c.policy, c.in, got, c.want)
+			}
+		}
+
+		q := dialect.Quoter{Open: "`", Close: "`", Policy: dialect.QuoteAsNeeded, Bare: bare}
+		if got := q.Quote(`"UserData".id`); got != "`UserData`.id" {
+			t.Errorf("expected ANSI quotes to be re-delimited, got %q", got)
+		}
+	})
+
+	t.Run("NewQuoter", func(t *testing.T) {
+		q := dialect.NewQuoter(dialect.Options{QuoteStyle: "["}, bare)
+		if got := q.Quote("dbo.Order Items"); got != "dbo.[Order Items]" {
+			t.Errorf("unexpected %q", got)
+		}
+		q = dialect.NewQuoter(dialect.Options{QuoteStyle: `"`, QuotePolicy: dialect.QuoteAlways}, bare)
+		if got := q.Quote("users"); got != `"users"` {
+			t.Errorf("unexpected %q", got)
+		}
+	})
+
+	t.Run("SplitQualified", func(t *testing.T) {
+		cases := []struct {
+			in   string
+			want []string
+		}{
+			{"a.b.c", []string{"a", "b", "c"}},
+			{`"my.schema".users`, []string{`"my.schema"`, "users"}},
+			{`"a"".b".c`, []string{`"a"".b"`, "c"}},
+			{"users", []string{"users"}},
+		}
+		for _, c := range cases {
+			if got := dialect.SplitQualified(c.in, `"`, `"`); !reflect.DeepEqual(got, c.want) {
+				t.Errorf("SplitQualified(%q) = %q, want %q", c.in, got, c.want)
+			}
+		}
+		if got := dialect.SplitQualified("a.b", "", ""); !reflect.DeepEqual(got, []string{"a", "b"}) {
+			t.Errorf("unexpected %q", got)
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		names := map[dialect.QuotePolicy]string{
+			dialect.QuoteAsNeeded:   "as-needed",
+			dialect.QuoteAlways:     "always",
+			dialect.QuoteNever:      "never",
+			dialect.QuotePolicy(42): "unknown",
+		}
+		for p, want := range names {
+			if got := p.String(); got != want {
+				t.Errorf("String() = %q, want %q", got, want)
+			}
+		}
+	})
+
+	t.Run("IsReserved", func(t *testing.T) {
+		if !dialect.IsReserved("select") || !dialect.IsReserved("ORDER") {
+			t.Error("expected select and ORDER to be reserved")
+		}
+		if dialect.IsReserved("users") {
+			t.Error("expected users not to be reserved")
+		}
+	})
+}
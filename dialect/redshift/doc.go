@@ -0,0 +1,30 @@
+/*
+Package redshift provides the Amazon Redshift SQL dialect implementation.
+
+# Overview
+
+The Redshift dialect renders SQL following Redshift rules, which are
+PostgreSQL-like with notable gaps:
+
+  - Identifiers are folded to lower case, even when quoted, unless
+    enable_case_sensitive_identifier is set. Lowercase simple names are
+    emitted bare; anything else is double-quoted.
+  - String literals double single quotes and escape backslashes.
+  - Placeholders are numbered ($1, $2, ...).
+  - Pagination uses LIMIT n OFFSET m.
+  - QUALIFY is supported; SAMPLE/TABLESAMPLE is not.
+  - MERGE is available, but INSERT ... ON CONFLICT and RETURNING are not.
+
+# Usage
+
+	d := redshift.New()
+	q := d.(dialect.Qualifier)
+	sql := "SELECT id FROM events " + q.QualifySyntax("rn = 1")
+	// SELECT id FROM events QUALIFY rn = 1
+
+# Capabilities
+
+Besides dialect.SQLDialect, the Redshift dialect implements the optional
+dialect.Qualifier interface.
+*/
+package redshift
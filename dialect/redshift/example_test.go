@@ -0,0 +1,24 @@
+package redshift_test
+
+import (
+	"fmt"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/redshift"
+)
+
+func Example() {
+	d := redshift.New()
+	fmt.Println(d.Placeholder(1))
+	fmt.Println(d.Options().EnableReturning)
+	// Output:
+	// $1
+	// false
+}
+
+func Example_qualify() {
+	d := redshift.New().(dialect.Qualifier)
+	fmt.Println("SELECT id FROM events " + d.QualifySyntax("rn = 1"))
+	// Output:
+	// SELECT id FROM events QUALIFY rn = 1
+}
@@ -0,0 +1,245 @@
+package redshift
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+)
+
+//
+// Redshift Dialect
+//
+
+// dialectImpl provides the Amazon Redshift implementation of the
+// dialect.SQLDialect interface. It is unexported to prevent direct
+// instantiation; consumers should always use the New() constructor.
+//
+// Key behaviors:
+//   - Identifiers are folded to lower case by Redshift, even when quoted,
+//     unless enable_case_sensitive_identifier is set. Lowercase simple names
+//     are emitted bare; anything else is double-quoted.
+//   - Placeholders are PostgreSQL-style "$1", "$2", ...
+//   - Pagination uses LIMIT n OFFSET m.
+//   - QUALIFY is supported; SAMPLE/TABLESAMPLE is not.
+//   - MERGE is available, but INSERT ... ON CONFLICT and RETURNING are not.
+type dialectImpl struct {
+	opts dialect.Options
+}
+
+// Compile-time checks: ensure dialectImpl implements SQLDialect and the
+// optional capabilities it advertises.
+var (
+	_ dialect.SQLDialect     = (*dialectImpl)(nil)
+	_ dialect.Qualifier      = (*dialectImpl)(nil)
+	_ dialect.Keyworder      = (*dialectImpl)(nil)
+	_ dialect.FunctionMapper = (*dialectImpl)(nil)
+	_ dialect.BinaryQuoter   = (*dialectImpl)(nil)
+	_ dialect.Savepointer    = (*dialectImpl)(nil)
+)
+
+// bareIdentifier matches identifiers that can be emitted without quotes.
+var bareIdentifier = regexp.MustCompile(`^[a-z_][a-z0-9_$]*$`)
+
+//
+// Constructors
+//
+
+// New returns an Amazon Redshift dialect. The returned value implements the
+// dialect.SQLDialect interface.
+//
+// Example:
+//
+//	d := redshift.New()
+//	d.Placeholder(2) // → "$2"
+func New() dialect.SQLDialect {
+	return &dialectImpl{
+		opts: dialect.Options{
+			Name:                    "redshift",
+			QuoteStyle:              `"`,
+			CaseFolding:             dialect.FoldLower,
+			PlaceholderStyle:        "$%d",
+			AllowMerge:              true,
+			AllowUpsert:             false,
+			ForcedAliasing:          true,
+			EnableReturning:         false,
+			SupportsCTE:             true,
+			SupportsWindowFunctions: true,
+			SupportsNullsOrdering:   true,
+			SupportsILike:           true,
+			SupportsArrayBinding:    false,
+			SupportsValuesList:      false,
+			MaxPlaceholderIndex:     32767,
+			MaxIdentifierLength:     127,
+		},
+	}
+}
+
+// NewWithOptions creates a Redshift dialect with the given static options.
+// Options are immutable once the dialect is constructed.
+func NewWithOptions(opts dialect.Options) dialect.SQLDialect {
+	return &dialectImpl{opts: opts}
+}
+
+//
+// Interface Implementation
+//
+
+// Name returns the identifier of this dialect, "redshift" by default.
+func (d *dialectImpl) Name() string {
+	return d.opts.Name
+}
+
+// Options returns the feature capability matrix for the Redshift dialect.
+func (d *dialectImpl) Options() dialect.Options {
+	return d.opts
+}
+
+// QuoteIdentifier returns a Redshift identifier. Lowercase simple names are
+// returned as-is; anything else is wrapped in double quotes, with embedded
+// double quotes escaped by doubling them.
+//
+// Schema-qualified names are quoted part by part and reserved words
+// are quoted, subject to Options().QuotePolicy.
+//
+// Example:
+//
+//	d.QuoteIdentifier("users")     // → users
+//	d.QuoteIdentifier("user data") // → "user data"
+func (d *dialectImpl) QuoteIdentifier(name string) string {
+	q := dialect.NewQuoter(d.opts, bareIdentifier.MatchString)
+	q.Reserved = keywords.IsReserved
+	return q.Quote(name)
+}
+
+// Keywords returns the Redshift keyword set, which reserves encoding and
+// COPY words such as BYTEDICT, CREDENTIALS and WALLET.
+func (d *dialectImpl) Keywords() dialect.Keywords {
+	return keywords
+}
+
+// Functions returns the Redshift spellings of canonical functions:
+// GETDATE() for the current timestamp and DATE_TRUNC with a unit string.
+func (d *dialectImpl) Functions() dialect.FunctionMap {
+	return dialect.FunctionMap{
+		dialect.FuncNow: dialect.Call("GETDATE"),
+		dialect.FuncDateTrunc: func(args []string) (string, error) {
+			return fmt.Sprintf("DATE_TRUNC('%s', %s)", args[0], args[1]), nil
+		},
+	}
+}
+
+// QuoteLiteral quotes a literal value for inline use in Redshift SQL.
+//
+// Supported types:
+//   - nil       → NULL
+//   - string    → wrapped in single quotes, with quotes doubled and
+//     backslashes escaped (Redshift treats backslash as an escape)
+//   - bool      → TRUE or FALSE
+//   - numbers   → rendered in decimal form
+//   - time.Time → TIMESTAMP 'YYYY-MM-DD HH:MM:SS' in UTC
+//   - []byte    → FROM_HEX('..')
+//   - others    → dialect.MustLiteral
+func (d *dialectImpl) QuoteLiteral(literal any) string {
+	switch v := literal.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return quoteString(v)
+	case bool:
+		if v {
+			return "TRUE"
+		}
+		return "FALSE"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 64)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []byte:
+		return d.QuoteBinary(v)
+	case time.Time:
+		return dialect.QuoteTime(d, v)
+	default:
+		return dialect.MustLiteral(d, v)
+	}
+}
+
+// QuoteBinary renders data as a VARBYTE decoded from hexadecimal.
+//
+// Example:
+//
+//	d.QuoteBinary([]byte("hi")) // → FROM_HEX('6869')
+func (d *dialectImpl) QuoteBinary(data []byte) string {
+	return "FROM_HEX('" + hex.EncodeToString(data) + "')"
+}
+
+// PaginationSyntax renders the Redshift LIMIT/OFFSET clause.
+//
+// Example:
+//
+//	d.PaginationSyntax(10, 20) // → " LIMIT 10 OFFSET 20"
+//	d.PaginationSyntax(0, 20)  // → " OFFSET 20"
+func (d *dialectImpl) PaginationSyntax(limit, offset int) string {
+	var sb strings.Builder
+	if limit > 0 {
+		sb.WriteString(fmt.Sprintf(" LIMIT %d", limit))
+	}
+	if offset > 0 {
+		sb.WriteString(fmt.Sprintf(" OFFSET %d", offset))
+	}
+	return sb.String()
+}
+
+// Placeholder returns the numbered placeholder for the given index, in
+// Options().PlaceholderStyle, or "$%d" when the style has no %d verb, as
+// with options built without one.
+//
+// Example:
+//
+//	d.Placeholder(1) // → "$1"
+func (d *dialectImpl) Placeholder(index int) string {
+	style := d.opts.PlaceholderStyle
+	if !strings.Contains(style, "%d") {
+		style = "$%d"
+	}
+	return fmt.Sprintf(style, index)
+}
+
+// QualifySyntax renders the QUALIFY clause.
+//
+// Example:
+//
+//	d.QualifySyntax("rn = 1") // → QUALIFY rn = 1
+func (d *dialectImpl) QualifySyntax(condition string) string {
+	if condition == "" {
+		return ""
+	}
+	return "QUALIFY " + condition
+}
+
+// quoteString wraps s in single quotes, escaping backslashes and doubling
+// single quotes.
+func quoteString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `''`).Replace(s) + "'"
+}
+
+// SavepointSyntax returns an empty string: Redshift has no savepoints.
+func (d *dialectImpl) SavepointSyntax(string) string {
+	return ""
+}
+
+// ReleaseSavepointSyntax returns an empty string: Redshift has no savepoints.
+func (d *dialectImpl) ReleaseSavepointSyntax(string) string {
+	return ""
+}
+
+// RollbackToSavepointSyntax returns an empty string: Redshift has no savepoints.
+func (d *dialectImpl) RollbackToSavepointSyntax(string) string {
+	return ""
+}
@@ -0,0 +1,113 @@
+package redshift_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/redshift"
+)
+
+func TestRedshiftDialect(t *testing.T) {
+	d := redshift.New()
+
+	t.Run("Name", func(t *testing.T) {
+		if got, want := d.Name(), "redshift"; got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+		if got := redshift.NewWithOptions(dialect.Options{Name: "rs", PlaceholderStyle: "$%d"}).Name(); got != "rs" {
+			t.Errorf("expected %q, got %q", "rs", got)
+		}
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		opts := d.Options()
+		if !opts.AllowMerge {
+			t.Error("expected AllowMerge=true")
+		}
+		if opts.AllowUpsert || opts.EnableReturning {
+			t.Error("expected UPSERT and RETURNING to be disabled")
+		}
+		if opts.MaxIdentifierLength != 127 {
+			t.Errorf("unexpected MaxIdentifierLength = %d", opts.MaxIdentifierLength)
+		}
+		if _, ok := d.(dialect.Sampler); ok {
+			t.Error("expected Redshift not to implement dialect.Sampler")
+		}
+	})
+
+	t.Run("QuoteIdentifier", func(t *testing.T) {
+		cases := []struct {
+			in   string
+			want string
+		}{
+			{"users", "users"},
+			{"UserData", "UserData"},
+			{`"UserData"`, `"UserData"`},
+			{"user data", `"user data"`},
+			{`odd"name`, `"odd""name"`},
+			{"", ""},
+		}
+		for _, c := range cases {
+			if got := d.QuoteIdentifier(c.in); got != c.want {
+				t.Errorf("QuoteIdentifier(%q) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("QuoteLiteral", func(t *testing.T) {
+		now := time.Date(2025, 9, 19, 3, 30, 0, 0, time.UTC)
+		cases := []struct {
+			in   any
+			want string
+		}{
+			{nil, "NULL"},
+			{"O'Reilly", "'O''Reilly'"},
+			{`C:\tmp`, `'C:\\tmp'`},
+			{true, "TRUE"},
+			{false, "FALSE"},
+			{uint64(5), "5"},
+			{float32(2.5), "2.5"},
+			{0.25, "0.25"},
+			{now, "TIMESTAMP '2025-09-19 03:30:00'"},
+			{now.Add(500 * time.Millisecond), "TIMESTAMP '2025-09-19 03:30:00.5'"},
+			{[]byte("hi"), "FROM_HEX('6869')"},
+		}
+		for _, c := range cases {
+			if got := d.QuoteLiteral(c.in); got != c.want {
+				t.Errorf("QuoteLiteral(%v) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("Placeholder", func(t *testing.T) {
+		if got := d.Placeholder(3); got != "$3" {
+			t.Errorf("Placeholder(3) = %q, want '$3'", got)
+		}
+		if got := redshift.NewWithOptions(dialect.Options{Name: "redshift"}).Placeholder(3); got != "$3" {
+			t.Errorf("expected $3 without a PlaceholderStyle, got %q", got)
+		}
+	})
+
+	t.Run("Golden", func(t *testing.T) {
+		q := d.(dialect.Qualifier)
+		cases := []struct {
+			name string
+			got  string
+			want string
+		}{
+			{"paginate", dialect.Paginate(d, "SELECT id FROM users", 10, 20),
+				"SELECT id FROM users LIMIT 10 OFFSET 20"},
+			{"paginate offset", dialect.Paginate(d, "SELECT id FROM users", 0, 20),
+				"SELECT id FROM users OFFSET 20"},
+			{"qualify", "SELECT id FROM events " + q.QualifySyntax("rn = 1"),
+				"SELECT id FROM events QUALIFY rn = 1"},
+			{"qualify empty", q.QualifySyntax(""), ""},
+		}
+		for _, c := range cases {
+			if c.got != c.want {
+				t.Errorf("%s:\n got %q\nwant %q", c.name, c.got, c.want)
+			}
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package dialect
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNoSavepoints is returned by Savepoint for dialects without savepoint
+// support, such as the warehouse engines.
+var ErrNoSavepoints = errors.New("savepoints are not supported")
+
+// Savepointer is implemented by dialects whose savepoint statements differ
+// from the SQL standard SAVEPOINT / RELEASE SAVEPOINT / ROLLBACK TO
+// SAVEPOINT forms: Oracle has no RELEASE, Db2 requires ON ROLLBACK RETAIN
+// CURSORS, and SQL Server uses SAVE TRANSACTION and ROLLBACK TRANSACTION.
+// Builders and executors should call the package-level Savepoint,
+// ReleaseSavepoint and RollbackToSavepoint helpers.
+type Savepointer interface {
+	// SavepointSyntax renders the statement setting savepoint name. It
+	// returns an empty string when the dialect has no savepoints.
+	SavepointSyntax(name string) string
+
+	// ReleaseSavepointSyntax renders the statement releasing savepoint
+	// name. It returns an empty string when savepoints cannot be released
+	// and simply end with the transaction.
+	ReleaseSavepointSyntax(name string) string
+
+	// RollbackToSavepointSyntax renders the statement rolling back to
+	// savepoint name.
+	RollbackToSavepointSyntax(name string) string
+}
+
+// Savepoint renders the statement setting savepoint name for d, or the
+// standard SAVEPOINT form when d does not implement Savepointer. It fails
+// with ErrNoSavepoints when d has no savepoints.
+//
+// Example:
+//
+//	dialect.Savepoint(db2.New(), "sp_1")
+//	// SAVEPOINT sp_1 ON ROLLBACK RETAIN CURSORS
+func Savepoint(d SQLDialect, name string) (string, error) {
+	if s, ok := d.(Savepointer); ok {
+		sql := s.SavepointSyntax(name)
+		if sql == "" {
+			return "", fmt.Errorf("%w by %s", ErrNoSavepoints, d.Name())
+		}
+		return sql, nil
+	}
+	return "SAVEPOINT " + name, nil
+}
+
+// ReleaseSavepoint renders the statement releasing savepoint name for d.
+// It returns an empty string when there is nothing to run.
+func ReleaseSavepoint(d SQLDialect, name string) string {
+	if s, ok := d.(Savepointer); ok {
+		return s.ReleaseSavepointSyntax(name)
+	}
+	return "RELEASE SAVEPOINT " + name
+}
+
+// RollbackToSavepoint renders the statement rolling back to savepoint name
+// for d.
+func RollbackToSavepoint(d SQLDialect, name string) string {
+	if s, ok := d.(Savepointer); ok {
+		return s.RollbackToSavepointSyntax(name)
+	}
+	return "ROLLBACK TO SAVEPOINT " + name
+}
@@ -0,0 +1,43 @@
+package dialect_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/db2"
+	"github.com/entiqon/db/dialect/generic"
+	"github.com/entiqon/db/dialect/mssql"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/dialect/snowflake"
+)
+
+func TestSavepoints(t *testing.T) {
+	cases := []struct {
+		d                  dialect.SQLDialect
+		set, release, undo string
+	}{
+		{generic.New(), "SAVEPOINT sp_1", "RELEASE SAVEPOINT sp_1", "ROLLBACK TO SAVEPOINT sp_1"},
+		{nil, "SAVEPOINT sp_1", "RELEASE SAVEPOINT sp_1", "ROLLBACK TO SAVEPOINT sp_1"},
+		{oracle.New(), "SAVEPOINT sp_1", "", "ROLLBACK TO SAVEPOINT sp_1"},
+		{db2.New(), "SAVEPOINT sp_1 ON ROLLBACK RETAIN CURSORS", "RELEASE SAVEPOINT sp_1", "ROLLBACK TO SAVEPOINT sp_1"},
+		{mssql.New(), "SAVE TRANSACTION sp_1", "", "ROLLBACK TRANSACTION sp_1"},
+	}
+	for _, c := range cases {
+		set, err := dialect.Savepoint(c.d, "sp_1")
+		if err != nil || set != c.set {
+			t.Errorf("Savepoint = %q, %v; want %q", set, err, c.set)
+		}
+		if got := dialect.ReleaseSavepoint(c.d, "sp_1"); got != c.release {
+			t.Errorf("ReleaseSavepoint = %q, want %q", got, c.release)
+		}
+		if got := dialect.RollbackToSavepoint(c.d, "sp_1"); got != c.undo {
+			t.Errorf("RollbackToSavepoint = %q, want %q", got, c.undo)
+		}
+	}
+
+	_, err := dialect.Savepoint(snowflake.New(), "sp_1")
+	if !errors.Is(err, dialect.ErrNoSavepoints) {
+		t.Errorf("expected ErrNoSavepoints, got %v", err)
+	}
+}
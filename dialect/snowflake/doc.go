@@ -0,0 +1,31 @@
+/*
+Package snowflake provides the Snowflake SQL dialect implementation.
+
+# Overview
+
+The Snowflake dialect renders SQL following Snowflake rules:
+
+  - Unquoted identifiers are folded to upper case by the server. Names
+    written entirely in lower or upper case are emitted bare and resolve
+    case-insensitively; mixed-case names are double-quoted to preserve
+    their case.
+  - String literals double single quotes and escape backslashes.
+  - Placeholders are unnumbered parameter markers (?).
+  - Pagination uses LIMIT n OFFSET m, with LIMIT NULL for offset-only.
+  - QUALIFY and SAMPLE are supported.
+  - Upserts are rendered as MERGE; RETURNING is not available.
+
+# Usage
+
+	d := snowflake.New()
+	d.QuoteIdentifier("users")      // users (resolved as USERS)
+	d.QuoteIdentifier("UserData")   // UserData (resolved as USERDATA)
+	d.QuoteIdentifier(`"UserData"`) // "UserData"
+
+# Capabilities
+
+Besides dialect.SQLDialect, the Snowflake dialect implements the optional
+capability interfaces Qualifier, Sampler and Merger declared in the
+dialect package.
+*/
+package snowflake
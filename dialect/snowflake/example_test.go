@@ -0,0 +1,33 @@
+package snowflake_test
+
+import (
+	"fmt"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/snowflake"
+)
+
+func Example() {
+	d := snowflake.New()
+	fmt.Println(d.QuoteIdentifier("users"))
+	fmt.Println(d.QuoteIdentifier("UserData"))
+	fmt.Println(d.QuoteIdentifier(`"UserData"`))
+	// Output:
+	// users
+	// UserData
+	// "UserData"
+}
+
+func Example_qualify() {
+	d := snowflake.New().(dialect.Qualifier)
+	fmt.Println("SELECT id FROM events " + d.QualifySyntax("ROW_NUMBER() OVER (PARTITION BY uid ORDER BY ts DESC) = 1"))
+	// Output:
+	// SELECT id FROM events QUALIFY ROW_NUMBER() OVER (PARTITION BY uid ORDER BY ts DESC) = 1
+}
+
+func Example_sample() {
+	d := snowflake.New().(dialect.Sampler)
+	fmt.Println("SELECT * FROM events " + d.SampleSyntax(10))
+	// Output:
+	// SELECT * FROM events SAMPLE (10)
+}
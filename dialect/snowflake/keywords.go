@@ -0,0 +1,30 @@
+package snowflake
+
+import "github.com/entiqon/db/dialect"
+
+// keywords holds the Snowflake reserved keywords, plus ANSI reserved words
+// that Snowflake also rejects as bare identifiers, and its limited
+// keywords, which are reserved only in some contexts.
+var keywords = dialect.NewKeywords(
+	[]string{
+		"ACCOUNT", "ALL", "ALTER", "AND", "ANY", "AS", "BETWEEN", "BY",
+		"CASE", "CAST", "CHECK", "COLUMN", "CONNECT", "CONNECTION",
+		"CONSTRAINT", "CREATE", "CROSS", "CURRENT", "CURRENT_DATE",
+		"CURRENT_TIME", "CURRENT_TIMESTAMP", "CURRENT_USER", "DATABASE",
+		"DELETE", "DISTINCT", "DROP", "ELSE", "EXISTS", "FALSE", "FOLLOWING",
+		"FOR", "FROM", "FULL", "GRANT", "GROUP", "GSCLUSTER", "HAVING",
+		"ILIKE", "IN", "INCREMENT", "INNER", "INSERT", "INTERSECT", "INTO",
+		"IS", "ISSUE", "JOIN", "LATERAL", "LEFT", "LIKE", "LOCALTIME",
+		"LOCALTIMESTAMP", "MINUS", "NATURAL", "NOT", "NULL", "OF", "ON", "OR",
+		"ORDER", "ORGANIZATION", "QUALIFY", "REGEXP", "REVOKE", "RIGHT",
+		"RLIKE", "ROW", "ROWS", "SAMPLE", "SCHEMA", "SELECT", "SET", "SOME",
+		"START", "TABLE", "TABLESAMPLE", "THEN", "TO", "TRIGGER", "TRUE",
+		"TRY_CAST", "UNION", "UNIQUE", "UPDATE", "USING", "VALUES", "VIEW",
+		"WHEN", "WHENEVER", "WHERE", "WITH",
+	},
+	[]string{
+		"ASC", "ASOF", "CHANGES", "DESC", "FIRST", "LAST", "LEVEL", "LIMIT",
+		"MATCH_CONDITION", "MATCH_RECOGNIZE", "NAME", "OFFSET", "PIVOT",
+		"REGION", "SIZE", "TOP", "UNPIVOT", "WINDOW",
+	},
+)
@@ -0,0 +1,297 @@
+package snowflake
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+	dberrors "github.com/entiqon/db/errors"
+)
+
+//
+// Snowflake Dialect
+//
+
+// dialectImpl provides the Snowflake implementation of the dialect.SQLDialect
+// interface. It is unexported to prevent direct instantiation; consumers should
+// always use the New() constructor.
+//
+// Key behaviors:
+//   - Unquoted identifiers are folded to upper case by Snowflake. Simple
+//     names written in a single case are emitted bare; mixed-case names are
+//     double-quoted to preserve their case.
+//   - Placeholders are unnumbered parameter markers "?".
+//   - Pagination uses LIMIT n OFFSET m (LIMIT NULL for offset-only).
+//   - QUALIFY and SAMPLE are supported.
+//   - Upserts are rendered as MERGE; RETURNING is not supported.
+type dialectImpl struct {
+	opts dialect.Options
+}
+
+// Compile-time checks: ensure dialectImpl implements SQLDialect and the
+// optional capabilities it advertises.
+var (
+	_ dialect.SQLDialect      = (*dialectImpl)(nil)
+	_ dialect.Qualifier       = (*dialectImpl)(nil)
+	_ dialect.Sampler         = (*dialectImpl)(nil)
+	_ dialect.Merger          = (*dialectImpl)(nil)
+	_ dialect.Keyworder       = (*dialectImpl)(nil)
+	_ dialect.FunctionMapper  = (*dialectImpl)(nil)
+	_ dialect.BinaryQuoter    = (*dialectImpl)(nil)
+	_ dialect.TimeQuoter      = (*dialectImpl)(nil)
+	_ dialect.Savepointer     = (*dialectImpl)(nil)
+	_ dialect.ErrorClassifier = (*dialectImpl)(nil)
+)
+
+// bareIdentifier matches identifiers that can be emitted without quotes:
+// all lower case or all upper case, so that folding does not lose
+// information the caller expressed.
+var bareIdentifier = regexp.MustCompile(`^([a-z_][a-z0-9_$]*|[A-Z_][A-Z0-9_$]*)$`)
+
+//
+// Constructors
+//
+
+// New returns a Snowflake dialect. The returned value implements the
+// dialect.SQLDialect interface.
+//
+// Example:
+//
+//	d := snowflake.New()
+//	d.QuoteIdentifier("users")    // → users (resolved as USERS)
+//	d.QuoteIdentifier("UserData") // → "UserData"
+func New() dialect.SQLDialect {
+	return &dialectImpl{
+		opts: dialect.Options{
+			Name:                    "snowflake",
+			QuoteStyle:              `"`,
+			CaseFolding:             dialect.FoldUpper,
+			PlaceholderStyle:        "?",
+			AllowMerge:              true,
+			AllowUpsert:             false,
+			ForcedAliasing:          true,
+			EnableReturning:         false,
+			SupportsCTE:             true,
+			SupportsWindowFunctions: true,
+			SupportsNullsOrdering:   true,
+			SupportsILike:           true,
+			SupportsArrayBinding:    false,
+			SupportsValuesList:      true,
+			MaxPlaceholderIndex:     0,
+			MaxIdentifierLength:     255,
+		},
+	}
+}
+
+// NewWithOptions creates a Snowflake dialect with the given static options.
+// Options are immutable once the dialect is constructed.
+func NewWithOptions(opts dialect.Options) dialect.SQLDialect {
+	return &dialectImpl{opts: opts}
+}
+
+//
+// Interface Implementation
+//
+
+// Name returns the identifier of this dialect, "snowflake" by default.
+func (d *dialectImpl) Name() string {
+	return d.opts.Name
+}
+
+// Options returns the feature capability matrix for the Snowflake dialect.
+func (d *dialectImpl) Options() dialect.Options {
+	return d.opts
+}
+
+// QuoteIdentifier returns a Snowflake identifier. Snowflake folds unquoted
+// identifiers to upper case, so single-case simple names are returned as-is
+// and resolve case-insensitively. Mixed-case names and names with other
+// characters are wrapped in double quotes, with embedded double quotes
+// escaped by doubling them; quoted identifiers are case-sensitive.
+//
+// Database- and schema-qualified names are quoted part by part, and
+// reserved words are quoted, subject to Options().QuotePolicy.
+//
+// Example:
+//
+//	d.QuoteIdentifier("users")    // → users
+//	d.QuoteIdentifier("USERS")    // → USERS
+//	d.QuoteIdentifier("UserData") // → "UserData"
+func (d *dialectImpl) QuoteIdentifier(name string) string {
+	q := dialect.NewQuoter(d.opts, bareIdentifier.MatchString)
+	q.Reserved = keywords.IsReserved
+	return q.Quote(name)
+}
+
+// Keywords returns the Snowflake keyword set, listing reserved keywords
+// such as QUALIFY and SAMPLE and limited keywords as non-reserved.
+func (d *dialectImpl) Keywords() dialect.Keywords {
+	return keywords
+}
+
+// Functions returns the Snowflake spellings of canonical functions:
+// SUBSTR, LENGTH and DATE_TRUNC with an uppercase unit string.
+func (d *dialectImpl) Functions() dialect.FunctionMap {
+	return dialect.FunctionMap{
+		dialect.FuncSubstring: dialect.Call("SUBSTR"),
+		dialect.FuncLength:    dialect.Call("LENGTH"),
+		dialect.FuncDateTrunc: func(args []string) (string, error) {
+			return fmt.Sprintf("DATE_TRUNC('%s', %s)", strings.ToUpper(args[0]), args[1]), nil
+		},
+	}
+}
+
+// QuoteLiteral quotes a literal value for inline use in Snowflake SQL.
+//
+// Supported types:
+//   - nil       → NULL
+//   - string    → wrapped in single quotes, with quotes doubled and
+//     backslashes escaped (Snowflake treats backslash as an escape)
+//   - bool      → TRUE or FALSE
+//   - numbers   → rendered in decimal form
+//   - time.Time → 'YYYY-MM-DD HH:MM:SS'::TIMESTAMP_NTZ in UTC
+//   - []byte    → TO_BINARY('..', 'HEX')
+//   - others    → dialect.MustLiteral
+func (d *dialectImpl) QuoteLiteral(literal any) string {
+	switch v := literal.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return quoteString(v)
+	case bool:
+		if v {
+			return "TRUE"
+		}
+		return "FALSE"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 64)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []byte:
+		return d.QuoteBinary(v)
+	case time.Time:
+		return dialect.QuoteTime(d, v)
+	default:
+		return dialect.MustLiteral(d, v)
+	}
+}
+
+// QuoteBinary renders data as a BINARY value decoded from hexadecimal.
+//
+// Example:
+//
+//	d.QuoteBinary([]byte("hi")) // → TO_BINARY('6869', 'HEX')
+func (d *dialectImpl) QuoteBinary(data []byte) string {
+	return "TO_BINARY('" + strings.ToUpper(hex.EncodeToString(data)) + "', 'HEX')"
+}
+
+// QuoteTime renders t as a TIMESTAMP_NTZ in UTC.
+//
+// Example:
+//
+//	d.QuoteTime(t) // → '2025-09-19 03:30:00'::TIMESTAMP_NTZ
+func (d *dialectImpl) QuoteTime(t time.Time) string {
+	return fmt.Sprintf("'%s'::TIMESTAMP_NTZ", t.UTC().Format(dialect.TimestampLayout))
+}
+
+// PaginationSyntax renders the Snowflake LIMIT/OFFSET clause. OFFSET is
+// only valid after LIMIT, so offset-only pagination uses LIMIT NULL.
+//
+// Example:
+//
+//	d.PaginationSyntax(10, 20) // → " LIMIT 10 OFFSET 20"
+//	d.PaginationSyntax(0, 20)  // → " LIMIT NULL OFFSET 20"
+func (d *dialectImpl) PaginationSyntax(limit, offset int) string {
+	switch {
+	case limit > 0 && offset > 0:
+		return fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+	case limit > 0:
+		return fmt.Sprintf(" LIMIT %d", limit)
+	case offset > 0:
+		return fmt.Sprintf(" LIMIT NULL OFFSET %d", offset)
+	default:
+		return ""
+	}
+}
+
+// Placeholder always returns "?" for Snowflake. The index parameter is
+// ignored since parameter markers are not numbered.
+func (d *dialectImpl) Placeholder(_ int) string {
+	return d.opts.PlaceholderStyle
+}
+
+// QualifySyntax renders the QUALIFY clause.
+//
+// Example:
+//
+//	d.QualifySyntax("rn = 1") // → QUALIFY rn = 1
+func (d *dialectImpl) QualifySyntax(condition string) string {
+	if condition == "" {
+		return ""
+	}
+	return "QUALIFY " + condition
+}
+
+// SampleSyntax renders the Bernoulli (row-based) SAMPLE clause.
+//
+// Example:
+//
+//	d.SampleSyntax(10) // → SAMPLE (10)
+func (d *dialectImpl) SampleSyntax(percent float64) string {
+	if percent <= 0 || percent > 100 {
+		return ""
+	}
+	return "SAMPLE (" + strconv.FormatFloat(percent, 'f', -1, 64) + ")"
+}
+
+// MergeSyntax renders an upsert as a MERGE statement reading a single row
+// of parameter markers from an inline SELECT.
+//
+// Example:
+//
+//	d.MergeSyntax("users", []string{"id", "name"}, []string{"id"})
+//	// MERGE INTO users tgt USING (SELECT ? AS id, ? AS name) src
+//	//   ON (tgt.id = src.id)
+//	//   WHEN MATCHED THEN UPDATE SET tgt.name = src.name
+//	//   WHEN NOT MATCHED THEN INSERT (id, name) VALUES (src.id, src.name)
+func (d *dialectImpl) MergeSyntax(table string, columns, keys []string) string {
+	return dialect.Merge(d, table, columns, keys, dialect.MergeStyle{Source: dialect.MergeSelect("")})
+}
+
+// quoteString wraps s in single quotes, escaping backslashes and doubling
+// single quotes.
+func quoteString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `''`).Replace(s) + "'"
+}
+
+// SavepointSyntax returns an empty string: Snowflake has no savepoints.
+func (d *dialectImpl) SavepointSyntax(string) string {
+	return ""
+}
+
+// ReleaseSavepointSyntax returns an empty string: Snowflake has no savepoints.
+func (d *dialectImpl) ReleaseSavepointSyntax(string) string {
+	return ""
+}
+
+// RollbackToSavepointSyntax returns an empty string: Snowflake has no savepoints.
+func (d *dialectImpl) RollbackToSavepointSyntax(string) string {
+	return ""
+}
+
+// ErrorCodes returns the Snowflake error numbers. Snowflake does not
+// enforce unique or foreign key constraints.
+func (d *dialectImpl) ErrorCodes() dialect.ErrorCodes {
+	return dialect.ErrorCodes{
+		"100072": dberrors.ErrNotNullViolation, // NULL in non-nullable column
+		"625":    dberrors.ErrLockTimeout,      // lock wait timeout
+		"604":    dberrors.ErrQueryCanceled,    // SQL execution canceled
+		"630":    dberrors.ErrQueryCanceled,    // statement timeout
+	}
+}
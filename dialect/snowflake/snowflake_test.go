@@ -0,0 +1,126 @@
+package snowflake_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/snowflake"
+)
+
+func TestSnowflakeDialect(t *testing.T) {
+	d := snowflake.New()
+
+	t.Run("Name", func(t *testing.T) {
+		if got, want := d.Name(), "snowflake"; got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+		if got := snowflake.NewWithOptions(dialect.Options{Name: "sf"}).Name(); got != "sf" {
+			t.Errorf("expected %q, got %q", "sf", got)
+		}
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		opts := d.Options()
+		if !opts.AllowMerge {
+			t.Error("expected AllowMerge=true")
+		}
+		if opts.AllowUpsert || opts.EnableReturning {
+			t.Error("expected UPSERT and RETURNING to be disabled")
+		}
+		if opts.MaxIdentifierLength != 255 {
+			t.Errorf("unexpected MaxIdentifierLength = %d", opts.MaxIdentifierLength)
+		}
+	})
+
+	t.Run("QuoteIdentifier", func(t *testing.T) {
+		cases := []struct {
+			in   string
+			want string
+		}{
+			{"users", "users"},
+			{"USERS", "USERS"},
+			{"ORDER_ID", "ORDER_ID"},
+			{"UserData", "UserData"},
+			{`"UserData"`, `"UserData"`},
+			{"user data", `"user data"`},
+			{`odd"name`, `"odd""name"`},
+			{"", ""},
+		}
+		for _, c := range cases {
+			if got := d.QuoteIdentifier(c.in); got != c.want {
+				t.Errorf("QuoteIdentifier(%q) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("QuoteLiteral", func(t *testing.T) {
+		now := time.Date(2025, 9, 19, 3, 30, 0, 0, time.UTC)
+		cases := []struct {
+			in   any
+			want string
+		}{
+			{nil, "NULL"},
+			{"O'Reilly", "'O''Reilly'"},
+			{`C:\tmp`, `'C:\\tmp'`},
+			{true, "TRUE"},
+			{false, "FALSE"},
+			{int16(-3), "-3"},
+			{float32(2.5), "2.5"},
+			{0.25, "0.25"},
+			{now, "'2025-09-19 03:30:00'::TIMESTAMP_NTZ"},
+			{now.Add(500 * time.Millisecond), "'2025-09-19 03:30:00.5'::TIMESTAMP_NTZ"},
+			{[]byte("hi"), "TO_BINARY('6869', 'HEX')"},
+		}
+		for _, c := range cases {
+			if got := d.QuoteLiteral(c.in); got != c.want {
+				t.Errorf("QuoteLiteral(%v) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("Placeholder", func(t *testing.T) {
+		if got := d.Placeholder(3); got != "?" {
+			t.Errorf("Placeholder(3) = %q, want '?'", got)
+		}
+	})
+
+	t.Run("Golden", func(t *testing.T) {
+		q := d.(dialect.Qualifier)
+		s := d.(dialect.Sampler)
+		m := d.(dialect.Merger)
+		cases := []struct {
+			name string
+			got  string
+			want string
+		}{
+			{"paginate", dialect.Paginate(d, "SELECT id FROM users", 10, 20),
+				"SELECT id FROM users LIMIT 10 OFFSET 20"},
+			{"paginate limit", dialect.Paginate(d, "SELECT id FROM users", 10, 0),
+				"SELECT id FROM users LIMIT 10"},
+			{"paginate offset", dialect.Paginate(d, "SELECT id FROM users", 0, 20),
+				"SELECT id FROM users LIMIT NULL OFFSET 20"},
+			{"paginate none", dialect.Paginate(d, "SELECT id FROM users", 0, 0),
+				"SELECT id FROM users"},
+			{"qualify", "SELECT id FROM events " + q.QualifySyntax("ROW_NUMBER() OVER (PARTITION BY uid ORDER BY ts DESC) = 1"),
+				"SELECT id FROM events QUALIFY ROW_NUMBER() OVER (PARTITION BY uid ORDER BY ts DESC) = 1"},
+			{"qualify empty", q.QualifySyntax(""), ""},
+			{"sample", "SELECT * FROM events " + s.SampleSyntax(12.5),
+				"SELECT * FROM events SAMPLE (12.5)"},
+			{"sample out of range", s.SampleSyntax(-1), ""},
+			{"merge", m.MergeSyntax("users", []string{"id", "name"}, []string{"id"}),
+				"MERGE INTO users tgt USING (SELECT ? AS id, ? AS name) src ON (tgt.id = src.id)" +
+					" WHEN MATCHED THEN UPDATE SET tgt.name = src.name" +
+					" WHEN NOT MATCHED THEN INSERT (id, name) VALUES (src.id, src.name)"},
+			{"merge keys only", m.MergeSyntax("tags", []string{"id"}, []string{"id"}),
+				"MERGE INTO tags tgt USING (SELECT ? AS id) src ON (tgt.id = src.id)" +
+					" WHEN NOT MATCHED THEN INSERT (id) VALUES (src.id)"},
+			{"merge invalid", m.MergeSyntax("users", []string{"id"}, nil), ""},
+		}
+		for _, c := range cases {
+			if c.got != c.want {
+				t.Errorf("%s:\n got %q\nwant %q", c.name, c.got, c.want)
+			}
+		}
+	})
+}
@@ -20,7 +20,9 @@ type SQLDialect interface {
 	QuoteIdentifier(name string) string
 
 	// QuoteLiteral safely quotes a literal value (string, number, bool, time, etc.)
-	// for inclusion in SQL statements, escaping where necessary.
+	// for inclusion in SQL statements, escaping where necessary. Values
+	// without a safe literal form are refused with a panic wrapping
+	// ErrUnsupportedLiteral (see MustLiteral); Literal returns the error.
 	QuoteLiteral(literal any) string
 
 	// PaginationSyntax generates the SQL syntax for limiting
@@ -42,14 +44,18 @@ func (d *BaseDialect) Name() string {
 }
 
 // QuoteIdentifier returns the given identifier quoted with double quotes,
-// suitable for most SQL databases.
+// suitable for most SQL databases. Qualified names are quoted part by part
+// and embedded double quotes are doubled.
 func (d *BaseDialect) QuoteIdentifier(name string) string {
-	return `"` + name + `"`
+	return Quoter{Open: `"`, Close: `"`, Policy: QuoteAlways}.Quote(name)
 }
 
 // QuoteLiteral quotes and escapes a literal value according to its type.
 // Strings are escaped to double single-quotes, numeric types are formatted plainly,
 // booleans converted to "true"/"false", nil to NULL, and time.Time formatted as timestamp.
+//
+// BaseDialect is not an SQLDialect and Literal never calls it; other values
+// are printed in their fmt form, for display only.
 func (d *BaseDialect) QuoteLiteral(value any) string {
 	switch v := value.(type) {
 	case string:
@@ -0,0 +1,30 @@
+/*
+Package sqlite provides the SQLite SQL dialect implementation.
+
+# Overview
+
+The SQLite dialect renders SQL following SQLite rules:
+
+  - Identifiers are double-quoted when needed; names are not folded.
+  - Placeholders are "?", bound in order. The 999 parameter limit of
+    SQLite before 3.32 applies by default; use NewWithOptions to raise
+    MaxPlaceholderIndex for newer builds.
+  - Pagination uses LIMIT n OFFSET m, with LIMIT -1 for offset-only
+    pagination.
+  - Booleans are 1 / 0 and timestamps are text in UTC.
+  - Upserts use INSERT ... ON CONFLICT (3.24+); RETURNING is supported
+    (3.35+).
+
+# Usage
+
+	d := sqlite.New()
+	sql := dialect.Paginate(d, "SELECT id FROM users", 0, 20)
+	// SELECT id FROM users LIMIT -1 OFFSET 20
+
+# Capabilities
+
+Besides dialect.SQLDialect, the SQLite dialect implements the optional
+dialect.Returner, dialect.Upserter and dialect.TimeQuoter interfaces.
+Savepoints use the standard statements.
+*/
+package sqlite
@@ -0,0 +1,24 @@
+package sqlite_test
+
+import (
+	"fmt"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/sqlite"
+)
+
+func Example() {
+	d := sqlite.New()
+	fmt.Println(d.Placeholder(1))
+	fmt.Println(d.Options().MaxPlaceholderIndex)
+	// Output:
+	// ?
+	// 999
+}
+
+func Example_paginate() {
+	d := sqlite.New()
+	fmt.Println(dialect.Paginate(d, "SELECT id FROM users", 0, 20))
+	// Output:
+	// SELECT id FROM users LIMIT -1 OFFSET 20
+}
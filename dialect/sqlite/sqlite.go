@@ -0,0 +1,207 @@
+package sqlite
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+)
+
+//
+// SQLite Dialect
+//
+
+// dialectImpl provides the SQLite implementation of the dialect.SQLDialect
+// interface. It is unexported to prevent direct instantiation; consumers
+// should always use the New() constructor.
+//
+// Key behaviors:
+//   - Identifiers are double-quoted when needed; names are not folded.
+//   - Placeholders are "?", up to 999 per statement (the default limit
+//     before SQLite 3.32).
+//   - Pagination uses LIMIT n OFFSET m; offset-only pagination uses
+//     LIMIT -1.
+//   - Upserts use INSERT ... ON CONFLICT (3.24+); RETURNING is supported
+//     (3.35+).
+type dialectImpl struct {
+	opts dialect.Options
+}
+
+// Compile-time checks: ensure dialectImpl implements SQLDialect and the
+// optional capabilities it advertises.
+var (
+	_ dialect.SQLDialect = (*dialectImpl)(nil)
+	_ dialect.Returner   = (*dialectImpl)(nil)
+	_ dialect.Upserter   = (*dialectImpl)(nil)
+	_ dialect.TimeQuoter = (*dialectImpl)(nil)
+)
+
+// bareIdentifier matches identifiers that can be emitted without quotes.
+var bareIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*$`)
+
+//
+// Constructors
+//
+
+// New returns a SQLite dialect. The returned value implements the
+// dialect.SQLDialect interface.
+//
+// Example:
+//
+//	d := sqlite.New()
+//	d.Placeholder(2) // → "?"
+func New() dialect.SQLDialect {
+	return &dialectImpl{
+		opts: dialect.Options{
+			Name:                    "sqlite",
+			QuoteStyle:              `"`,
+			PlaceholderStyle:        "?",
+			AllowMerge:              false,
+			AllowUpsert:             true,
+			ForcedAliasing:          true,
+			EnableReturning:         true,
+			SupportsCTE:             true,
+			SupportsWindowFunctions: true,
+			SupportsNullsOrdering:   true,
+			SupportsILike:           false,
+			SupportsArrayBinding:    false,
+			SupportsValuesList:      true,
+			MaxPlaceholderIndex:     999,
+		},
+	}
+}
+
+// NewWithOptions creates a SQLite dialect with the given static options,
+// for example a MaxPlaceholderIndex of 32766 for SQLite 3.32 and later.
+// Options are immutable once the dialect is constructed.
+func NewWithOptions(opts dialect.Options) dialect.SQLDialect {
+	return &dialectImpl{opts: opts}
+}
+
+//
+// Interface Implementation
+//
+
+// Name returns the identifier of this dialect, "sqlite" by default.
+func (d *dialectImpl) Name() string {
+	return d.opts.Name
+}
+
+// Options returns the feature capability matrix for the SQLite dialect.
+func (d *dialectImpl) Options() dialect.Options {
+	return d.opts
+}
+
+// QuoteIdentifier returns a SQLite identifier. Regular names are returned
+// as-is; anything else is wrapped in double quotes, with embedded double
+// quotes escaped by doubling them.
+//
+// Schema-qualified names are quoted part by part and reserved words
+// are quoted, subject to Options().QuotePolicy.
+//
+// Example:
+//
+//	d.QuoteIdentifier("main.users") // → main.users
+//	d.QuoteIdentifier("user data")  // → "user data"
+func (d *dialectImpl) QuoteIdentifier(name string) string {
+	return dialect.NewQuoter(d.opts, bareIdentifier.MatchString).Quote(name)
+}
+
+// QuoteLiteral quotes a literal value for inline use in SQLite SQL.
+//
+// Supported types:
+//   - nil       → NULL
+//   - string    → escaped and wrapped in single quotes
+//   - bool      → 1 or 0
+//   - numbers   → rendered in decimal form
+//   - time.Time → 'YYYY-MM-DD HH:MM:SS' in UTC
+//   - []byte    → X'..'
+//   - others    → dialect.MustLiteral
+func (d *dialectImpl) QuoteLiteral(literal any) string {
+	switch v := literal.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return "'" + strings.ReplaceAll(v, "'", "''") + "'"
+	case bool:
+		if v {
+			return "1"
+		}
+		return "0"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 64)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return dialect.MustLiteral(d, v)
+	}
+}
+
+// QuoteTime renders t as text in UTC, the form SQLite date and time
+// functions read.
+//
+// Example:
+//
+//	d.QuoteTime(t) // → '2025-09-19 03:30:00'
+func (d *dialectImpl) QuoteTime(t time.Time) string {
+	return "'" + t.UTC().Format(dialect.TimestampLayout) + "'"
+}
+
+// PaginationSyntax renders the SQLite LIMIT/OFFSET clause. OFFSET is only
+// valid after LIMIT, so offset-only pagination uses LIMIT -1.
+//
+// Example:
+//
+//	d.PaginationSyntax(10, 20) // → " LIMIT 10 OFFSET 20"
+//	d.PaginationSyntax(0, 20)  // → " LIMIT -1 OFFSET 20"
+func (d *dialectImpl) PaginationSyntax(limit, offset int) string {
+	switch {
+	case limit > 0 && offset > 0:
+		return fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+	case limit > 0:
+		return fmt.Sprintf(" LIMIT %d", limit)
+	case offset > 0:
+		return fmt.Sprintf(" LIMIT -1 OFFSET %d", offset)
+	default:
+		return ""
+	}
+}
+
+// Placeholder always returns "?" for SQLite. The index parameter is
+// ignored since parameters are bound in order.
+func (d *dialectImpl) Placeholder(_ int) string {
+	return d.opts.PlaceholderStyle
+}
+
+// ReturningSyntax renders "RETURNING ..." for the given columns. SQLite
+// returns values as a result set, so nextIndex is ignored.
+//
+// Example:
+//
+//	d.ReturningSyntax([]string{"id"}, 1) // → RETURNING id
+func (d *dialectImpl) ReturningSyntax(columns []string, _ int) string {
+	if len(columns) == 0 {
+		return ""
+	}
+	cols := make([]string, len(columns))
+	for i, c := range columns {
+		cols[i] = d.QuoteIdentifier(c)
+	}
+	return "RETURNING " + strings.Join(cols, ", ")
+}
+
+// UpsertSyntax renders an INSERT ... ON CONFLICT upsert.
+//
+// Example:
+//
+//	d.UpsertSyntax("users", []string{"id", "name"}, []string{"id"})
+//	// INSERT INTO users (id, name) VALUES (?, ?)
+//	//   ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name
+func (d *dialectImpl) UpsertSyntax(table string, columns, keys []string) string {
+	return dialect.OnConflict(d, table, columns, keys)
+}
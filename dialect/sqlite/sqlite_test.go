@@ -0,0 +1,114 @@
+package sqlite_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/sqlite"
+)
+
+func TestSQLiteDialect(t *testing.T) {
+	d := sqlite.New()
+
+	t.Run("Name", func(t *testing.T) {
+		if got, want := d.Name(), "sqlite"; got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+		if got := sqlite.NewWithOptions(dialect.Options{Name: "sqlite3", PlaceholderStyle: "?"}).Name(); got != "sqlite3" {
+			t.Errorf("expected %q, got %q", "sqlite3", got)
+		}
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		opts := d.Options()
+		if !opts.AllowUpsert || !opts.EnableReturning {
+			t.Error("expected UPSERT and RETURNING to be enabled")
+		}
+		if opts.AllowMerge || opts.SupportsArrayBinding {
+			t.Error("expected MERGE and array binding to be disabled")
+		}
+		if opts.MaxPlaceholderIndex != 999 {
+			t.Errorf("unexpected MaxPlaceholderIndex = %d", opts.MaxPlaceholderIndex)
+		}
+	})
+
+	t.Run("QuoteIdentifier", func(t *testing.T) {
+		cases := []struct {
+			in   string
+			want string
+		}{
+			{"users", "users"},
+			{"UserData", "UserData"},
+			{"main.users", "main.users"},
+			{"user data", `"user data"`},
+			{"order", `"order"`},
+			{`odd"name`, `"odd""name"`},
+			{"", ""},
+		}
+		for _, c := range cases {
+			if got := d.QuoteIdentifier(c.in); got != c.want {
+				t.Errorf("QuoteIdentifier(%q) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("QuoteLiteral", func(t *testing.T) {
+		now := time.Date(2025, 9, 19, 3, 30, 0, 0, time.UTC)
+		cases := []struct {
+			in   any
+			want string
+		}{
+			{nil, "NULL"},
+			{"O'Reilly", "'O''Reilly'"},
+			{true, "1"},
+			{false, "0"},
+			{uint64(5), "5"},
+			{0.25, "0.25"},
+			{now, "'2025-09-19 03:30:00'"},
+			{[]byte("hi"), "X'6869'"},
+		}
+		for _, c := range cases {
+			if got := d.QuoteLiteral(c.in); got != c.want {
+				t.Errorf("QuoteLiteral(%v) = %q, want %q", c.in, got, c.want)
+			}
+		}
+	})
+
+	t.Run("Placeholder", func(t *testing.T) {
+		if got := d.Placeholder(3); got != "?" {
+			t.Errorf("Placeholder(3) = %q, want '?'", got)
+		}
+	})
+
+	t.Run("Golden", func(t *testing.T) {
+		r := d.(dialect.Returner)
+		u := d.(dialect.Upserter)
+		cases := []struct {
+			name string
+			got  string
+			want string
+		}{
+			{"paginate", dialect.Paginate(d, "SELECT id FROM users", 10, 20),
+				"SELECT id FROM users LIMIT 10 OFFSET 20"},
+			{"paginate limit", dialect.Paginate(d, "SELECT id FROM users", 10, 0),
+				"SELECT id FROM users LIMIT 10"},
+			{"paginate offset", dialect.Paginate(d, "SELECT id FROM users", 0, 20),
+				"SELECT id FROM users LIMIT -1 OFFSET 20"},
+			{"returning", r.ReturningSyntax([]string{"id"}, 1), "RETURNING id"},
+			{"upsert", u.UpsertSyntax("users", []string{"id", "name"}, []string{"id"}),
+				"INSERT INTO users (id, name) VALUES (?, ?) ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name"},
+		}
+		for _, c := range cases {
+			if c.got != c.want {
+				t.Errorf("%s:\n got %q\nwant %q", c.name, c.got, c.want)
+			}
+		}
+	})
+
+	t.Run("Placeholders", func(t *testing.T) {
+		if err := dialect.CheckPlaceholders(d, 1000); err == nil {
+			t.Error("expected an error beyond 999 placeholders")
+		}
+	})
+}
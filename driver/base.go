@@ -53,6 +53,15 @@ type BaseDialect struct {
 	// PlaceholderStyle is an optional function that generates argument placeholders (e.g., $1, ?, :GetName).
 	PlaceholderStyle styling.PlaceholderStyle
 
+	// MaxPlaceholderIndex is the maximum number of placeholders a statement
+	// may bind (65535 for PostgreSQL, 2099 for SQL Server, 999 for SQLite).
+	// Zero means no limit.
+	//
+	// This value is returned by the PlaceholderLimit method.
+	//
+	// Since: v1.8.0
+	MaxPlaceholderIndex int
+
 	counter int // used for sequential placeholder tracking
 }
 
@@ -76,6 +85,14 @@ func (b *BaseDialect) NextPlaceholder() string {
 	return b.Placeholder(b.counter)
 }
 
+// PlaceholderLimit returns MaxPlaceholderIndex, the maximum number of
+// placeholders a statement may bind, or 0 when there is no limit.
+//
+// Since: v1.8.0
+func (b *BaseDialect) PlaceholderLimit() int {
+	return b.MaxPlaceholderIndex
+}
+
 // QuoteType returns the configured identifier QuoteStyle style.
 //
 // Since: v1.4.0
@@ -86,25 +103,37 @@ func (b *BaseDialect) QuoteType() styling.QuoteStyle {
 // QuoteIdentifier returns the given identifier with dialect-appropriate quoting.
 // Defaults to no quoting unless the QuoteStyle style is configured.
 //
-// Updated: v1.4.0
+// Qualified names are quoted part by part (`"public"."users"`), and embedded
+// quote characters are escaped.
+//
+// Updated: v1.7.0
 func (b *BaseDialect) QuoteIdentifier(identifier string) string {
-	return b.QuoteStyle.Quote(identifier)
+	return b.QuoteStyle.QuoteQualified(identifier)
 }
 
 // QuoteLiteral returns a printable literal string for debugging/logging purposes only.
+// Embedded single quotes are escaped by doubling them, and nil renders as NULL.
 // ⚠️ DO NOT use this for building real queries — use placeholders instead.
+// For strict, dialect-aware literals see dialect.Literal and dialect.Interpolate.
 //
-// Updated: v1.4.0
+// This is the legacy driver rendering: values other than strings, numbers,
+// booleans and nil are printed in their fmt form, for display only.
+//
+// Updated: v1.8.0
 func (b *BaseDialect) QuoteLiteral(value any) string {
 	switch v := value.(type) {
+	case nil:
+		return "NULL"
 	case string:
-		return "'" + v + "'"
-	case int, int64, float64:
+		return "'" + strings.ReplaceAll(v, "'", "''") + "'"
+	case int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64,
+		float32, float64:
 		return fmt.Sprintf("%v", v)
 	case bool:
 		return strconv.FormatBool(v)
 	default:
-		return fmt.Sprintf("'%v'", v)
+		return "'" + strings.ReplaceAll(fmt.Sprintf("%v", v), "'", "''") + "'"
 	}
 }
 
@@ -5,29 +5,73 @@
 
 package driver
 
-import "github.com/entiqon/db/driver/styling"
+import (
+	"fmt"
 
-// NewDB2Dialect implements the Dialect interface for IBM DB2 databases.
+	"github.com/entiqon/db/driver/styling"
+)
+
+// DB2Dialect implements the Dialect interface for IBM DB2 databases.
 //
 // This dialect uses:
 //   - Quoting style: "identifier"
-//   - Placeholder style: :param (named)
+//   - Placeholder style: ? (unnumbered parameter markers)
 //   - Aliasing support: optional `AS`
-//   - RETURNING clause: partially supported (varies by version)
+//   - Pagination: OFFSET n ROWS FETCH FIRST m ROWS ONLY (DB2 11.1+)
+//   - RETURNING clause: not supported (DB2 uses SELECT ... FROM FINAL TABLE)
 //   - UPSERT capability: supported via MERGE syntax
 //
+// For MERGE rendering and version-aware quoting, use the dialect/db2 package.
+//
 // See:
 //   - https://www.ibm.com/docs/en/db2
 //   - https://www.ibm.com/docs/en/db2/latest?topic=statements-merge
-func NewDB2Dialect() Dialect {
-	return &BaseDialect{
-		Name:             "db2",
-		QuoteStyle:       styling.QuoteDouble,
-		PlaceholderStyle: styling.PlaceholderNamed,
-		EnableAliasing:   true,
-		EnableReturning:  true,
-		EnableUpsert:     true,
+//
+// Since: v1.6.0
+type DB2Dialect struct {
+	BaseDialect
+}
+
+// NewDB2Dialect returns a new DB2Dialect instance,
+// preconfigured with DB2-compatible rules.
+//
+// Quoting style: "identifier"
+// PlaceholderStyle style: ?
+// SupportsUpsert: true (MERGE INTO)
+// EnableReturning: false
+//
+// Since: v1.6.0
+func NewDB2Dialect() *DB2Dialect {
+	return &DB2Dialect{
+		BaseDialect: BaseDialect{
+			Name:                "db2",
+			QuoteStyle:          styling.QuoteDouble,
+			PlaceholderStyle:    styling.PlaceholderQuestion,
+			EnableAliasing:      true,
+			EnableReturning:     false,
+			EnableUpsert:        true,
+			MaxPlaceholderIndex: 32767,
+		},
+	}
+}
+
+// BuildLimitOffset returns the DB2 row-limiting clause.
+// Negative values mean "not set". Returns an empty string if neither is defined.
+//
+// Example: `OFFSET 20 ROWS FETCH FIRST 10 ROWS ONLY`
+//
+// Since: v1.6.0
+func (d *DB2Dialect) BuildLimitOffset(limit, offset int) string {
+	switch {
+	case limit >= 0 && offset > 0:
+		return fmt.Sprintf("OFFSET %d ROWS FETCH FIRST %d ROWS ONLY", offset, limit)
+	case limit >= 0:
+		return fmt.Sprintf("FETCH FIRST %d ROWS ONLY", limit)
+	case offset >= 0:
+		return fmt.Sprintf("OFFSET %d ROWS", offset)
+	default:
+		return ""
 	}
 }
 
-var _ Dialect = &BaseDialect{}
+var _ Dialect = &DB2Dialect{}
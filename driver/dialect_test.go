@@ -25,18 +25,32 @@ func TestDialect(t *testing.T) {
 
 	t.Run("db2", func(t *testing.T) {
 		d := driver.NewDB2Dialect()
-		if got := d.PlaceholderNamed("id"); got != ":id" {
-			t.Errorf("expected %q, got %q", ":id", got)
+		if got := d.Placeholder(1); got != "?" {
+			t.Errorf("expected %q, got %q", "?", got)
 		}
 		if got := d.QuoteIdentifier("id"); got != "\"id\"" {
 			t.Errorf("expected %q, got %q", "\"id\"", got)
 		}
-		if !d.SupportsReturning() {
-			t.Errorf("expected SupportsReturning=true")
+		if d.SupportsReturning() {
+			t.Errorf("expected SupportsReturning=false")
 		}
 		if !d.SupportsUpsert() {
 			t.Errorf("expected SupportsUpsert=true")
 		}
+		limits := []struct {
+			limit, offset int
+			want          string
+		}{
+			{10, 20, "OFFSET 20 ROWS FETCH FIRST 10 ROWS ONLY"},
+			{10, -1, "FETCH FIRST 10 ROWS ONLY"},
+			{-1, 20, "OFFSET 20 ROWS"},
+			{-1, -1, ""},
+		}
+		for _, c := range limits {
+			if got := d.BuildLimitOffset(c.limit, c.offset); got != c.want {
+				t.Errorf("BuildLimitOffset(%d, %d): expected %q, got %q", c.limit, c.offset, c.want, got)
+			}
+		}
 	})
 
 	t.Run("firebird", func(t *testing.T) {
@@ -53,6 +67,20 @@ func TestDialect(t *testing.T) {
 		if !d.SupportsUpsert() {
 			t.Errorf("expected SupportsUpsert=true")
 		}
+		limits := []struct {
+			limit, offset int
+			want          string
+		}{
+			{10, 20, "ROWS 21 TO 30"},
+			{10, -1, "ROWS 10"},
+			{-1, 20, "OFFSET 20 ROWS"},
+			{-1, -1, ""},
+		}
+		for _, c := range limits {
+			if got := d.BuildLimitOffset(c.limit, c.offset); got != c.want {
+				t.Errorf("BuildLimitOffset(%d, %d): expected %q, got %q", c.limit, c.offset, c.want, got)
+			}
+		}
 	})
 
 	t.Run("informix", func(t *testing.T) {
@@ -63,8 +91,22 @@ func TestDialect(t *testing.T) {
 		if got := d.QuoteIdentifier("id"); got != "\"id\"" {
 			t.Errorf("expected %q, got %q", "\"id\"", got)
 		}
-		if !d.SupportsReturning() {
-			t.Errorf("expected SupportsReturning=true")
+		if d.SupportsReturning() {
+			t.Errorf("expected SupportsReturning=false")
+		}
+		limits := []struct {
+			limit, offset int
+			want          string
+		}{
+			{10, 20, "SKIP 20 FIRST 10"},
+			{10, -1, "FIRST 10"},
+			{-1, 20, "SKIP 20"},
+			{-1, -1, ""},
+		}
+		for _, c := range limits {
+			if got := d.BuildLimitOffset(c.limit, c.offset); got != c.want {
+				t.Errorf("BuildLimitOffset(%d, %d): expected %q, got %q", c.limit, c.offset, c.want, got)
+			}
 		}
 		if d.SupportsUpsert() {
 			t.Errorf("expected SupportsUpsert=false")
@@ -95,6 +137,12 @@ func TestDialect(t *testing.T) {
 		if got := d.QuoteIdentifier("id"); got != "`id`" {
 			t.Errorf("expected %q, got %q", "`id`", got)
 		}
+		if got := d.QuoteLiteral(`it's a \ test`); got != `'it''s a \\ test'` {
+			t.Errorf("expected %q, got %q", `'it''s a \\ test'`, got)
+		}
+		if got := d.QuoteLiteral(42); got != "42" {
+			t.Errorf("expected %q, got %q", "42", got)
+		}
 		if d.SupportsReturning() {
 			t.Errorf("expected SupportsReturning=false")
 		}
@@ -108,6 +156,23 @@ func TestDialect(t *testing.T) {
 		if got := d.PlaceholderNamed("id"); got != ":id" {
 			t.Errorf("expected %q, got %q", ":id", got)
 		}
+		if got := d.Placeholder(2); got != ":2" {
+			t.Errorf("expected %q, got %q", ":2", got)
+		}
+		limits := []struct {
+			limit, offset int
+			want          string
+		}{
+			{10, 20, "OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY"},
+			{10, -1, "FETCH FIRST 10 ROWS ONLY"},
+			{-1, 20, "OFFSET 20 ROWS"},
+			{-1, -1, ""},
+		}
+		for _, l := range limits {
+			if got := d.BuildLimitOffset(l.limit, l.offset); got != l.want {
+				t.Errorf("BuildLimitOffset(%d, %d): expected %q, got %q", l.limit, l.offset, l.want, got)
+			}
+		}
 		if got := d.QuoteIdentifier("id"); got != "\"id\"" {
 			t.Errorf("expected %q, got %q", "\"id\"", got)
 		}
@@ -45,6 +45,15 @@ func TestBaseDialectDirectMethods(t *testing.T) {
 	if got := base.QuoteLiteral([]int{1, 2, 3}); got != "'[1 2 3]'" {
 		t.Errorf("expected %q, got %q", "'[1 2 3]'", got)
 	}
+	if got := base.QuoteLiteral("O'Reilly"); got != "'O''Reilly'" {
+		t.Errorf("expected %q, got %q", "'O''Reilly'", got)
+	}
+	if got := base.QuoteLiteral(nil); got != "NULL" {
+		t.Errorf("expected %q, got %q", "NULL", got)
+	}
+	if got := base.QuoteLiteral(uint8(7)); got != "7" {
+		t.Errorf("expected %q, got %q", "7", got)
+	}
 }
 
 func TestGetName(t *testing.T) {
@@ -81,6 +90,11 @@ func TestQuoteIdentifier(t *testing.T) {
 		{"postgres", driver.NewPostgresDialect(), "user", `"user"`},
 		{"mssql", driver.NewMSSQLDialect(), "user", "[user]"},
 		{"mysql", driver.NewMySQLDialect(), "user", "`user`"},
+		{"postgres qualified", driver.NewPostgresDialect(), "public.users", `"public"."users"`},
+		{"postgres escaped", driver.NewPostgresDialect(), `odd"name`, `"odd""name"`},
+		{"mssql qualified", driver.NewMSSQLDialect(), "dbo.a]b", "[dbo].[a]]b]"},
+		{"mysql qualified", driver.NewMySQLDialect(), "shop.odd`name", "`shop`.`odd``name`"},
+		{"generic qualified", driver.NewGenericDialect(), "public.users", "public.users"},
 	}
 
 	for _, tt := range tests {
@@ -5,29 +5,75 @@
 
 package driver
 
-import "github.com/entiqon/db/driver/styling"
+import (
+	"fmt"
 
-// NewFirebirdDialect implements the Dialect interface for Firebird SQL engines.
+	"github.com/entiqon/db/driver/styling"
+)
+
+// FirebirdDialect implements the Dialect interface for Firebird SQL engines.
 //
 // This dialect uses:
 //   - Quoting style: "identifier"
 //   - Placeholder style: ?
 //   - Aliasing support: optional `AS`
+//   - Pagination: ROWS m TO n (trailing clause)
 //   - RETURNING clause: supported
-//   - UPSERT capability: limited, often requires custom logic
+//   - UPSERT capability: supported via UPDATE OR INSERT ... MATCHING and MERGE
+//
+// For FIRST/SKIP pagination, which must follow the SELECT keyword, use the
+// dialect/firebird package.
 //
 // See:
 //   - https://firebirdsql.org/refdocs/langrefupd21-insert.html
 //   - https://firebirdsql.org/file/documentation/reference_manuals/
-func NewFirebirdDialect() Dialect {
-	return &BaseDialect{
-		Name:             "firebird",
-		QuoteStyle:       styling.QuoteDouble,
-		PlaceholderStyle: styling.PlaceholderQuestion,
-		EnableAliasing:   true,
-		EnableReturning:  true,
-		EnableUpsert:     true,
+//
+// Since: v1.6.0
+type FirebirdDialect struct {
+	BaseDialect
+}
+
+// NewFirebirdDialect returns a new FirebirdDialect instance,
+// preconfigured with Firebird-compatible rules.
+//
+// Quoting style: "identifier"
+// PlaceholderStyle style: ?
+// SupportsUpsert: true (UPDATE OR INSERT / MERGE)
+// EnableReturning: true
+//
+// Since: v1.6.0
+func NewFirebirdDialect() *FirebirdDialect {
+	return &FirebirdDialect{
+		BaseDialect: BaseDialect{
+			Name:             "firebird",
+			QuoteStyle:       styling.QuoteDouble,
+			PlaceholderStyle: styling.PlaceholderQuestion,
+			EnableAliasing:   true,
+			EnableReturning:  true,
+			EnableUpsert:     true,
+		},
+	}
+}
+
+// BuildLimitOffset returns the Firebird ROWS clause. ROWS is one-based and
+// inclusive, so offset 20 and limit 10 become `ROWS 21 TO 30`. An offset
+// without limit falls back to the SQL:2008 `OFFSET n ROWS` form (Firebird 3.0+).
+// Negative values mean "not set". Returns an empty string if neither is defined.
+//
+// Example: `ROWS 21 TO 30`
+//
+// Since: v1.6.0
+func (d *FirebirdDialect) BuildLimitOffset(limit, offset int) string {
+	switch {
+	case limit >= 0 && offset > 0:
+		return fmt.Sprintf("ROWS %d TO %d", offset+1, offset+limit)
+	case limit >= 0:
+		return fmt.Sprintf("ROWS %d", limit)
+	case offset >= 0:
+		return fmt.Sprintf("OFFSET %d ROWS", offset)
+	default:
+		return ""
 	}
 }
 
-var _ Dialect = &BaseDialect{}
+var _ Dialect = &FirebirdDialect{}
@@ -5,28 +5,73 @@
 
 package driver
 
-import "github.com/entiqon/db/driver/styling"
+import (
+	"fmt"
+	"strings"
 
-// NewInformixDialect implements the Dialect interface for Informix databases.
+	"github.com/entiqon/db/driver/styling"
+)
+
+// InformixDialect implements the Dialect interface for Informix databases.
 //
 // This dialect uses:
-//   - Quoting style: "identifier"
+//   - Quoting style: "identifier" (requires DELIMIDENT)
 //   - Placeholder style: ?
 //   - Aliasing support: optional `AS`
-//   - RETURNING clause: supported
+//   - Pagination: SKIP n FIRST m, placed right after the SELECT keyword
+//   - RETURNING clause: not supported
 //   - UPSERT capability: not natively supported; emulate with MERGE
 //
+// Because the pagination clause is not a trailing clause, builders that
+// append BuildLimitOffset to the statement produce invalid SQL for Informix.
+// Use the dialect/informix package together with dialect.Paginate instead.
+//
 // See:
 //   - https://www.ibm.com/docs/en/informix-servers
-func NewInformixDialect() Dialect {
-	return &BaseDialect{
-		Name:             "informix",
-		QuoteStyle:       styling.QuoteDouble,
-		PlaceholderStyle: styling.PlaceholderQuestion,
-		EnableAliasing:   true,
-		EnableReturning:  true,
-		EnableUpsert:     false,
+//
+// Since: v1.6.0
+type InformixDialect struct {
+	BaseDialect
+}
+
+// NewInformixDialect returns a new InformixDialect instance,
+// preconfigured with Informix-compatible rules.
+//
+// Quoting style: "identifier"
+// PlaceholderStyle style: ?
+// SupportsUpsert: false
+// EnableReturning: false
+//
+// Since: v1.6.0
+func NewInformixDialect() *InformixDialect {
+	return &InformixDialect{
+		BaseDialect: BaseDialect{
+			Name:             "informix",
+			QuoteStyle:       styling.QuoteDouble,
+			PlaceholderStyle: styling.PlaceholderQuestion,
+			EnableAliasing:   true,
+			EnableReturning:  false,
+			EnableUpsert:     false,
+		},
+	}
+}
+
+// BuildLimitOffset returns the Informix SKIP/FIRST projection clause, which
+// belongs between SELECT and the select list.
+// Negative values mean "not set". Returns an empty string if neither is defined.
+//
+// Example: `SKIP 20 FIRST 10`
+//
+// Since: v1.6.0
+func (d *InformixDialect) BuildLimitOffset(limit, offset int) string {
+	var parts []string
+	if offset >= 0 {
+		parts = append(parts, fmt.Sprintf("SKIP %d", offset))
+	}
+	if limit >= 0 {
+		parts = append(parts, fmt.Sprintf("FIRST %d", limit))
 	}
+	return strings.Join(parts, " ")
 }
 
-var _ Dialect = &BaseDialect{}
+var _ Dialect = &InformixDialect{}
@@ -32,12 +32,13 @@ type MSSQLDialect struct {
 func NewMSSQLDialect() *MSSQLDialect {
 	return &MSSQLDialect{
 		BaseDialect{
-			Name:             "mssql",
-			QuoteStyle:       styling.QuoteBracket,
-			PlaceholderStyle: styling.PlaceholderQuestion,
-			EnableAliasing:   true,
-			EnableReturning:  false,
-			EnableUpsert:     false,
+			Name:                "mssql",
+			QuoteStyle:          styling.QuoteBracket,
+			PlaceholderStyle:    styling.PlaceholderQuestion,
+			EnableAliasing:      true,
+			EnableReturning:     false,
+			EnableUpsert:        false,
+			MaxPlaceholderIndex: 2099,
 		},
 	}
 }
@@ -5,7 +5,11 @@
 
 package driver
 
-import "github.com/entiqon/db/driver/styling"
+import (
+	"strings"
+
+	"github.com/entiqon/db/driver/styling"
+)
 
 // MySQLDialect implements the Dialect interface for MySQL-compatible databases.
 //
@@ -32,14 +36,28 @@ type MySQLDialect struct {
 func NewMySQLDialect() *MySQLDialect {
 	return &MySQLDialect{
 		BaseDialect: BaseDialect{
-			Name:             "mysql",
-			QuoteStyle:       styling.QuoteBacktick,
-			PlaceholderStyle: styling.PlaceholderQuestion,
-			EnableAliasing:   true,
-			EnableReturning:  false,
-			EnableUpsert:     false,
+			Name:                "mysql",
+			QuoteStyle:          styling.QuoteBacktick,
+			PlaceholderStyle:    styling.PlaceholderQuestion,
+			EnableAliasing:      true,
+			EnableReturning:     false,
+			EnableUpsert:        false,
+			MaxPlaceholderIndex: 65535,
 		},
 	}
 }
 
+// QuoteLiteral returns a printable literal string for debugging/logging purposes only.
+// MySQL treats backslashes in string literals as escapes by default, so they
+// are doubled along with single quotes. Other values render as in BaseDialect.
+//
+// Since: v1.8.0
+func (d *MySQLDialect) QuoteLiteral(value any) string {
+	if s, ok := value.(string); ok {
+		s = strings.ReplaceAll(s, `\`, `\\`)
+		return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+	}
+	return d.BaseDialect.QuoteLiteral(value)
+}
+
 var _ Dialect = &BaseDialect{}
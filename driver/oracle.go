@@ -5,34 +5,80 @@
 
 package driver
 
-import "github.com/entiqon/db/driver/styling"
+import (
+	"fmt"
 
-// NewOracleDialect implements the Dialect interface for Oracle databases.
+	"github.com/entiqon/db/driver/styling"
+)
+
+// OracleDialect implements the Dialect interface for Oracle databases.
 //
-// Oracle uses named parameters (e.g., :param), standard double-quote identifiers,
-// and supports RETURNING clauses. UPSERT logic is available using `MERGE INTO`.
+// Oracle uses numbered bind variables (e.g., :1) or named ones (e.g., :param),
+// standard double-quote identifiers, and supports RETURNING ... INTO clauses.
+// UPSERT logic is available using `MERGE INTO`.
 // Note that SQL syntax can differ significantly depending on Oracle version.
 //
 // This dialect uses:
 //   - Quoting style: "identifier"
-//   - Placeholder style: :param
-//   - Aliasing support: optional `AS`
+//   - Placeholder style: :1 (positional) and :param (named)
+//   - Aliasing support: table aliases without `AS`
+//   - Pagination: OFFSET n ROWS FETCH NEXT m ROWS ONLY (12c+)
 //   - RETURNING clause: enabled
 //   - UPSERT capability: enabled via MERGE INTO
 //
+// For ROWNUM pagination on 11g and version-aware identifier validation,
+// use the dialect/oracle package.
+//
 // See:
 //   - https://docs.oracle.com/en/database/
 //   - https://docs.oracle.com/en/database/oracle/oracle-database/19/sqlrf/MERGE.html
 //   - https://docs.oracle.com/en/database/oracle/oracle-database/19/sqlrf/RETURNING-INTO-Clause.html
-func NewOracleDialect() Dialect {
-	return &BaseDialect{
-		Name:             "Oracle",
-		QuoteStyle:       styling.QuoteDouble,
-		PlaceholderStyle: styling.PlaceholderNamed, // e.g., :param
-		EnableAliasing:   true,
-		EnableReturning:  true,
-		EnableUpsert:     true,
+//
+// Since: v1.6.0
+type OracleDialect struct {
+	BaseDialect
+}
+
+// NewOracleDialect returns a new OracleDialect instance,
+// preconfigured with Oracle-compatible rules.
+//
+// Quoting style: "identifier"
+// PlaceholderStyle style: :1, :2, ... / :name
+// SupportsUpsert: true (MERGE INTO)
+// EnableReturning: true
+//
+// Since: v1.6.0
+func NewOracleDialect() *OracleDialect {
+	return &OracleDialect{
+		BaseDialect: BaseDialect{
+			Name:                "oracle",
+			QuoteStyle:          styling.QuoteDouble,
+			PlaceholderStyle:    styling.PlaceholderNamed, // :1 positional, :param named
+			EnableAliasing:      true,
+			EnableReturning:     true,
+			EnableUpsert:        true,
+			MaxPlaceholderIndex: 65535,
+		},
+	}
+}
+
+// BuildLimitOffset returns the Oracle 12c+ row-limiting clause.
+// Negative values mean "not set". Returns an empty string if neither is defined.
+//
+// Example: `OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY`
+//
+// Since: v1.6.0
+func (d *OracleDialect) BuildLimitOffset(limit, offset int) string {
+	switch {
+	case limit >= 0 && offset > 0:
+		return fmt.Sprintf("OFFSET %d ROWS FETCH NEXT %d ROWS ONLY", offset, limit)
+	case limit >= 0:
+		return fmt.Sprintf("FETCH FIRST %d ROWS ONLY", limit)
+	case offset >= 0:
+		return fmt.Sprintf("OFFSET %d ROWS", offset)
+	default:
+		return ""
 	}
 }
 
-var _ Dialect = &BaseDialect{}
+var _ Dialect = &OracleDialect{}
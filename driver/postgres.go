@@ -32,12 +32,13 @@ type PostgresDialect struct {
 func NewPostgresDialect() *PostgresDialect {
 	return &PostgresDialect{
 		BaseDialect: BaseDialect{
-			Name:             "postgres",
-			QuoteStyle:       styling.QuoteDouble,
-			PlaceholderStyle: styling.PlaceholderDollar,
-			EnableAliasing:   true,
-			EnableReturning:  true,
-			EnableUpsert:     true,
+			Name:                "postgres",
+			QuoteStyle:          styling.QuoteDouble,
+			PlaceholderStyle:    styling.PlaceholderDollar,
+			EnableAliasing:      true,
+			EnableReturning:     true,
+			EnableUpsert:        true,
+			MaxPlaceholderIndex: 65535,
 		},
 	}
 }
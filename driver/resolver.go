@@ -10,6 +10,10 @@ import "strings"
 //   - "postgres", "postgresql" → NewPostgresDialect()
 //   - "mysql", "mariadb"       → NewMySQLDialect()
 //   - "mssql", "sqlserver"     → NewMSSQLDialect()
+//   - "oracle"                 → NewOracleDialect()
+//   - "db2"                    → NewDB2Dialect()
+//   - "firebird"               → NewFirebirdDialect()
+//   - "informix"               → NewInformixDialect()
 //   - default fallback         → NewGenericDialect()
 //
 // This function never returns nil.
@@ -25,6 +29,14 @@ func ResolveDialect(name string) Dialect {
 		return NewMySQLDialect()
 	case "mssql", "sqlserver":
 		return NewMSSQLDialect()
+	case "oracle":
+		return NewOracleDialect()
+	case "db2":
+		return NewDB2Dialect()
+	case "firebird":
+		return NewFirebirdDialect()
+	case "informix":
+		return NewInformixDialect()
 	default:
 		return NewGenericDialect()
 	}
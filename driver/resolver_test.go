@@ -42,3 +42,41 @@ func TestResolveDialect_UnknownFallback(t *testing.T) {
 		t.Errorf("expected no error, got %v", err)
 	}
 }
+
+func TestResolveDialect_Oracle(t *testing.T) {
+	d := driver.ResolveDialect("Oracle")
+	if got := d.GetName(); got != "oracle" {
+		t.Errorf("expected %q, got %q", "oracle", got)
+	}
+	if got := d.Placeholder(1); got != ":1" {
+		t.Errorf("expected %q, got %q", ":1", got)
+	}
+	if got := d.RenderFrom("users", "u"); got != `"users" u` {
+		t.Errorf("expected %q, got %q", `"users" u`, got)
+	}
+}
+
+func TestResolveDialect_LegacyIBMAndFirebird(t *testing.T) {
+	cases := []struct {
+		input       string
+		name        string
+		placeholder string
+		limit       string
+	}{
+		{"db2", "db2", "?", "OFFSET 20 ROWS FETCH FIRST 10 ROWS ONLY"},
+		{" Firebird ", "firebird", "?", "ROWS 21 TO 30"},
+		{"INFORMIX", "informix", "?", "SKIP 20 FIRST 10"},
+	}
+	for _, c := range cases {
+		d := driver.ResolveDialect(c.input)
+		if got := d.GetName(); got != c.name {
+			t.Errorf("%q: expected name %q, got %q", c.input, c.name, got)
+		}
+		if got := d.Placeholder(1); got != c.placeholder {
+			t.Errorf("%q: expected placeholder %q, got %q", c.input, c.placeholder, got)
+		}
+		if got := d.BuildLimitOffset(10, 20); got != c.limit {
+			t.Errorf("%q: expected limit %q, got %q", c.input, c.limit, got)
+		}
+	}
+}
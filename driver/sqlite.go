@@ -26,12 +26,13 @@ import "github.com/entiqon/db/driver/styling"
 //   - https://www.sqlite.org/lang_upsert.html
 func NewSQLiteDialect() Dialect {
 	return &BaseDialect{
-		Name:             "SQLite",
-		QuoteStyle:       styling.QuoteDouble,         // uses "column"
-		PlaceholderStyle: styling.PlaceholderQuestion, // uses ?
-		EnableAliasing:   true,
-		EnableReturning:  true,
-		EnableUpsert:     true,
+		Name:                "SQLite",
+		QuoteStyle:          styling.QuoteDouble,         // uses "column"
+		PlaceholderStyle:    styling.PlaceholderQuestion, // uses ?
+		EnableAliasing:      true,
+		EnableReturning:     true,
+		EnableUpsert:        true,
+		MaxPlaceholderIndex: 999,
 	}
 }
 
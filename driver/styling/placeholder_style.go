@@ -53,18 +53,22 @@ const (
 )
 
 // Format returns a placeholder string based on the given positional index.
-// This applies only to positional styles (Question, Dollar).
+// This applies to positional styles (Question, Dollar) and to Named, which
+// renders numbered bind variables as used by Oracle.
 //
 // Example:
 //
 //	PlaceholderQuestion.Format(1) → "?"
 //	PlaceholderDollar.Format(3)   → "$3"
+//	PlaceholderNamed.Format(2)    → ":2"
 func (p PlaceholderStyle) Format(index int) string {
 	switch p {
 	case PlaceholderQuestion:
 		return "?"
 	case PlaceholderDollar:
 		return fmt.Sprintf("$%d", index)
+	case PlaceholderNamed:
+		return fmt.Sprintf(":%d", index)
 	default:
 		return "?"
 	}
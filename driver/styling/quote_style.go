@@ -5,7 +5,7 @@
 
 package styling
 
-import "fmt"
+import "github.com/entiqon/db/dialect"
 
 // QuoteStyle defines how SQL identifiers (e.g., table or column names) are quoted
 // by a dialect to prevent conflicts with reserved keywords or support case-sensitivity.
@@ -68,23 +68,46 @@ func (q QuoteStyle) IsValid() bool {
 }
 
 // Quote returns the quoted version of the given identifier,
-// based on the configured QuoteStyle.
+// based on the configured QuoteStyle. Embedded closing quote characters
+// are escaped by doubling them.
+//
+// The identifier is treated as a single part; use QuoteQualified for
+// names such as schema.table.
 //
 // Examples:
 //
 //	QuoteDouble.Quote("id")   → `"id"`
-//	QuoteBacktick.Quote("id") → "`id"`
-//	QuoteBracket.Quote("id")  → `[id]`
+//	QuoteDouble.Quote(`a"b`)  → `"a""b"`
+//	QuoteBacktick.Quote("id") → "`id`"
+//	QuoteBracket.Quote("a]b") → `[a]]b]`
 //	QuoteNone.Quote("id")     → `id`
 func (q QuoteStyle) Quote(identifier string) string {
+	return q.quoter().Delimit(identifier)
+}
+
+// QuoteQualified quotes a possibly qualified identifier part by part.
+// Parts that are already quoted and the "*" wildcard are kept as-is.
+//
+// Examples:
+//
+//	QuoteDouble.QuoteQualified("public.users") → `"public"."users"`
+//	QuoteBracket.QuoteQualified("dbo.*")       → `[dbo].*`
+//
+// Since: v1.7.0
+func (q QuoteStyle) QuoteQualified(identifier string) string {
+	return q.quoter().Quote(identifier)
+}
+
+// quoter returns the dialect.Quoter equivalent of the style.
+func (q QuoteStyle) quoter() dialect.Quoter {
 	switch q {
 	case QuoteDouble:
-		return fmt.Sprintf(`"%s"`, identifier)
+		return dialect.Quoter{Open: `"`, Close: `"`, Policy: dialect.QuoteAlways}
 	case QuoteBacktick:
-		return fmt.Sprintf("`%s`", identifier)
+		return dialect.Quoter{Open: "`", Close: "`", Policy: dialect.QuoteAlways}
 	case QuoteBracket:
-		return fmt.Sprintf("[%s]", identifier)
+		return dialect.Quoter{Open: "[", Close: "]", Policy: dialect.QuoteAlways}
 	default:
-		return identifier
+		return dialect.Quoter{Policy: dialect.QuoteNever}
 	}
 }
@@ -114,6 +114,45 @@ func TestStyleValidationCoverage(t *testing.T) {
 				t.Errorf("expected %q, got %q", "name", got)
 			}
 		})
+
+		t.Run("QuoteEscaping", func(t *testing.T) {
+			cases := []struct {
+				style styling.QuoteStyle
+				in    string
+				want  string
+			}{
+				{styling.QuoteDouble, `a"b`, `"a""b"`},
+				{styling.QuoteBacktick, "a`b", "`a``b`"},
+				{styling.QuoteBracket, "a]b", "[a]]b]"},
+				{styling.QuoteDouble, "a.b", `"a.b"`},
+				{styling.QuoteNone, `a"b`, `a"b`},
+			}
+			for _, c := range cases {
+				if got := c.style.Quote(c.in); got != c.want {
+					t.Errorf("Quote(%q): expected %q, got %q", c.in, c.want, got)
+				}
+			}
+		})
+
+		t.Run("QuoteQualified", func(t *testing.T) {
+			cases := []struct {
+				style styling.QuoteStyle
+				in    string
+				want  string
+			}{
+				{styling.QuoteDouble, "public.users", `"public"."users"`},
+				{styling.QuoteDouble, `public."Users"`, `"public"."Users"`},
+				{styling.QuoteBacktick, "shop.order", "`shop`.`order`"},
+				{styling.QuoteBracket, "dbo.*", "[dbo].*"},
+				{styling.QuoteBracket, "[my.db].dbo.t", "[my.db].[dbo].[t]"},
+				{styling.QuoteNone, "public.users", "public.users"},
+			}
+			for _, c := range cases {
+				if got := c.style.QuoteQualified(c.in); got != c.want {
+					t.Errorf("QuoteQualified(%q): expected %q, got %q", c.in, c.want, got)
+				}
+			}
+		})
 	})
 
 	t.Run("PlaceholderStyle", func(t *testing.T) {
@@ -145,6 +184,9 @@ func TestStyleValidationCoverage(t *testing.T) {
 			if got := styling.PlaceholderAt.Format(1); got != "?" {
 				t.Errorf("expected %q, got %q", "?", got)
 			}
+			if got := styling.PlaceholderNamed.Format(2); got != ":2" {
+				t.Errorf("expected %q, got %q", ":2", got)
+			}
 			if got := styling.PlaceholderNamed.FormatNamed("param"); got != ":param" {
 				t.Errorf("expected %q, got %q", ":param", got)
 			}
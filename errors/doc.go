@@ -1,5 +1,6 @@
 // Package errors defines sentinel errors used across Entiqon’s SQL
-// builder tokens (tables, fields, joins, conditions).
+// builder tokens (tables, fields, joins, conditions), and the classes of
+// database driver errors.
 //
 // These errors provide consistent classification for common failure modes
 // such as unsupported constructor types or invalid identifier names.
@@ -21,6 +22,19 @@
 //     table.New("???")
 //     // → error: invalid table identifier
 //
+// # Driver Errors
+//
+// [ErrUniqueViolation], [ErrForeignKeyViolation], [ErrNotNullViolation],
+// [ErrSerialization], [ErrDeadlock], [ErrLockTimeout] and [ErrQueryCanceled]
+// classify failures reported by database drivers. Dialects map SQLSTATE
+// and vendor codes onto them (see dialect.ClassifyError) and wrap the
+// driver error in a [DriverError], which matches both the class and the
+// original error:
+//
+//	if errors.Is(err, dberrors.ErrUniqueViolation) {
+//	    return ErrEmailTaken
+//	}
+//
 // # Usage
 //
 // Callers should prefer [errors.Is] to detect sentinel values:
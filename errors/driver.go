@@ -0,0 +1,78 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Driver error classes. Dialects map the SQLSTATE and vendor codes of
+// driver errors onto these sentinels (see dialect.ClassifyError), so
+// callers can react to a failure without knowing the database behind it:
+//
+//	if errors.Is(err, dberrors.ErrUniqueViolation) {
+//	    return ErrEmailTaken
+//	}
+var (
+	// ErrUniqueViolation reports a duplicate value in a unique index or
+	// primary key (Postgres 23505, MySQL 1062, SQL Server 2627, ORA-00001).
+	ErrUniqueViolation = errors.New("unique violation")
+
+	// ErrForeignKeyViolation reports a missing referenced row, or a
+	// referenced row still in use (Postgres 23503, MySQL 1452, ORA-02291).
+	ErrForeignKeyViolation = errors.New("foreign key violation")
+
+	// ErrNotNullViolation reports a NULL written to a NOT NULL column
+	// (Postgres 23502, MySQL 1048, SQL Server 515, ORA-01400).
+	ErrNotNullViolation = errors.New("not null violation")
+
+	// ErrSerialization reports a transaction aborted because it could not
+	// be serialized with concurrent ones (40001, ORA-08177). Running the
+	// transaction again may succeed.
+	ErrSerialization = errors.New("serialization failure")
+
+	// ErrDeadlock reports a transaction chosen as a deadlock victim
+	// (Postgres 40P01, MySQL 1213, SQL Server 1205, ORA-00060). Running the
+	// transaction again may succeed.
+	ErrDeadlock = errors.New("deadlock")
+
+	// ErrLockTimeout reports a lock that could not be acquired in time
+	// (Postgres 55P03, MySQL 1205, SQL Server 1222, ORA-00054).
+	ErrLockTimeout = errors.New("lock timeout")
+
+	// ErrQueryCanceled reports a statement canceled by the client, a
+	// statement timeout or the administrator (Postgres 57014, ORA-01013).
+	ErrQueryCanceled = errors.New("query canceled")
+)
+
+// DriverError is a driver error classified by a dialect.
+//
+// It unwraps to both its class and the driver error, so errors.Is matches
+// the class sentinel and errors.As still reaches the vendor error type.
+type DriverError struct {
+	// Kind is the class of the error, one of the Err* sentinels.
+	Kind error
+
+	// Code is the SQLSTATE or vendor code the class was derived from. It
+	// is empty for classes derived otherwise, such as context cancellation.
+	Code string
+
+	// Err is the driver error.
+	Err error
+}
+
+// Error implements error.
+//
+// Example output:
+//
+//	unique violation [23505]: duplicate key value violates unique constraint "users_email_key"
+func (e *DriverError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("%v: %v", e.Kind, e.Err)
+	}
+	return fmt.Sprintf("%v [%s]: %v", e.Kind, e.Code, e.Err)
+}
+
+// Unwrap returns the class and the driver error.
+func (e *DriverError) Unwrap() []error {
+	return []error{e.Kind, e.Err}
+}
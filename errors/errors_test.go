@@ -61,3 +61,35 @@ func TestErrors(t *testing.T) {
 		})
 	}
 }
+
+func ExampleDriverError() {
+	driverErr := stdErrors.New(`duplicate key value violates unique constraint "users_email_key"`)
+	err := fmt.Errorf("create user: %w", &errors.DriverError{
+		Kind: errors.ErrUniqueViolation,
+		Code: "23505",
+		Err:  driverErr,
+	})
+
+	fmt.Println(stdErrors.Is(err, errors.ErrUniqueViolation), stdErrors.Is(err, driverErr))
+	fmt.Println(err)
+
+	// Output:
+	// true true
+	// create user: unique violation [23505]: duplicate key value violates unique constraint "users_email_key"
+}
+
+func TestDriverError(t *testing.T) {
+	driverErr := stdErrors.New("canceling statement")
+	err := &errors.DriverError{Kind: errors.ErrQueryCanceled, Err: driverErr}
+	if err.Error() != "query canceled: canceling statement" {
+		t.Errorf("unexpected message %q", err.Error())
+	}
+	for _, target := range []error{errors.ErrQueryCanceled, driverErr} {
+		if !stdErrors.Is(err, target) {
+			t.Errorf("expected errors.Is(%v, %v)", err, target)
+		}
+	}
+	if stdErrors.Is(err, errors.ErrDeadlock) {
+		t.Error("expected no match for another class")
+	}
+}
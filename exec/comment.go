@@ -0,0 +1,133 @@
+// File: db/exec/comment.go
+
+package exec
+
+import (
+	"context"
+	"maps"
+	"path"
+	"reflect"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/entiqon/db/dialect"
+)
+
+// CallerTag is the tag NewCommentHook sets to the call site of statements
+// when CommentOptions.CallSite is set.
+const CallerTag = "caller"
+
+// CommentOptions configures NewCommentHook.
+type CommentOptions struct {
+	// Tags are set on every statement, such as the application name.
+	Tags map[string]string
+
+	// Func, when not nil, returns the tags of a statement, such as the
+	// route or trace id held by ctx.
+	Func func(ctx context.Context, e *Event) map[string]string
+
+	// CallSite sets CallerTag to the file and line of the code that ran
+	// the statement through this package.
+	CallSite bool
+}
+
+// tagsKey holds the tags of contexts returned by WithTags.
+type tagsKey struct{}
+
+// WithTags returns ctx carrying tags, added to those ctx already carries,
+// for NewCommentHook to set on the statements run with it:
+//
+//	ctx = exec.WithTags(ctx, map[string]string{"route": r.Pattern})
+func WithTags(ctx context.Context, tags map[string]string) context.Context {
+	merged := maps.Clone(TagsFrom(ctx))
+	if merged == nil {
+		merged = make(map[string]string, len(tags))
+	}
+	maps.Copy(merged, tags)
+	return context.WithValue(ctx, tagsKey{}, merged)
+}
+
+// TagsFrom returns the tags carried by ctx, or nil. The map must not be
+// modified.
+func TagsFrom(ctx context.Context) map[string]string {
+	tags, _ := ctx.Value(tagsKey{}).(map[string]string)
+	return tags
+}
+
+// commentHook is the Hook returned by NewCommentHook.
+type commentHook struct {
+	NopHook
+	opts CommentOptions
+}
+
+// NewCommentHook returns a Hook tagging every statement with a trailing
+// comment in the sqlcommenter format, such as
+// /*app='billing',route='%2Forders'*/, so DBAs can trace it back to its
+// origin. Tags come from, by increasing precedence, opts.Tags, opts.Func,
+// the context (WithTags) and the builder itself (SelectBuilder.Tag); the
+// comment is rendered and placed by dialect.Comment.
+//
+// Tags varying per call, such as trace ids, make every statement text
+// distinct, which defeats StmtCache and the server's statement caches.
+//
+// Example:
+//
+//	db := exec.WithHooks(exec.Bind(sqlDB, oracle.New()), exec.NewCommentHook(&exec.CommentOptions{
+//	    Tags:     map[string]string{"app": "billing"},
+//	    CallSite: true,
+//	}))
+//	// SELECT * FROM orders WHERE id = :1 /*app='billing',caller='orders%2Frepo.go%3A42'*/
+func NewCommentHook(opts *CommentOptions) Hook {
+	h := &commentHook{}
+	if opts != nil {
+		h.opts = *opts
+	}
+	return h
+}
+
+// AfterBuild tags the rendered statement.
+func (h *commentHook) AfterBuild(ctx context.Context, e *Event) error {
+	if e.Err != nil {
+		return nil
+	}
+	tags := maps.Clone(h.opts.Tags)
+	if tags == nil {
+		tags = make(map[string]string)
+	}
+	if h.opts.CallSite {
+		if site := callSite(); site != "" {
+			tags[CallerTag] = site
+		}
+	}
+	if h.opts.Func != nil {
+		maps.Copy(tags, h.opts.Func(ctx, e))
+	}
+	maps.Copy(tags, TagsFrom(ctx))
+	e.SQL = dialect.Comment(e.dialect, e.SQL, tags)
+	return nil
+}
+
+// callSiteSkip prefixes the names of the functions callSite skips: those
+// of this package, and the runtime and iterator helpers they call through.
+var callSiteSkip = []string{reflect.TypeFor[Event]().PkgPath() + ".", "runtime.", "iter.", "slices."}
+
+// callSite returns the file and line, as "dir/file.go:42", of the first
+// caller outside this package.
+func callSite() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		f, more := frames.Next()
+		if !slices.ContainsFunc(callSiteSkip, func(prefix string) bool {
+			return strings.HasPrefix(f.Function, prefix)
+		}) {
+			dir, file := path.Split(f.File)
+			return path.Join(path.Base(dir), file) + ":" + strconv.Itoa(f.Line)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
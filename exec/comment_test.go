@@ -0,0 +1,87 @@
+// File: db/exec/comment_test.go
+
+package exec_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/entiqon/db/builder/selects"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/exec"
+	"github.com/entiqon/db/internal/drivertest"
+	"github.com/entiqon/db/token/types/operator"
+)
+
+func TestCommentHook(t *testing.T) {
+	ctx := context.Background()
+	setup := func(t *testing.T, opts *exec.CommentOptions) (*drivertest.Driver, exec.Runner) {
+		drv := drivertest.New()
+		db := drv.DB()
+		t.Cleanup(func() { _ = db.Close() })
+		return drv, exec.WithHooks(exec.Bind(db, oracle.New()), exec.NewCommentHook(opts))
+	}
+	orders := func() selects.SelectBuilder {
+		return selects.New(nil).From("orders").Where("id", operator.Equal, 7)
+	}
+	expect := func(t *testing.T, drv *drivertest.Driver, want string) {
+		t.Helper()
+		if got := drv.Queries(); len(got) != 1 || got[0] != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	}
+
+	t.Run("Tags", func(t *testing.T) {
+		drv, r := setup(t, &exec.CommentOptions{Tags: map[string]string{"app": "billing"}})
+		if _, err := exec.Exec(ctx, orders(), r); err != nil {
+			t.Fatal(err)
+		}
+		expect(t, drv, "SELECT * FROM orders WHERE id = :1 /*app='billing'*/")
+	})
+
+	t.Run("Precedence", func(t *testing.T) {
+		drv, r := setup(t, &exec.CommentOptions{
+			Tags: map[string]string{"app": "billing", "route": "static", "team": "static", "action": "static"},
+			Func: func(_ context.Context, e *exec.Event) map[string]string {
+				return map[string]string{"route": "func", "team": "func", "action": e.Op}
+			},
+		})
+		ctx := exec.WithTags(exec.WithTags(ctx, map[string]string{"route": "ctx"}), map[string]string{"team": "ctx"})
+		if _, err := exec.Exec(ctx, orders().Tag("route", "builder"), r); err != nil {
+			t.Fatal(err)
+		}
+		expect(t, drv, "SELECT * FROM orders WHERE id = :1 /*action='Exec',app='billing',route='builder',team='ctx'*/")
+	})
+
+	t.Run("CallSite", func(t *testing.T) {
+		drv, r := setup(t, &exec.CommentOptions{CallSite: true})
+		for range exec.Iter[int](ctx, orders(), r) {
+		}
+		want := regexp.MustCompile(`/\*caller='exec%2Fcomment_test\.go%3A\d+'\*/$`)
+		if got := drv.Queries(); len(got) != 1 || !want.MatchString(got[0]) {
+			t.Errorf("expected caller tag, got %q", got)
+		}
+	})
+
+	t.Run("BuildError", func(t *testing.T) {
+		drv, r := setup(t, &exec.CommentOptions{Tags: map[string]string{"app": "billing"}})
+		if _, err := exec.Exec(ctx, selects.New(nil), r); err == nil {
+			t.Fatal("expected build error")
+		}
+		if n := len(drv.Calls()); n != 0 {
+			t.Errorf("expected no statement, got %q", drv.Queries())
+		}
+	})
+
+	t.Run("TagsFrom", func(t *testing.T) {
+		if tags := exec.TagsFrom(ctx); tags != nil {
+			t.Errorf("expected no tags, got %v", tags)
+		}
+		parent := exec.WithTags(ctx, map[string]string{"a": "1"})
+		_ = exec.WithTags(parent, map[string]string{"a": "2"})
+		if got := exec.TagsFrom(parent)["a"]; got != "1" {
+			t.Errorf("expected parent tags unchanged, got %q", got)
+		}
+	})
+}
@@ -0,0 +1,127 @@
+/*
+Package exec runs builders against database/sql.
+
+# Overview
+
+Builders stop at (string, []any, error). This package does the rest:
+
+  - Query      — renders a builder and returns *sql.Rows
+  - Exec       — renders a builder and returns sql.Result
+  - QueryRow   — renders a builder and returns a *Row to scan
+  - ScanAll    — renders a builder and maps its rows onto []T
+  - ScanOne    — renders a builder and maps its single row onto T
+  - Iter       — renders a builder and streams its rows as T values
+  - IterKeyset — streams a builder's rows in keyset-paginated statements
+  - WithTx     — runs a function in a transaction or savepoint
+
+Statements run on a Runner, an interface satisfied by *sql.DB, *sql.Tx and
+*sql.Conn, and always with a context. The SELECT, INSERT, UPDATE, upsert
+and DELETE builders of the builder packages all implement Builder.
+
+# Prepared statements
+
+A StmtCache is a Runner preparing each distinct SQL string once on a
+*sql.DB and reusing the statement afterwards, so builders rendering the
+same shape skip parsing on the server:
+
+	stmts := exec.NewStmtCache(sqlDB, 512)
+	defer stmts.Close()
+	db := exec.Bind(stmts, oracle.New())
+
+The least recently used statements are closed beyond the cache size, and
+statements failing to prepare or failing for reasons other than the data
+are prepared again. Stats reports hits, misses and evictions.
+
+# Dialects
+
+A builder is rendered for the dialect it was created with. Bind attaches a
+dialect to a Runner instead, so every builder executed on it gets the
+placeholders (?, $1, :1, @p1) its driver expects:
+
+	db := exec.Bind(sqlDB, oracle.New())
+	rows, err := exec.Query(ctx, selects.New(nil).From("users"), db)
+
+# Scanning
+
+ScanAll and ScanOne run a builder and map its rows onto structs:
+
+	type Order struct {
+	    ID     int64   `db:"id"`
+	    Total  float64 `db:"order_total"`
+	    Coupon *string // coupon; nil when NULL
+	}
+	orders, err := exec.ScanAll[Order](ctx, sb, db)
+
+Columns match `db` tags, or the snake_case field name, case-insensitively.
+Embedded structs are promoted, pointer fields take NULLs and sql.Scanner
+types scan themselves. Column names come from the builder's field aliases
+when available. A column without a field fails with ErrUnknownColumn,
+except the row number added by Oracle 11g ROWNUM pagination (rn_);
+ScanOne fails with sql.ErrNoRows or ErrTooManyRows. ScanRows maps rows
+obtained by other means.
+
+# Streaming
+
+Iter maps rows like ScanAll, but yields them one at a time to a range
+loop, so large result sets are never held in memory:
+
+	for order, err := range exec.Iter[Order](ctx, sb, db) {
+	    if err != nil {
+	        return err
+	    }
+	    ...
+	}
+
+Rows are closed when the loop ends or breaks. IterKeyset fetches the rows
+in pages instead, each page a statement resuming after the key of the last
+row seen (WHERE (id > ?) ORDER BY id LIMIT n), so no cursor stays open and
+late pages cost no more than early ones.
+
+# Transactions
+
+WithTx runs a function in a transaction, committed when the function
+returns nil and rolled back when it fails or panics. Called again with the
+Runner it hands out, it nests through savepoints rendered for the bound
+dialect. Transactions aborted by serialization failures or deadlocks are
+run again with backoff; see TxOptions and IsRetryable.
+
+# Hooks
+
+A Hook runs around every builder executed by the package: BeforeBuild and
+AfterBuild around rendering, BeforeExec and AfterExec around the driver
+call. Hooks receive an Event with the operation, the builder's Debug()
+output, the dialect name, the SQL, its args, the duration and the error,
+and may reject a statement by returning an error. Register them for every
+runner with AddHook, or for one with WithHooks; NopHook provides defaults:
+
+	db := exec.WithHooks(exec.Bind(sqlDB, oracle.New()), metrics, tenantGuard)
+
+NewLogHook logs statements through log/slog with their dialect,
+fingerprint, duration, rows affected and error class. Args bound to
+columns matching LogOptions.SensitiveColumns (password, token, ssn, ...),
+or marked with Sensitive, are logged as Redacted. The fingerprint,
+Event.Fingerprint, hashes the statement shape, whatever its values and IN
+list lengths, and can key metrics in other hooks as well.
+
+NewTraceHook records a span around each build and each statement, with
+the OpenTelemetry database attributes (db.system, db.operation,
+db.sql.table, db.statement, db.rows_affected). It starts spans through
+the small Tracer interface, so this package depends on no tracing
+library; the otelexec module adapts OpenTelemetry tracers to it.
+
+NewCommentHook tags statements with a trailing sqlcommenter comment, such
+as app='billing',route='%2Forders', built from static tags, a function of
+the context, the tags set with WithTags, the builder's own tags and,
+optionally, the call site, so DBAs can trace a statement to its origin.
+
+# Errors
+
+Build errors are returned unchanged. Driver errors are wrapped in an
+*Error holding the operation, the SQL, its args and the builder's Debug()
+output. Its message leaves the args out, so logging it leaks no values.
+It unwraps to the driver error, so errors.Is(err, sql.ErrNoRows) still
+holds. Driver errors with a known SQLSTATE or vendor code are
+classified for the bound dialect, so errors.Is also matches the classes of
+the errors package, such as ErrUniqueViolation.
+*/
+package exec
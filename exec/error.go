@@ -0,0 +1,91 @@
+// File: db/exec/error.go
+
+package exec
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/entiqon/db/dialect"
+)
+
+// Operations reported in Error.Op.
+const (
+	OpQuery    = "Query"
+	OpExec     = "Exec"
+	OpQueryRow = "QueryRow"
+)
+
+// Error wraps a driver error with the statement that caused it.
+//
+// It unwraps to the driver error, so errors.Is and errors.As keep working
+// (sql.ErrNoRows, driver-specific error types). Driver errors with a known
+// SQLSTATE or vendor code are classified first (see dialect.ClassifyError),
+// so errors.Is(err, dberrors.ErrUniqueViolation) and the other classes of
+// the errors package hold as well.
+type Error struct {
+	// Op is the operation that failed: OpQuery, OpExec or OpQueryRow.
+	Op string
+
+	// SQL is the rendered statement.
+	SQL string
+
+	// Args are the bound values. They are left out of Error, so logging
+	// the error does not leak them.
+	Args []any
+
+	// Debug is the builder's Debug() output.
+	Debug string
+
+	// Err is the driver error, wrapped in a *dberrors.DriverError when it
+	// was classified.
+	Err error
+}
+
+// Error implements error. The message holds the statement with its
+// placeholders, never the bound values, which only Args carries.
+//
+// Example output:
+//
+//	[Exec] - Query: ORA-00942: table or view does not exist
+//		SQL: SELECT * FROM users WHERE id = :1
+//		Builder: SelectBuilder{table:Table("users"), fields:0, join:0, where:1, ...}
+func (e *Error) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "[Exec] - %s: %v", e.Op, e.Err)
+	fmt.Fprintf(&sb, "\n\tSQL: %s", e.SQL)
+	if e.Debug != "" {
+		fmt.Fprintf(&sb, "\n\tBuilder: %s", e.Debug)
+	}
+	return sb.String()
+}
+
+// Unwrap returns the driver error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// driverError returns the driver error wrapped by err when it is an
+// *Error, without the statement, arguments and Debug() output the *Error
+// carries, or err itself otherwise. Hooks exporting errors use it so that
+// values never leave the process through them.
+func driverError(err error) error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Err
+	}
+	return err
+}
+
+// wrap builds the *Error reported for a failed operation, classifying err
+// for the dialect r is bound to.
+func wrap(op string, q Builder, r Runner, query string, args []any, err error) error {
+	return &Error{
+		Op:    op,
+		SQL:   query,
+		Args:  args,
+		Debug: q.Debug(),
+		Err:   dialect.ClassifyError(DialectOf(r), err),
+	}
+}
@@ -0,0 +1,304 @@
+// File: db/exec/example_test.go
+
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/entiqon/db/builder/selects"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/exec"
+	"github.com/entiqon/db/internal/drivertest"
+	"github.com/entiqon/db/token/types/operator"
+)
+
+func ExampleQuery() {
+	drv := drivertest.New()
+	drv.Respond = func(string, []any) (drivertest.Result, error) {
+		return drivertest.Result{Columns: []string{"name"}, Rows: [][]any{{"Ada"}, {"Grace"}}}, nil
+	}
+	sqlDB := drv.DB()
+	defer sqlDB.Close()
+
+	db := exec.Bind(sqlDB, oracle.New())
+	sb := selects.New(nil).Fields("name").From("users").Where("active", operator.Equal, true)
+
+	rows, err := exec.Query(context.Background(), sb, db)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name string
+		_ = rows.Scan(&name)
+		fmt.Println(name)
+	}
+	fmt.Println(drv.Queries()[0])
+
+	// Output:
+	// Ada
+	// Grace
+	// SELECT name FROM users WHERE active = :1
+}
+
+func ExampleError() {
+	drv := drivertest.New()
+	drv.Respond = func(string, []any) (drivertest.Result, error) {
+		return drivertest.Result{}, errors.New("table or view does not exist")
+	}
+	sqlDB := drv.DB()
+	defer sqlDB.Close()
+
+	sb := selects.New(oracle.New()).From("users").Where("id", operator.Equal, 42)
+	_, err := exec.Exec(context.Background(), sb, sqlDB)
+
+	var execErr *exec.Error
+	if errors.As(err, &execErr) {
+		fmt.Println(execErr.Op, execErr.SQL, execErr.Args, execErr.Err)
+	}
+	// Output: Exec SELECT * FROM users WHERE id = :1 [42] table or view does not exist
+}
+
+func ExampleScanAll() {
+	drv := drivertest.New()
+	drv.Respond = func(string, []any) (drivertest.Result, error) {
+		return drivertest.Result{
+			Columns: []string{"ID", "ORDER_TOTAL", "COUPON"},
+			Rows:    [][]any{{int64(1), 9.5, nil}, {int64(2), 20.0, "SAVE"}},
+		}, nil
+	}
+	db := drv.DB()
+	defer db.Close()
+
+	type Order struct {
+		ID     int64   `db:"id"`
+		Total  float64 `db:"order_total"`
+		Coupon *string `db:"coupon"`
+	}
+
+	sb := selects.New(oracle.New()).
+		Fields("id").
+		AppendFields("total", "order_total").
+		AppendFields("coupon").
+		From("orders")
+
+	orders, err := exec.ScanAll[Order](context.Background(), sb, db)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, o := range orders {
+		fmt.Println(o.ID, o.Total, o.Coupon != nil)
+	}
+
+	// Output:
+	// 1 9.5 false
+	// 2 20 true
+}
+
+func ExampleWithTx() {
+	drv := drivertest.New()
+	db := drv.DB()
+	defer db.Close()
+
+	ctx := context.Background()
+	insert := func(stmt string) func(exec.Runner) error {
+		return func(tx exec.Runner) error {
+			_, err := tx.ExecContext(ctx, stmt)
+			return err
+		}
+	}
+
+	_ = exec.WithTx(ctx, db, nil, func(tx exec.Runner) error {
+		if err := insert("INSERT INTO orders VALUES (1)")(tx); err != nil {
+			return err
+		}
+		err := exec.WithTx(ctx, tx, nil, func(tx exec.Runner) error {
+			_ = insert("INSERT INTO audit VALUES (1)")(tx)
+			return errors.New("audit failed")
+		})
+		fmt.Println(err)
+		return nil
+	})
+	for _, q := range drv.Queries() {
+		fmt.Println(q)
+	}
+
+	// Output:
+	// audit failed
+	// BEGIN
+	// INSERT INTO orders VALUES (1)
+	// SAVEPOINT sp_1
+	// INSERT INTO audit VALUES (1)
+	// ROLLBACK TO SAVEPOINT sp_1
+	// COMMIT
+}
+
+func ExampleIterKeyset() {
+	drv := drivertest.New()
+	drv.Respond = func(_ string, args []any) (drivertest.Result, error) {
+		if len(args) == 0 {
+			return drivertest.Result{Columns: []string{"id"}, Rows: [][]any{{int64(1)}, {int64(2)}}}, nil
+		}
+		return drivertest.Result{Columns: []string{"id"}, Rows: [][]any{{int64(3)}}}, nil
+	}
+	sqlDB := drv.DB()
+	defer sqlDB.Close()
+
+	db := exec.Bind(sqlDB, oracle.New())
+	sb := selects.New(nil).Fields("id").From("orders")
+	keys := exec.Keyset{Columns: []string{"id"}, PageSize: 2}
+
+	for id, err := range exec.IterKeyset[int64](context.Background(), sb, db, keys) {
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(id)
+	}
+	for _, q := range drv.Queries() {
+		fmt.Println(q)
+	}
+
+	// Output:
+	// 1
+	// 2
+	// 3
+	// SELECT * FROM (SELECT id FROM orders) keyset ORDER BY id FETCH FIRST 2 ROWS ONLY
+	// SELECT * FROM (SELECT id FROM orders) keyset WHERE (id > :1) ORDER BY id FETCH FIRST 2 ROWS ONLY
+}
+
+func ExampleStmtCache() {
+	drv := drivertest.New()
+	sqlDB := drv.DB()
+	defer sqlDB.Close()
+
+	stmts := exec.NewStmtCache(sqlDB, 0)
+	defer stmts.Close()
+	db := exec.Bind(stmts, oracle.New())
+
+	for _, id := range []int{1, 2, 3} {
+		sb := selects.New(nil).From("users").Where("id", operator.Equal, id)
+		if _, err := exec.Exec(context.Background(), sb, db); err != nil {
+			fmt.Println(err)
+			return
+		}
+	}
+	s := stmts.Stats()
+	fmt.Println(drv.Prepares(), s.Hits, s.Misses)
+	// Output: 1 2 1
+}
+
+func ExampleWithHooks() {
+	drv := drivertest.New()
+	sqlDB := drv.DB()
+	defer sqlDB.Close()
+
+	db := exec.WithHooks(exec.Bind(sqlDB, oracle.New()), tenantGuard{})
+
+	sb := selects.New(nil).From("invoices")
+	_, err := exec.Exec(context.Background(), sb, db)
+	fmt.Println(err)
+
+	sb.Where("tenant_id", operator.Equal, 42)
+	_, err = exec.Exec(context.Background(), sb, db)
+	fmt.Println(err, drv.Queries())
+
+	// Output:
+	// statement without tenant_id: SELECT * FROM invoices
+	// <nil> [SELECT * FROM invoices WHERE tenant_id = :1]
+}
+
+// tenantGuard rejects statements not filtered by tenant.
+type tenantGuard struct {
+	exec.NopHook
+}
+
+func (tenantGuard) AfterBuild(_ context.Context, e *exec.Event) error {
+	if e.Err == nil && !strings.Contains(e.SQL, "tenant_id") {
+		return fmt.Errorf("statement without tenant_id: %s", e.SQL)
+	}
+	return nil
+}
+
+func ExampleNewLogHook() {
+	drv := drivertest.New()
+	sqlDB := drv.DB()
+	defer sqlDB.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			switch a.Key {
+			case slog.TimeKey, "duration", "fingerprint":
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	db := exec.WithHooks(exec.Bind(sqlDB, oracle.New()), exec.NewLogHook(logger, nil))
+
+	sb := selects.New(nil).
+		Fields("id").
+		From("users").
+		Where("login", operator.Equal, "ada").
+		AndWhere("password_hash", operator.Equal, "5f4dcc3b").
+		AndWhere("email", operator.Equal, exec.Sensitive("ada@example.com"))
+	_, _ = exec.Exec(context.Background(), sb, db)
+
+	// Output:
+	// level=INFO msg=statement op=Exec dialect=oracle sql="SELECT id FROM users WHERE login = :1 AND password_hash = :2 AND email = :3" args="[ada [REDACTED] [REDACTED]]" rows=0
+}
+
+// printTracer is an exec.Tracer printing the spans it starts.
+type printTracer struct{}
+
+func (printTracer) Start(ctx context.Context, name string, attrs ...exec.Attribute) (context.Context, exec.Span) {
+	fmt.Println(name, attrs)
+	return ctx, printSpan{}
+}
+
+type printSpan struct{}
+
+func (printSpan) SetAttributes(...exec.Attribute) {}
+func (printSpan) End(error)                       {}
+
+func ExampleNewTraceHook() {
+	sqlDB := drivertest.New().DB()
+	defer sqlDB.Close()
+
+	hook := exec.NewTraceHook(printTracer{}, &exec.TraceOptions{Statement: exec.StatementInlined})
+	db := exec.WithHooks(exec.Bind(sqlDB, oracle.New()), hook)
+
+	sb := selects.New(nil).
+		Fields("id").
+		From("users").
+		Where("login", operator.Equal, "ada").
+		AndWhere("password_hash", operator.Equal, "5f4dcc3b")
+	_, _ = exec.Query(context.Background(), sb, db)
+
+	// Output:
+	// build users [{db.system oracle} {db.sql.table users}]
+	// SELECT users [{db.system oracle} {db.operation SELECT} {db.sql.table users} {db.statement SELECT id FROM users WHERE login = 'ada' AND password_hash = '[REDACTED]'}]
+}
+
+func ExampleNewCommentHook() {
+	drv := drivertest.New()
+	sqlDB := drv.DB()
+	defer sqlDB.Close()
+
+	hook := exec.NewCommentHook(&exec.CommentOptions{Tags: map[string]string{"app": "billing"}})
+	db := exec.WithHooks(exec.Bind(sqlDB, oracle.New()), hook)
+
+	ctx := exec.WithTags(context.Background(), map[string]string{"route": "/orders/{id}"})
+	sb := selects.New(nil).From("orders").Where("id", operator.Equal, 7)
+	_, _ = exec.Exec(ctx, sb, db)
+
+	fmt.Println(drv.Queries()[0])
+	// Output: SELECT * FROM orders WHERE id = :1 /*app='billing',route='%2Forders%2F%7Bid%7D'*/
+}
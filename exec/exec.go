@@ -0,0 +1,216 @@
+// File: db/exec/exec.go
+
+package exec
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/entiqon/db/contract"
+	"github.com/entiqon/db/dialect"
+)
+
+// Builder is a statement builder that can be executed. It is implemented
+// by selects.SelectBuilder, inserts.InsertBuilder, updates.UpdateBuilder,
+// upserts.UpsertBuilder and deletes.DeleteBuilder.
+type Builder interface {
+	// Build renders the statement for the builder's own dialect.
+	Build() (string, []any, error)
+
+	// BuildFor renders the statement for d.
+	BuildFor(d dialect.SQLDialect) (string, []any, error)
+
+	contract.Debuggable
+}
+
+// Runner executes SQL statements. It is satisfied by *sql.DB, *sql.Tx and
+// *sql.Conn.
+type Runner interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Compile-time checks: the database/sql handles are runners.
+var (
+	_ Runner = (*sql.DB)(nil)
+	_ Runner = (*sql.Tx)(nil)
+	_ Runner = (*sql.Conn)(nil)
+)
+
+// boundRunner is a Runner bound to the dialect of its database, to hooks,
+// or to both.
+type boundRunner struct {
+	Runner
+	dialect dialect.SQLDialect
+	hooks   []Hook
+}
+
+// ExecContext runs query on the wrapped runner. A StmtCache classifies its
+// failures for the bound dialect.
+func (b *boundRunner) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	if c, ok := b.Runner.(*StmtCache); ok {
+		return c.execFor(ctx, b.dialect, query, args...)
+	}
+	return b.Runner.ExecContext(ctx, query, args...)
+}
+
+// QueryContext runs query on the wrapped runner like ExecContext.
+func (b *boundRunner) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	if c, ok := b.Runner.(*StmtCache); ok {
+		return c.queryFor(ctx, b.dialect, query, args...)
+	}
+	return b.Runner.QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext runs query on the wrapped runner like ExecContext.
+func (b *boundRunner) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	if c, ok := b.Runner.(*StmtCache); ok {
+		return c.queryRowFor(ctx, b.dialect, query, args...)
+	}
+	return b.Runner.QueryRowContext(ctx, query, args...)
+}
+
+// Bind returns r bound to dialect d. Builders executed on the returned
+// runner are rendered for d, whatever dialect they were created with, so
+// placeholders always match the driver.
+//
+// Example:
+//
+//	db := exec.Bind(sqlDB, oracle.New())
+//	rows, err := exec.Query(ctx, selects.New(nil).From("users"), db)
+func Bind(r Runner, d dialect.SQLDialect) Runner {
+	switch b := r.(type) {
+	case *boundRunner:
+		cp := *b
+		cp.dialect = d
+		return &cp
+	case *txRunner:
+		tx := *b
+		tx.dialect = d
+		return &tx
+	}
+	return &boundRunner{Runner: r, dialect: d}
+}
+
+// DialectOf returns the dialect r was bound to with Bind, or nil. The
+// runners handed out by WithTx keep the dialect of the runner the
+// transaction was begun on.
+func DialectOf(r Runner) dialect.SQLDialect {
+	switch b := r.(type) {
+	case *boundRunner:
+		return b.dialect
+	case *txRunner:
+		return b.dialect
+	}
+	return nil
+}
+
+// Query renders q and runs it on r, returning the resulting rows.
+//
+// q is rendered for the dialect r was bound to (see Bind), or for its own
+// dialect otherwise. Build errors are returned as is; driver errors are
+// wrapped in an *Error carrying the statement and q.Debug().
+//
+// Example:
+//
+//	rows, err := exec.Query(ctx, sb, db)
+//	if err != nil {
+//	    return err
+//	}
+//	defer rows.Close()
+func Query(ctx context.Context, q Builder, r Runner) (*sql.Rows, error) {
+	s := newStatement(OpQuery, q, r, nil)
+	if err := s.render(ctx, nil); err != nil {
+		return nil, err
+	}
+	return s.query(ctx)
+}
+
+// Exec renders q and runs it on r without returning rows, as for INSERT,
+// UPDATE and DELETE statements. Errors are reported as for Query.
+func Exec(ctx context.Context, q Builder, r Runner) (sql.Result, error) {
+	s := newStatement(OpExec, q, r, nil)
+	if err := s.render(ctx, nil); err != nil {
+		return nil, err
+	}
+	var res sql.Result
+	err := s.run(ctx, func(ctx context.Context) (err error) {
+		res, err = r.ExecContext(ctx, s.event.SQL, s.event.Args...)
+		s.event.Result = res
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// QueryRow renders q and runs it on r, expecting at most one row. Errors,
+// including build errors, are deferred to Row.Scan, as with sql.Row.
+//
+// Example:
+//
+//	var name string
+//	err := exec.QueryRow(ctx, sb, db).Scan(&name)
+//	if errors.Is(err, sql.ErrNoRows) {
+//	    // not found
+//	}
+func QueryRow(ctx context.Context, q Builder, r Runner) *Row {
+	s := newStatement(OpQueryRow, q, r, nil)
+	if err := s.render(ctx, nil); err != nil {
+		return &Row{err: err}
+	}
+	var row *sql.Row
+	err := s.run(ctx, func(ctx context.Context) error {
+		row = r.QueryRowContext(ctx, s.event.SQL, s.event.Args...)
+		return row.Err()
+	})
+	if row == nil {
+		return &Row{err: err}
+	}
+	return &Row{
+		row:  row,
+		wrap: func(err error) error { return s.wrap(OpQueryRow, err) },
+	}
+}
+
+// Row is the result of QueryRow.
+type Row struct {
+	row  *sql.Row
+	err  error
+	wrap func(error) error
+}
+
+// Err returns the error, if any, that was encountered while running the
+// query, without scanning the row.
+func (r *Row) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	if err := r.row.Err(); err != nil {
+		return r.wrap(err)
+	}
+	return nil
+}
+
+// Scan copies the columns of the row into dest, as sql.Row.Scan does.
+// sql.ErrNoRows is wrapped like any other error and still matches
+// errors.Is(err, sql.ErrNoRows).
+func (r *Row) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	if err := r.row.Scan(dest...); err != nil {
+		return r.wrap(err)
+	}
+	return nil
+}
+
+// build renders q for the dialect bound to r, or for its own dialect.
+func build(q Builder, r Runner) (string, []any, error) {
+	if d := DialectOf(r); d != nil {
+		return q.BuildFor(d)
+	}
+	return q.Build()
+}
@@ -0,0 +1,248 @@
+// File: db/exec/exec_test.go
+
+package exec_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/entiqon/db/builder/inserts"
+	"github.com/entiqon/db/builder/selects"
+	"github.com/entiqon/db/builder/updates"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/dialect/postgres"
+	dberrors "github.com/entiqon/db/errors"
+	"github.com/entiqon/db/exec"
+	"github.com/entiqon/db/internal/drivertest"
+	"github.com/entiqon/db/token/types/operator"
+)
+
+func TestExec(t *testing.T) {
+	ctx := context.Background()
+	errBoom := errors.New("boom")
+
+	users := func() selects.SelectBuilder {
+		return selects.New(oracle.New()).
+			Fields("id, name").
+			From("users").
+			Where("id", operator.Equal, 42)
+	}
+
+	setup := func(t *testing.T) (*drivertest.Driver, *sql.DB) {
+		drv := drivertest.New()
+		db := drv.DB()
+		t.Cleanup(func() { _ = db.Close() })
+		return drv, db
+	}
+
+	t.Run("Runner", func(t *testing.T) {
+		_, db := setup(t)
+		conn, err := db.Conn(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer tx.Rollback()
+		for _, r := range []exec.Runner{db, tx, conn} {
+			if _, err := exec.Exec(ctx, users(), r); err != nil {
+				t.Errorf("expected %T to run, got %v", r, err)
+			}
+		}
+	})
+
+	t.Run("Query", func(t *testing.T) {
+		drv, db := setup(t)
+		drv.Respond = func(string, []any) (drivertest.Result, error) {
+			return drivertest.Result{
+				Columns: []string{"id", "name"},
+				Rows:    [][]any{{int64(42), "Ada"}},
+			}, nil
+		}
+
+		rows, err := exec.Query(ctx, users(), db)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		defer rows.Close()
+		var id int
+		var name string
+		if !rows.Next() || rows.Scan(&id, &name) != nil || id != 42 || name != "Ada" {
+			t.Errorf("unexpected row %d %q", id, name)
+		}
+
+		calls := drv.Calls()
+		if len(calls) != 1 || calls[0].Query != "SELECT id, name FROM users WHERE id = :1" ||
+			fmt.Sprint(calls[0].Args) != "[42]" {
+			t.Errorf("unexpected calls %v", calls)
+		}
+	})
+
+	t.Run("Exec", func(t *testing.T) {
+		drv, db := setup(t)
+		drv.Respond = func(string, []any) (drivertest.Result, error) {
+			return drivertest.Result{RowsAffected: 3}, nil
+		}
+		res, err := exec.Exec(ctx, users(), db)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if n, _ := res.RowsAffected(); n != 3 {
+			t.Errorf("expected 3 rows affected, got %d", n)
+		}
+	})
+
+	t.Run("Writes", func(t *testing.T) {
+		drv, db := setup(t)
+		drv.Respond = func(string, []any) (drivertest.Result, error) {
+			return drivertest.Result{RowsAffected: 1}, nil
+		}
+		r := exec.Bind(db, postgres.New())
+
+		insert := inserts.New(nil).Into("users").Columns("id", "name").Values(7, "Ada")
+		if _, err := exec.Exec(ctx, insert, r); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		update := updates.New(oracle.New()).Table("users").Set("name", "Grace").Where("id = ?", 7)
+		res, err := exec.Exec(ctx, update, db)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if n, _ := res.RowsAffected(); n != 1 {
+			t.Errorf("expected 1 row affected, got %d", n)
+		}
+
+		calls := drv.Calls()
+		if len(calls) != 2 ||
+			calls[0].Query != "INSERT INTO users (id, name) VALUES ($1, $2)" || fmt.Sprint(calls[0].Args) != "[7 Ada]" ||
+			calls[1].Query != "UPDATE users SET name = :1 WHERE id = :2" || fmt.Sprint(calls[1].Args) != "[Grace 7]" {
+			t.Errorf("expected the INSERT for the bound dialect and the UPDATE for its own, got %v", calls)
+		}
+
+		_, err = exec.Exec(ctx, updates.New(nil).Set("name", "Ada"), db)
+		if err == nil || len(drv.Calls()) != 2 {
+			t.Errorf("expected the build error before running, got %v", err)
+		}
+	})
+
+	t.Run("QueryRow", func(t *testing.T) {
+		drv, db := setup(t)
+		drv.Respond = func(string, []any) (drivertest.Result, error) {
+			return drivertest.Result{Columns: []string{"name"}, Rows: [][]any{{"Ada"}}}, nil
+		}
+		var name string
+		row := exec.QueryRow(ctx, users(), db)
+		if err := row.Err(); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if err := row.Scan(&name); err != nil || name != "Ada" {
+			t.Errorf("expected Ada, got %q (%v)", name, err)
+		}
+
+		drv.Respond = nil
+		err := exec.QueryRow(ctx, users(), db).Scan(&name)
+		var execErr *exec.Error
+		if !errors.Is(err, sql.ErrNoRows) || !errors.As(err, &execErr) || execErr.Op != exec.OpQueryRow {
+			t.Errorf("expected wrapped sql.ErrNoRows, got %v", err)
+		}
+	})
+
+	t.Run("Bind", func(t *testing.T) {
+		drv, db := setup(t)
+		r := exec.Bind(exec.Bind(db, nil), oracle.New())
+		if exec.DialectOf(r).Name() != "oracle" || exec.DialectOf(db) != nil {
+			t.Fatal("unexpected bound dialect")
+		}
+
+		sb := selects.New(nil).From("users").Where("id", operator.Equal, 1)
+		if _, err := exec.Query(ctx, sb, r); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if q := drv.Queries(); len(q) != 1 || q[0] != "SELECT * FROM users WHERE id = :1" {
+			t.Errorf("expected builder rendered for the bound dialect, got %v", q)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		drv, db := setup(t)
+
+		invalid := selects.New(nil)
+		if _, err := exec.Query(ctx, invalid, db); err == nil || strings.Contains(err.Error(), "[Exec]") {
+			t.Errorf("expected unwrapped build error, got %v", err)
+		}
+		if _, err := exec.Exec(ctx, invalid, db); err == nil {
+			t.Error("expected build error")
+		}
+		if err := exec.QueryRow(ctx, invalid, db).Scan(); err == nil {
+			t.Error("expected build error")
+		}
+		if err := exec.QueryRow(ctx, invalid, db).Err(); err == nil {
+			t.Error("expected build error")
+		}
+		if len(drv.Calls()) != 0 {
+			t.Errorf("expected nothing to run, got %v", drv.Queries())
+		}
+
+		drv.Respond = func(string, []any) (drivertest.Result, error) {
+			return drivertest.Result{}, errBoom
+		}
+		_, err := exec.Query(ctx, users(), db)
+		var execErr *exec.Error
+		if !errors.Is(err, errBoom) || !errors.As(err, &execErr) {
+			t.Fatalf("expected wrapped driver error, got %v", err)
+		}
+		if execErr.Op != exec.OpQuery || execErr.SQL != "SELECT id, name FROM users WHERE id = :1" ||
+			!strings.Contains(execErr.Debug, "SelectBuilder{") {
+			t.Errorf("unexpected error fields %+v", *execErr)
+		}
+		want := "[Exec] - Query: boom\n\tSQL: SELECT id, name FROM users WHERE id = :1\n\tBuilder: "
+		if !strings.HasPrefix(err.Error(), want) {
+			t.Errorf("expected message prefix %q, got %q", want, err.Error())
+		}
+		if !reflect.DeepEqual(execErr.Args, []any{42}) || strings.Contains(err.Error(), "42") {
+			t.Errorf("expected args in the Args field only, got %v and %q", execErr.Args, err.Error())
+		}
+
+		if _, err := exec.Exec(ctx, users(), db); !errors.As(err, &execErr) || execErr.Op != exec.OpExec {
+			t.Errorf("expected wrapped Exec error, got %v", err)
+		}
+		if err := exec.QueryRow(ctx, users(), db).Err(); !errors.Is(err, errBoom) {
+			t.Errorf("expected wrapped QueryRow error, got %v", err)
+		}
+		if msg := (&exec.Error{Op: exec.OpExec, SQL: "DELETE FROM t", Err: errBoom}).Error(); strings.Contains(msg, "Builder") {
+			t.Errorf("expected no builder line without debug output, got %q", msg)
+		}
+	})
+
+	t.Run("Classified", func(t *testing.T) {
+		drv, db := setup(t)
+		drv.Respond = func(string, []any) (drivertest.Result, error) {
+			return drivertest.Result{}, oraError(1)
+		}
+
+		_, err := exec.Exec(ctx, users(), exec.Bind(db, oracle.New()))
+		var ora oraError
+		if !errors.Is(err, dberrors.ErrUniqueViolation) || !errors.As(err, &ora) {
+			t.Errorf("expected a unique violation wrapping the driver error, got %v", err)
+		}
+
+		_, err = exec.Exec(ctx, users(), db)
+		if errors.Is(err, dberrors.ErrUniqueViolation) {
+			t.Errorf("expected vendor codes to need the bound dialect, got %v", err)
+		}
+	})
+}
+
+// oraError is an Oracle driver error reporting its ORA- number.
+type oraError int
+
+func (e oraError) Error() string { return fmt.Sprintf("ORA-%05d", int(e)) }
+func (e oraError) Code() int     { return int(e) }
@@ -0,0 +1,286 @@
+// File: db/exec/hook.go
+
+package exec
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/entiqon/db/builder"
+	"github.com/entiqon/db/dialect"
+)
+
+// Hook runs around the builders executed by this package, for logging,
+// metrics, slow query detection or policy checks. Register hooks globally
+// with AddHook or per runner with WithHooks.
+//
+// Before hooks run in registration order, global hooks first, and After
+// hooks in reverse order. An error returned by BeforeBuild, AfterBuild or
+// BeforeExec short-circuits the call: the statement is not run and the
+// caller receives the error as is. Every hook whose Before method ran still
+// has its After method called, so hooks may pair them, as spans do. Hooks
+// must be safe for concurrent use.
+//
+// Embed NopHook to implement only some of the methods.
+type Hook interface {
+	// BeforeBuild is called before the builder is rendered. Event holds
+	// the operation, the builder, its Debug() output and the dialect name.
+	BeforeBuild(ctx context.Context, e *Event) error
+
+	// AfterBuild is called once the builder is rendered, with Event.SQL
+	// and Event.Args set, or Event.Err on failure. It may rewrite SQL and
+	// Args before they are run. It is also called when a later hook's
+	// BeforeBuild or AfterBuild failed, with that error, and its own error
+	// is then ignored.
+	AfterBuild(ctx context.Context, e *Event) error
+
+	// BeforeExec is called before the statement runs. The context it
+	// returns, when not nil, is used to run the statement and is passed to
+	// AfterExec.
+	BeforeExec(ctx context.Context, e *Event) (context.Context, error)
+
+	// AfterExec is called once the statement ran, with Event.Duration,
+	// Event.Err and, for Exec, Event.Result set. It is also called when a
+	// later hook's BeforeExec failed, with that error.
+	AfterExec(ctx context.Context, e *Event)
+}
+
+// Event describes a builder executed through hooks.
+type Event struct {
+	// Op is the operation: OpQuery, OpExec or OpQueryRow. ScanAll,
+	// ScanOne, Iter and IterKeyset run queries.
+	Op string
+
+	// Builder is the builder executed, and Debug its Debug() output.
+	Builder Builder
+	Debug   string
+
+	// Dialect is the name of the dialect the builder is rendered for, or
+	// an empty string for dialect-neutral SQL.
+	Dialect string
+
+	// SQL and Args are the rendered statement, set from AfterBuild on.
+	SQL  string
+	Args []any
+
+	// Result is the result of Exec operations, set in AfterExec.
+	Result sql.Result
+
+	// Duration is the time taken by the statement, set in AfterExec.
+	Duration time.Duration
+
+	// Err is the build error in AfterBuild and the execution error,
+	// wrapped in an *Error, in AfterExec.
+	Err error
+
+	dialect dialect.SQLDialect
+}
+
+// Fingerprint returns the fingerprint of the statement shape: that of the
+// builder when it implements builder.Fingerprinter, as SelectBuilder does,
+// or builder.Fingerprint of SQL otherwise. Statements differing only by
+// their values or IN list lengths share it, which makes it a key for
+// latency metrics:
+//
+//	func (h latency) AfterExec(_ context.Context, e *exec.Event) {
+//	    h.histogram.WithLabelValues(e.Fingerprint()).Observe(e.Duration.Seconds())
+//	}
+func (e *Event) Fingerprint() string {
+	if f, ok := e.Builder.(builder.Fingerprinter); ok {
+		return f.Fingerprint()
+	}
+	return builder.Fingerprint(e.SQL)
+}
+
+// NopHook implements Hook with methods doing nothing. Embed it in hooks
+// that only need some of the methods:
+//
+//	type slowQueries struct{ exec.NopHook }
+//
+//	func (slowQueries) AfterExec(ctx context.Context, e *exec.Event) {
+//	    if e.Duration > time.Second {
+//	        log.Printf("slow %s (%s): %s", e.Op, e.Duration, e.SQL)
+//	    }
+//	}
+type NopHook struct{}
+
+func (NopHook) BeforeBuild(context.Context, *Event) error { return nil }
+func (NopHook) AfterBuild(context.Context, *Event) error  { return nil }
+func (NopHook) AfterExec(context.Context, *Event)         {}
+
+func (NopHook) BeforeExec(ctx context.Context, _ *Event) (context.Context, error) {
+	return ctx, nil
+}
+
+// globalHooks are the hooks registered with AddHook.
+var globalHooks struct {
+	sync.RWMutex
+	hooks []*Hook
+}
+
+// AddHook registers h for every builder executed by this package, on any
+// runner. It returns a function unregistering it:
+//
+//	remove := exec.AddHook(metrics)
+//	defer remove()
+func AddHook(h Hook) (remove func()) {
+	ref := &h
+	globalHooks.Lock()
+	globalHooks.hooks = append(globalHooks.hooks, ref)
+	globalHooks.Unlock()
+
+	return func() {
+		globalHooks.Lock()
+		defer globalHooks.Unlock()
+		globalHooks.hooks = slices.DeleteFunc(globalHooks.hooks, func(r *Hook) bool { return r == ref })
+	}
+}
+
+// WithHooks returns r with hooks added to those it already runs, for the
+// builders executed on it. The dialect r is bound to is kept, and
+// transactions begun by WithTx on the returned runner run the hooks too.
+func WithHooks(r Runner, hooks ...Hook) Runner {
+	switch b := r.(type) {
+	case *boundRunner:
+		cp := *b
+		cp.hooks = append(slices.Clip(b.hooks), hooks...)
+		return &cp
+	case *txRunner:
+		cp := *b
+		cp.hooks = append(slices.Clip(b.hooks), hooks...)
+		return &cp
+	}
+	return &boundRunner{Runner: r, hooks: hooks}
+}
+
+// hooksOf returns the global hooks followed by those of r.
+func hooksOf(r Runner) []Hook {
+	var hooks []Hook
+	globalHooks.RLock()
+	for _, h := range globalHooks.hooks {
+		hooks = append(hooks, *h)
+	}
+	globalHooks.RUnlock()
+
+	switch b := r.(type) {
+	case *boundRunner:
+		hooks = append(hooks, b.hooks...)
+	case *txRunner:
+		hooks = append(hooks, b.hooks...)
+	}
+	return hooks
+}
+
+// statement is a builder rendered and run on a runner through its hooks.
+type statement struct {
+	q     Builder
+	r     Runner
+	hooks []Hook
+	event Event
+}
+
+// newStatement prepares the execution of q on r for op. Builds are
+// rendered for d, or as build does when d is nil.
+func newStatement(op string, q Builder, r Runner, d dialect.SQLDialect) *statement {
+	s := &statement{q: q, r: r, hooks: hooksOf(r), event: Event{Op: op, Builder: q}}
+	if len(s.hooks) > 0 {
+		if d == nil {
+			d = renderDialect(q, r)
+		}
+		s.event.Debug, s.event.dialect = q.Debug(), d
+		if d != nil {
+			s.event.Dialect = d.Name()
+		}
+	}
+	return s
+}
+
+// render builds q with render, or with build when render is nil, through
+// the build hooks.
+func (s *statement) render(ctx context.Context, render func() (string, []any, error)) error {
+	if render == nil {
+		render = func() (string, []any, error) { return build(s.q, s.r) }
+	}
+	var err error
+	entered := 0
+	for _, h := range s.hooks {
+		if err = h.BeforeBuild(ctx, &s.event); err != nil {
+			break
+		}
+		entered++
+	}
+	if err == nil {
+		s.event.SQL, s.event.Args, err = render()
+	}
+	s.event.Err = err
+	for _, h := range slices.Backward(s.hooks[:entered]) {
+		if herr := h.AfterBuild(ctx, &s.event); herr != nil && err == nil {
+			err, s.event.Err = herr, herr
+		}
+	}
+	s.event.Err = nil
+	return err
+}
+
+// run calls fn through the execution hooks. Errors returned by fn are
+// wrapped for op; errors from BeforeExec are returned as is.
+func (s *statement) run(ctx context.Context, fn func(ctx context.Context) error) error {
+	entered := 0
+	for _, h := range s.hooks {
+		next, err := h.BeforeExec(ctx, &s.event)
+		if err != nil {
+			s.event.Err = err
+			s.afterExec(ctx, entered)
+			return err
+		}
+		if next != nil {
+			ctx = next
+		}
+		entered++
+	}
+
+	start := time.Now()
+	err := fn(ctx)
+	if err != nil {
+		err = s.wrap(s.event.Op, err)
+	}
+	s.event.Duration, s.event.Err = time.Since(start), err
+	s.afterExec(ctx, entered)
+	return err
+}
+
+// afterExec runs the AfterExec hooks of the first n hooks, in reverse.
+func (s *statement) afterExec(ctx context.Context, n int) {
+	for _, h := range slices.Backward(s.hooks[:n]) {
+		h.AfterExec(ctx, &s.event)
+	}
+}
+
+// query runs the statement as a query.
+func (s *statement) query(ctx context.Context) (*sql.Rows, error) {
+	var rows *sql.Rows
+	err := s.run(ctx, func(ctx context.Context) (err error) {
+		rows, err = s.r.QueryContext(ctx, s.event.SQL, s.event.Args...)
+		return err
+	})
+	return rows, err
+}
+
+// wrap wraps a driver error for op with the statement.
+func (s *statement) wrap(op string, err error) error {
+	return wrap(op, s.q, s.r, s.event.SQL, s.event.Args, err)
+}
+
+// renderDialect returns the dialect q is rendered for on r, or nil.
+func renderDialect(q Builder, r Runner) dialect.SQLDialect {
+	if d := DialectOf(r); d != nil {
+		return d
+	}
+	if b, ok := q.(interface{ Dialect() dialect.SQLDialect }); ok {
+		return b.Dialect()
+	}
+	return nil
+}
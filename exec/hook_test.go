@@ -0,0 +1,235 @@
+// File: db/exec/hook_test.go
+
+package exec_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/entiqon/db/builder/selects"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/exec"
+	"github.com/entiqon/db/internal/drivertest"
+	"github.com/entiqon/db/token/types/operator"
+)
+
+// ctxKey marks contexts passed along by recorder.BeforeExec.
+type ctxKey struct{}
+
+// recorder is a Hook recording its calls as "<name>.<method>" and the
+// events it saw.
+type recorder struct {
+	name   string
+	mu     *sync.Mutex
+	calls  *[]string
+	events []exec.Event
+
+	fail    string // method returning errHook
+	rewrite func(*exec.Event)
+}
+
+var errHook = errors.New("denied by hook")
+
+func (h *recorder) record(method string, e *exec.Event) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	*h.calls = append(*h.calls, h.name+"."+method)
+	h.events = append(h.events, *e)
+	if h.fail == method {
+		return errHook
+	}
+	return nil
+}
+
+func (h *recorder) BeforeBuild(_ context.Context, e *exec.Event) error {
+	return h.record("BeforeBuild", e)
+}
+
+func (h *recorder) AfterBuild(_ context.Context, e *exec.Event) error {
+	if h.rewrite != nil {
+		h.rewrite(e)
+	}
+	return h.record("AfterBuild", e)
+}
+
+func (h *recorder) BeforeExec(ctx context.Context, e *exec.Event) (context.Context, error) {
+	return context.WithValue(ctx, ctxKey{}, h.name), h.record("BeforeExec", e)
+}
+
+func (h *recorder) AfterExec(ctx context.Context, e *exec.Event) {
+	_ = h.record("AfterExec", e)
+	if ctx.Value(ctxKey{}) == nil {
+		panic("AfterExec expects the context returned by BeforeExec")
+	}
+}
+
+func TestHooks(t *testing.T) {
+	ctx := context.Background()
+	users := func() selects.SelectBuilder {
+		return selects.New(nil).Fields("id").From("users").Where("id", operator.Equal, 7)
+	}
+	setup := func(t *testing.T) (*drivertest.Driver, *sql.DB, func(name string) *recorder, *[]string) {
+		drv := drivertest.New()
+		db := drv.DB()
+		t.Cleanup(func() { _ = db.Close() })
+		var mu sync.Mutex
+		calls := &[]string{}
+		hook := func(name string) *recorder {
+			return &recorder{name: name, mu: &mu, calls: calls}
+		}
+		return drv, db, hook, calls
+	}
+	expect := func(t *testing.T, calls *[]string, want ...string) {
+		t.Helper()
+		if !reflect.DeepEqual(*calls, want) {
+			t.Errorf("expected calls %q, got %q", want, *calls)
+		}
+	}
+
+	t.Run("Order", func(t *testing.T) {
+		drv, db, hook, calls := setup(t)
+		global, local := hook("global"), hook("local")
+		t.Cleanup(exec.AddHook(global))
+		drv.Respond = func(string, []any) (drivertest.Result, error) {
+			return drivertest.Result{RowsAffected: 3}, nil
+		}
+
+		r := exec.WithHooks(exec.Bind(db, oracle.New()), local)
+		if _, err := exec.Exec(ctx, users(), r); err != nil {
+			t.Fatal(err)
+		}
+		expect(t, calls,
+			"global.BeforeBuild", "local.BeforeBuild",
+			"local.AfterBuild", "global.AfterBuild",
+			"global.BeforeExec", "local.BeforeExec",
+			"local.AfterExec", "global.AfterExec",
+		)
+
+		before, after := local.events[0], local.events[3]
+		if before.Op != exec.OpExec || before.Dialect != "oracle" || before.SQL != "" ||
+			!strings.HasPrefix(before.Debug, "SelectBuilder{") {
+			t.Errorf("unexpected BeforeBuild event %+v", before)
+		}
+		if after.SQL != "SELECT id FROM users WHERE id = :1" || !reflect.DeepEqual(after.Args, []any{7}) ||
+			after.Err != nil || after.Duration <= 0 || after.Result == nil {
+			t.Errorf("unexpected AfterExec event %+v", after)
+		}
+		if n, _ := after.Result.RowsAffected(); n != 3 {
+			t.Errorf("expected result with 3 rows affected, got %d", n)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		drv, db, hook, _ := setup(t)
+		errBoom := errors.New("boom")
+		drv.Respond = func(string, []any) (drivertest.Result, error) {
+			return drivertest.Result{}, errBoom
+		}
+		h := hook("h")
+
+		_, err := exec.Query(ctx, users(), exec.WithHooks(db, h))
+		var e *exec.Error
+		if !errors.As(err, &e) || !errors.Is(err, errBoom) {
+			t.Fatalf("expected wrapped driver error, got %v", err)
+		}
+		if got := h.events[len(h.events)-1].Err; got != err {
+			t.Errorf("expected AfterExec to see %v, got %v", err, got)
+		}
+
+		_, err = exec.Query(ctx, selects.New(nil), exec.WithHooks(db, h))
+		if err == nil || errors.As(err, &e) {
+			t.Fatalf("expected build error, got %v", err)
+		}
+		if got := h.events[len(h.events)-1]; got.Err == nil {
+			t.Errorf("expected AfterBuild to see the build error, got %+v", got)
+		}
+	})
+
+	t.Run("ShortCircuit", func(t *testing.T) {
+		for _, method := range []string{"BeforeBuild", "AfterBuild", "BeforeExec"} {
+			t.Run(method, func(t *testing.T) {
+				drv, db, hook, calls := setup(t)
+				first, second := hook("first"), hook("second")
+				second.fail = method
+				r := exec.WithHooks(db, first, second)
+
+				if _, err := exec.Exec(ctx, users(), r); err != errHook {
+					t.Errorf("Exec: expected hook error, got %v", err)
+				}
+				if _, err := exec.ScanAll[int](ctx, users(), r); err != errHook {
+					t.Errorf("ScanAll: expected hook error, got %v", err)
+				}
+				var id int
+				if err := exec.QueryRow(ctx, users(), r).Scan(&id); err != errHook {
+					t.Errorf("QueryRow: expected hook error, got %v", err)
+				}
+				if n := len(drv.Calls()); n != 0 {
+					t.Errorf("expected no statement to run, got %q", drv.Queries())
+				}
+				after := "first.AfterBuild"
+				if method == "BeforeExec" {
+					after = "first.AfterExec"
+				}
+				if got := (*calls)[len(*calls)-1]; got != after || first.events[len(first.events)-1].Err != errHook {
+					t.Errorf("expected %s to see the hook error, got %q", after, *calls)
+				}
+			})
+		}
+	})
+
+	t.Run("Rewrite", func(t *testing.T) {
+		drv, db, hook, _ := setup(t)
+		h := hook("h")
+		h.rewrite = func(e *exec.Event) {
+			e.SQL += " /* app='billing' */"
+		}
+		if _, err := exec.Exec(ctx, users(), exec.WithHooks(db, h)); err != nil {
+			t.Fatal(err)
+		}
+		if got := drv.Queries(); len(got) != 1 || !strings.HasSuffix(got[0], "/* app='billing' */") {
+			t.Errorf("expected rewritten SQL, got %q", got)
+		}
+	})
+
+	t.Run("Runners", func(t *testing.T) {
+		drv, db, hook, calls := setup(t)
+		drv.Respond = func(string, []any) (drivertest.Result, error) {
+			return drivertest.Result{Columns: []string{"id"}, Rows: [][]any{{int64(7)}}}, nil
+		}
+		h := hook("h")
+		r := exec.Bind(exec.WithHooks(db, h), oracle.New())
+
+		err := exec.WithTx(ctx, r, nil, func(tx exec.Runner) error {
+			_, err := exec.ScanOne[int](ctx, users(), tx)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for range exec.Iter[int](ctx, users(), r) {
+		}
+		if n := len(*calls); n != 8 {
+			t.Errorf("expected hooks to run in transactions and iterators, got %q", *calls)
+		}
+		if d := h.events[0].Dialect; d != "oracle" {
+			t.Errorf("expected dialect to survive Bind, got %q", d)
+		}
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		_, db, hook, calls := setup(t)
+		remove := exec.AddHook(hook("global"))
+		remove()
+		if _, err := exec.Exec(ctx, users(), db); err != nil {
+			t.Fatal(err)
+		}
+		if len(*calls) != 0 {
+			t.Errorf("expected removed hook not to run, got %q", *calls)
+		}
+	})
+}
@@ -0,0 +1,267 @@
+// File: db/exec/iter.go
+
+package exec
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"iter"
+	"reflect"
+	"strings"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/generic"
+	"github.com/entiqon/db/internal/record"
+)
+
+// DefaultPageSize is the number of rows fetched per statement by IterKeyset
+// when Keyset.PageSize is not set.
+const DefaultPageSize = 1000
+
+// Iter runs q on r and yields its rows one at a time, mapped onto T with
+// the rules of ScanAll. Rows are never loaded all at once, so result sets
+// of any size can be streamed:
+//
+//	for user, err := range exec.Iter[User](ctx, sb, db) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    export(user)
+//	}
+//
+// The underlying *sql.Rows is closed when the loop ends, including on
+// break. Errors are yielded once, with a zero T, and end the iteration;
+// they are wrapped as for ScanAll.
+func Iter[T any](ctx context.Context, q Builder, r Runner) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		s := newStatement(OpQuery, q, r, nil)
+		if err := s.render(ctx, nil); err != nil {
+			yield(zero, err)
+			return
+		}
+		rows, err := s.query(ctx)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer rows.Close()
+
+		if _, ok, err := yieldRows(rows, aliases(q), yield); ok && err != nil {
+			yield(zero, s.wrap(OpScan, err))
+		}
+	}
+}
+
+// Keyset configures IterKeyset.
+type Keyset struct {
+	// Columns are the key columns, as named in the query results. Together
+	// they must be unique and not null; rows are ordered by them.
+	Columns []string
+
+	// Desc walks the keys in descending order.
+	Desc bool
+
+	// PageSize is the number of rows fetched per statement. Zero means
+	// DefaultPageSize.
+	PageSize int
+}
+
+// IterKeyset yields the rows of q like Iter, but fetches them in pages of
+// keys.PageSize rows, each page run as its own statement. A page resumes
+// after the key of the last row yielded (keyset or "seek" pagination), so
+// no cursor or transaction stays open between pages, and each statement
+// remains as cheap as the first, unlike OFFSET.
+//
+// Every page wraps q in a derived table, ordered by the key columns:
+//
+//	SELECT * FROM (<q>) keyset WHERE (id > ?) ORDER BY id LIMIT 1000
+//
+// so q must select the key columns and should not be ordered or limited
+// itself. Composite keys seek with (a > ?) OR (a = ? AND b > ?). T must
+// hold the key columns, or be a single-column type when there is one key.
+//
+// Pages are rendered for the dialect r is bound to, else the dialect of q
+// when it exposes one (SelectBuilder.Dialect), else the generic dialect.
+//
+// Example:
+//
+//	keys := exec.Keyset{Columns: []string{"id"}, PageSize: 5000}
+//	for order, err := range exec.IterKeyset[Order](ctx, sb, db, keys) {
+//	    ...
+//	}
+func IterKeyset[T any](ctx context.Context, q Builder, r Runner, keys Keyset) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		if len(keys.Columns) == 0 {
+			yield(zero, errors.New("keyset pagination requires at least one key column"))
+			return
+		}
+		keyOf, err := keyFunc[T](keys.Columns)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		size := keys.PageSize
+		if size <= 0 {
+			size = DefaultPageSize
+		}
+		d := keysetDialect(q, r)
+		names := aliases(q)
+
+		var last T
+		var seek []any
+		for {
+			s := newStatement(OpQuery, q, r, d)
+			if err := s.render(ctx, func() (string, []any, error) {
+				return keysetPage(d, q, keys, size, seek)
+			}); err != nil {
+				yield(zero, err)
+				return
+			}
+			rows, err := s.query(ctx)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			n, ok, err := yieldRows(rows, names, func(v T, err error) bool {
+				last = v
+				return yield(v, err)
+			})
+			_ = rows.Close()
+			if !ok {
+				return
+			}
+			if err != nil {
+				yield(zero, s.wrap(OpScan, err))
+				return
+			}
+			if n < size {
+				return
+			}
+			seek = keyOf(last)
+		}
+	}
+}
+
+// yieldRows scans rows into T values and yields them. It returns the
+// number of rows yielded, false when yield asked to stop, and the scan
+// error, if any, which is left to the caller to yield.
+func yieldRows[T any](rows *sql.Rows, names []string, yield func(T, error) bool) (int, bool, error) {
+	next, err := scanner[T](rows, names)
+	if err != nil {
+		return 0, true, err
+	}
+	n := 0
+	for rows.Next() {
+		v, err := next()
+		if err != nil {
+			return n, true, err
+		}
+		n++
+		if !yield(v, nil) {
+			return n, false, nil
+		}
+	}
+	return n, true, rows.Err()
+}
+
+// keysetDialect returns the dialect keyset pages are rendered for.
+func keysetDialect(q Builder, r Runner) dialect.SQLDialect {
+	if d := renderDialect(q, r); d != nil {
+		return d
+	}
+	return generic.New()
+}
+
+// keysetPage renders the page of q following the key seek, or the first
+// page when seek is nil.
+func keysetPage(d dialect.SQLDialect, q Builder, keys Keyset, size int, seek []any) (string, []any, error) {
+	inner, args, err := q.BuildFor(d)
+	if err != nil {
+		return "", nil, err
+	}
+
+	cols := make([]string, len(keys.Columns))
+	order := make([]string, len(keys.Columns))
+	for i, c := range keys.Columns {
+		cols[i] = d.QuoteIdentifier(c)
+		order[i] = cols[i]
+		if keys.Desc {
+			order[i] += " DESC"
+		}
+	}
+
+	sql := "SELECT * FROM " + dialect.AliasTable(d, "("+inner+")", "keyset")
+	if seek != nil {
+		op := ">"
+		if keys.Desc {
+			op = "<"
+		}
+		bind := func(v any) string {
+			args = append(args, v)
+			return d.Placeholder(len(args))
+		}
+		ors := make([]string, len(cols))
+		for i := range cols {
+			ands := make([]string, 0, i+1)
+			for j := 0; j < i; j++ {
+				ands = append(ands, cols[j]+" = "+bind(seek[j]))
+			}
+			ands = append(ands, cols[i]+" "+op+" "+bind(seek[i]))
+			ors[i] = "(" + strings.Join(ands, " AND ") + ")"
+		}
+		sql += " WHERE " + strings.Join(ors, " OR ")
+	}
+	sql += " ORDER BY " + strings.Join(order, ", ")
+	sql = dialect.Paginate(d, sql, size, 0)
+
+	if err := dialect.CheckPlaceholders(d, len(args)); err != nil {
+		return "", nil, err
+	}
+	return sql, args, nil
+}
+
+// keyFunc returns a function reading the key columns of a T.
+func keyFunc[T any](columns []string) (func(T) []any, error) {
+	t := reflect.TypeFor[T]()
+	st := t
+	if st.Kind() == reflect.Pointer {
+		st = st.Elem()
+	}
+	if !record.IsStruct(st) {
+		if len(columns) != 1 {
+			return nil, fmt.Errorf("%s holds 1 key column, got %d", t, len(columns))
+		}
+		return func(v T) []any { return []any{v} }, nil
+	}
+
+	meta, err := record.Of(st)
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]record.Field, len(columns))
+	for i, c := range columns {
+		f, ok := meta.Lookup(c)
+		if !ok {
+			return nil, fmt.Errorf("%w: key %q has no field in %s", ErrUnknownColumn, c, st)
+		}
+		fields[i] = f
+	}
+	return func(v T) []any {
+		rv := reflect.ValueOf(v)
+		if rv.Kind() == reflect.Pointer {
+			rv = rv.Elem()
+		}
+		key := make([]any, len(fields))
+		for i, f := range fields {
+			if fv, ok := record.Value(rv, f); ok {
+				key[i] = fv.Interface()
+			}
+		}
+		return key
+	}, nil
+}
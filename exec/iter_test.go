@@ -0,0 +1,259 @@
+// File: db/exec/iter_test.go
+
+package exec_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/entiqon/db/builder/selects"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/exec"
+	"github.com/entiqon/db/internal/drivertest"
+	"github.com/entiqon/db/token/types/operator"
+)
+
+type user struct {
+	ID   int64 `db:"id"`
+	Name string
+}
+
+func TestIter(t *testing.T) {
+	ctx := context.Background()
+	errBoom := errors.New("boom")
+
+	setup := func(t *testing.T) (*drivertest.Driver, *sql.DB) {
+		drv := drivertest.New()
+		db := drv.DB()
+		t.Cleanup(func() { _ = db.Close() })
+		return drv, db
+	}
+	users := func() selects.SelectBuilder {
+		return selects.New(nil).Fields("id, name").From("users")
+	}
+	// table answers keyset pages from rows ordered by id: the first page
+	// when the query has no seek argument, else the rows after it. Names
+	// are only returned when selected.
+	table := func(drv *drivertest.Driver, size int, ids ...int64) {
+		drv.Respond = func(query string, args []any) (drivertest.Result, error) {
+			res := drivertest.Result{Columns: []string{"id"}}
+			named := strings.Contains(query, "name")
+			if named {
+				res.Columns = append(res.Columns, "name")
+			}
+			for _, id := range ids {
+				if len(args) > 0 && id <= args[len(args)-1].(int64) {
+					continue
+				}
+				if len(res.Rows) == size {
+					break
+				}
+				row := []any{id}
+				if named {
+					row = append(row, "user")
+				}
+				res.Rows = append(res.Rows, row)
+			}
+			return res, nil
+		}
+	}
+
+	t.Run("Stream", func(t *testing.T) {
+		drv, db := setup(t)
+		table(drv, 10, 1, 2, 3)
+
+		var ids []int64
+		for u, err := range exec.Iter[user](ctx, users(), db) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids = append(ids, u.ID)
+		}
+		if !reflect.DeepEqual(ids, []int64{1, 2, 3}) {
+			t.Errorf("expected ids 1, 2, 3, got %v", ids)
+		}
+		if got := drv.Queries(); len(got) != 1 || got[0] != "SELECT id, name FROM users" {
+			t.Errorf("unexpected queries %q", got)
+		}
+	})
+
+	t.Run("Break", func(t *testing.T) {
+		drv, db := setup(t)
+		table(drv, 10, 1, 2, 3)
+
+		for u, err := range exec.Iter[user](ctx, users(), db) {
+			if err != nil || u.ID != 1 {
+				t.Fatalf("expected user 1, got %+v (%v)", u, err)
+			}
+			break
+		}
+		if n := db.Stats().InUse; n != 0 {
+			t.Errorf("expected rows to be closed on break, %d connections in use", n)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		drv, db := setup(t)
+		drv.Respond = func(string, []any) (drivertest.Result, error) {
+			return drivertest.Result{}, errBoom
+		}
+
+		count := 0
+		for u, err := range exec.Iter[user](ctx, users(), db) {
+			count++
+			var e *exec.Error
+			if !errors.As(err, &e) || e.Op != exec.OpQuery || !errors.Is(err, errBoom) {
+				t.Errorf("expected query error, got %v", err)
+			}
+			if u != (user{}) {
+				t.Errorf("expected zero user with error, got %+v", u)
+			}
+		}
+		if count != 1 {
+			t.Errorf("expected error to be yielded once, got %d", count)
+		}
+
+		for _, err := range exec.Iter[user](ctx, selects.New(nil), db) {
+			if err == nil {
+				t.Error("expected build error")
+			}
+		}
+	})
+
+	t.Run("Keyset", func(t *testing.T) {
+		drv, db := setup(t)
+		table(drv, 2, 1, 2, 3, 4, 5)
+
+		var ids []int64
+		keys := exec.Keyset{Columns: []string{"id"}, PageSize: 2}
+		for u, err := range exec.IterKeyset[user](ctx, users(), exec.Bind(db, oracle.New()), keys) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids = append(ids, u.ID)
+		}
+		if !reflect.DeepEqual(ids, []int64{1, 2, 3, 4, 5}) {
+			t.Errorf("expected ids 1 to 5, got %v", ids)
+		}
+
+		calls := drv.Calls()
+		want := []string{
+			"SELECT * FROM (SELECT id, name FROM users) keyset ORDER BY id FETCH FIRST 2 ROWS ONLY",
+			"SELECT * FROM (SELECT id, name FROM users) keyset WHERE (id > :1) ORDER BY id FETCH FIRST 2 ROWS ONLY",
+			"SELECT * FROM (SELECT id, name FROM users) keyset WHERE (id > :1) ORDER BY id FETCH FIRST 2 ROWS ONLY",
+		}
+		if len(calls) != len(want) {
+			t.Fatalf("expected %d pages, got %q", len(want), drv.Queries())
+		}
+		for i, c := range calls {
+			if c.Query != want[i] {
+				t.Errorf("page %d: expected %q, got %q", i+1, want[i], c.Query)
+			}
+		}
+		if !reflect.DeepEqual(calls[1].Args, []any{int64(2)}) || !reflect.DeepEqual(calls[2].Args, []any{int64(4)}) {
+			t.Errorf("expected seek arguments 2 and 4, got %v and %v", calls[1].Args, calls[2].Args)
+		}
+	})
+
+	t.Run("KeysetBreak", func(t *testing.T) {
+		drv, db := setup(t)
+		table(drv, 2, 1, 2, 3, 4, 5)
+
+		keys := exec.Keyset{Columns: []string{"id"}, PageSize: 2}
+		for id, err := range exec.IterKeyset[int64](ctx, selects.New(nil).Fields("id").From("users"), db, keys) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			if id == 3 {
+				break
+			}
+		}
+		if n := len(drv.Calls()); n != 2 {
+			t.Errorf("expected 2 pages before break, got %d", n)
+		}
+	})
+
+	t.Run("KeysetComposite", func(t *testing.T) {
+		drv, db := setup(t)
+		drv.Respond = func(_ string, args []any) (drivertest.Result, error) {
+			res := drivertest.Result{Columns: []string{"tenant", "id", "name"}}
+			if len(args) == 1 {
+				res.Rows = [][]any{{"acme", int64(7), "Ada"}}
+			}
+			return res, nil
+		}
+		type member struct {
+			Tenant string
+			ID     int64 `db:"id"`
+			Name   string
+		}
+
+		sb := selects.New(oracle.New()).
+			Fields("tenant, id, name").
+			From("members").
+			Where("active", operator.Equal, true)
+		keys := exec.Keyset{Columns: []string{"tenant", "id"}, Desc: true, PageSize: 1}
+		count := 0
+		for _, err := range exec.IterKeyset[member](ctx, sb, db, keys) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			count++
+		}
+		if count != 1 {
+			t.Errorf("expected 1 member, got %d", count)
+		}
+
+		calls := drv.Calls()
+		want := "SELECT * FROM (SELECT tenant, id, name FROM members WHERE active = :1) keyset " +
+			"WHERE (tenant < :2) OR (tenant = :3 AND id < :4) " +
+			"ORDER BY tenant DESC, id DESC FETCH FIRST 1 ROWS ONLY"
+		if len(calls) != 2 || calls[1].Query != want {
+			t.Fatalf("expected second page %q, got %q", want, drv.Queries())
+		}
+		if !reflect.DeepEqual(calls[1].Args, []any{true, "acme", "acme", int64(7)}) {
+			t.Errorf("unexpected seek arguments %v", calls[1].Args)
+		}
+	})
+
+	t.Run("KeysetInvalid", func(t *testing.T) {
+		_, db := setup(t)
+		cases := map[string]struct {
+			keys exec.Keyset
+			iter func(exec.Keyset) error
+		}{
+			"NoKeys": {exec.Keyset{}, func(k exec.Keyset) error {
+				return first(exec.IterKeyset[user](ctx, users(), db, k))
+			}},
+			"UnknownKey": {exec.Keyset{Columns: []string{"email"}}, func(k exec.Keyset) error {
+				err := first(exec.IterKeyset[user](ctx, users(), db, k))
+				if !errors.Is(err, exec.ErrUnknownColumn) {
+					t.Errorf("expected ErrUnknownColumn, got %v", err)
+				}
+				return err
+			}},
+			"ScalarComposite": {exec.Keyset{Columns: []string{"tenant", "id"}}, func(k exec.Keyset) error {
+				return first(exec.IterKeyset[int64](ctx, users(), db, k))
+			}},
+		}
+		for name, tc := range cases {
+			if err := tc.iter(tc.keys); err == nil {
+				t.Errorf("%s: expected error", name)
+			}
+		}
+	})
+}
+
+// first returns the first error yielded by seq.
+func first[T any](seq func(func(T, error) bool)) error {
+	for _, err := range seq {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
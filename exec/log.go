@@ -0,0 +1,225 @@
+// File: db/exec/log.go
+
+package exec
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+	dberrors "github.com/entiqon/db/errors"
+)
+
+// Redacted replaces sensitive arguments in logs.
+const Redacted = "[REDACTED]"
+
+// DefaultSensitiveColumns are the column name patterns LogHook redacts
+// when LogOptions.SensitiveColumns is nil.
+var DefaultSensitiveColumns = []string{
+	"password", "passwd", "secret", "token", "api_key", "apikey",
+	"ssn", "card_number", "cvv", "iban",
+}
+
+// LogOptions configures NewLogHook. The zero value, like a nil
+// *LogOptions, logs statements at info level and failures at error level,
+// redacting DefaultSensitiveColumns.
+type LogOptions struct {
+	// Level is the level of successful statements.
+	Level slog.Level
+
+	// SlowThreshold, when positive, logs statements taking longer at
+	// warning level.
+	SlowThreshold time.Duration
+
+	// SensitiveColumns are matched, case-insensitively, as substrings of
+	// the column each argument is bound to; matching arguments are logged
+	// as Redacted. Nil means DefaultSensitiveColumns; use an empty, non-nil
+	// slice to only redact values marked with Sensitive.
+	SensitiveColumns []string
+}
+
+// logHook is the Hook returned by NewLogHook.
+type logHook struct {
+	NopHook
+	logger *slog.Logger
+	opts   LogOptions
+}
+
+// NewLogHook returns a Hook logging every executed statement to logger,
+// or to slog.Default() when logger is nil. Records hold:
+//
+//   - op, dialect and sql: the operation and the rendered statement, with
+//     its placeholders
+//   - fingerprint: a hash of the statement shape, stable across values
+//     (see Event.Fingerprint)
+//   - args: the bound values, sensitive ones replaced by Redacted
+//   - duration, and rows for Exec operations
+//   - error and error_class (a class of the errors package, such as
+//     "unique violation") on failure
+//
+// Arguments are sensitive when their column matches
+// LogOptions.SensitiveColumns (see dialect.Params) or when they were
+// marked with Sensitive. Builders' Debug() output, which holds raw values,
+// is never logged: the error attribute holds the driver error only, not
+// the *Error wrapping it with the arguments and Debug() output.
+//
+// Example:
+//
+//	db := exec.WithHooks(exec.Bind(sqlDB, oracle.New()), exec.NewLogHook(logger, nil))
+//	// level=INFO msg=statement op=Query dialect=oracle sql="SELECT * FROM users WHERE password = :1"
+//	//     fingerprint=8f3c0d2e51a4b7c6 args=[[REDACTED]] duration=1.2ms
+func NewLogHook(logger *slog.Logger, opts *LogOptions) Hook {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	h := &logHook{logger: logger}
+	if opts != nil {
+		h.opts = *opts
+	}
+	h.opts.SensitiveColumns = sensitivePatterns(h.opts.SensitiveColumns)
+	return h
+}
+
+// AfterBuild logs build failures; successful builds are logged once run.
+func (h *logHook) AfterBuild(ctx context.Context, e *Event) error {
+	if e.Err != nil {
+		h.logger.LogAttrs(ctx, slog.LevelError, "statement",
+			slog.String("op", e.Op),
+			slog.String("dialect", e.Dialect),
+			slog.String("error", driverError(e.Err).Error()),
+		)
+	}
+	return nil
+}
+
+// AfterExec logs the statement.
+func (h *logHook) AfterExec(ctx context.Context, e *Event) {
+	level := h.opts.Level
+	switch {
+	case e.Err != nil:
+		level = slog.LevelError
+	case h.opts.SlowThreshold > 0 && e.Duration > h.opts.SlowThreshold:
+		level = slog.LevelWarn
+	}
+	if !h.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("op", e.Op),
+		slog.String("dialect", e.Dialect),
+		slog.String("sql", e.SQL),
+		slog.String("fingerprint", e.Fingerprint()),
+		slog.Any("args", redact(e, h.opts.SensitiveColumns)),
+		slog.Duration("duration", e.Duration),
+	}
+	if e.Result != nil {
+		if n, err := e.Result.RowsAffected(); err == nil {
+			attrs = append(attrs, slog.Int64("rows", n))
+		}
+	}
+	if e.Err != nil {
+		attrs = append(attrs, slog.String("error", driverError(e.Err).Error()))
+		if class := errorClass(e.Err); class != "" {
+			attrs = append(attrs, slog.String("error_class", class))
+		}
+	}
+	h.logger.LogAttrs(ctx, level, "statement", attrs...)
+}
+
+// sensitivePatterns returns patterns lowercased, or DefaultSensitiveColumns
+// when patterns is nil.
+func sensitivePatterns(patterns []string) []string {
+	if patterns == nil {
+		patterns = DefaultSensitiveColumns
+	}
+	out := make([]string, len(patterns))
+	for i, p := range patterns {
+		out[i] = strings.ToLower(p)
+	}
+	return out
+}
+
+// redact returns the args of e with the values marked with Sensitive, and
+// those bound to a column matching patterns, replaced by Redacted.
+func redact(e *Event, patterns []string) []any {
+	if len(e.Args) == 0 {
+		return nil
+	}
+	out := make([]any, len(e.Args))
+	for i, a := range e.Args {
+		if _, ok := a.(sensitive); ok {
+			out[i] = Redacted
+		} else {
+			out[i] = a
+		}
+	}
+
+	for _, p := range dialect.Params(e.dialect, e.SQL) {
+		if p.Arg >= 0 && p.Arg < len(out) && matches(p.Column, patterns) {
+			out[p.Arg] = Redacted
+		}
+	}
+	return out
+}
+
+// matches reports whether column matches one of the lowercase patterns.
+func matches(column string, patterns []string) bool {
+	if column == "" {
+		return false
+	}
+	column = strings.ToLower(column)
+	for _, p := range patterns {
+		if strings.Contains(column, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// errorClass returns the class of a classified driver error, or an empty
+// string.
+func errorClass(err error) string {
+	var classified *dberrors.DriverError
+	if errors.As(err, &classified) {
+		return classified.Kind.Error()
+	}
+	return ""
+}
+
+// sensitive is a value marked with Sensitive.
+type sensitive struct {
+	value any
+}
+
+// Sensitive marks v as sensitive: LogHook logs it as Redacted, and so do
+// fmt and log/slog, whatever its column. The driver receives v itself:
+//
+//	sb.Where("email", operator.Equal, exec.Sensitive(email))
+//
+// v must be a value the driver accepts, or a driver.Valuer.
+func Sensitive(v any) any {
+	return sensitive{value: v}
+}
+
+// Value implements driver.Valuer, handing the marked value to the driver.
+func (s sensitive) Value() (driver.Value, error) {
+	return driver.DefaultParameterConverter.ConvertValue(s.value)
+}
+
+// String implements fmt.Stringer, hiding the value.
+func (s sensitive) String() string { return Redacted }
+
+// GoString implements fmt.GoStringer, hiding the value.
+func (s sensitive) GoString() string { return Redacted }
+
+// LogValue implements slog.LogValuer, hiding the value.
+func (s sensitive) LogValue() slog.Value { return slog.StringValue(Redacted) }
+
+// Format implements fmt.Formatter, hiding the value from every verb.
+func (s sensitive) Format(f fmt.State, _ rune) { _, _ = f.Write([]byte(Redacted)) }
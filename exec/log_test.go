@@ -0,0 +1,191 @@
+// File: db/exec/log_test.go
+
+package exec_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/entiqon/db/builder"
+	"github.com/entiqon/db/builder/selects"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/exec"
+	"github.com/entiqon/db/internal/drivertest"
+	"github.com/entiqon/db/token/types/operator"
+)
+
+func TestLogHook(t *testing.T) {
+	ctx := context.Background()
+
+	// setup returns a runner logging JSON records, decoded by records.
+	setup := func(t *testing.T, bind bool, opts *exec.LogOptions) (*drivertest.Driver, exec.Runner, func() []map[string]any) {
+		drv := drivertest.New()
+		db := drv.DB()
+		t.Cleanup(func() { _ = db.Close() })
+
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		var r exec.Runner = db
+		if bind {
+			r = exec.Bind(db, oracle.New())
+		}
+		records := func() []map[string]any {
+			var out []map[string]any
+			for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+				var rec map[string]any
+				if err := json.Unmarshal([]byte(line), &rec); err != nil {
+					t.Fatalf("invalid record %q: %v", line, err)
+				}
+				out = append(out, rec)
+			}
+			return out
+		}
+		return drv, exec.WithHooks(r, exec.NewLogHook(logger, opts)), records
+	}
+	login := func() selects.SelectBuilder {
+		return selects.New(nil).
+			Fields("id").
+			From("users").
+			Where("login", operator.Equal, "ada").
+			AndWhere("password_hash", operator.Equal, "hunter2")
+	}
+
+	t.Run("Record", func(t *testing.T) {
+		_, r, records := setup(t, true, nil)
+		if _, err := exec.Query(ctx, login(), r); err != nil {
+			t.Fatal(err)
+		}
+		rec := records()[0]
+		want := map[string]any{
+			"level":   "INFO",
+			"msg":     "statement",
+			"op":      exec.OpQuery,
+			"dialect": "oracle",
+			"sql":     "SELECT id FROM users WHERE login = :1 AND password_hash = :2",
+			"args":    []any{"ada", exec.Redacted},
+		}
+		for k, v := range want {
+			if !reflect.DeepEqual(rec[k], v) {
+				t.Errorf("expected %s=%v, got %v", k, v, rec[k])
+			}
+		}
+		if rec["fingerprint"] == "" || rec["duration"] == nil {
+			t.Errorf("expected fingerprint and duration, got %v", rec)
+		}
+		if strings.Contains(fmt.Sprint(rec), "hunter2") {
+			t.Errorf("sensitive value leaked: %v", rec)
+		}
+	})
+
+	t.Run("Fingerprint", func(t *testing.T) {
+		_, r, records := setup(t, true, nil)
+		var sb selects.SelectBuilder
+		for _, ids := range [][]int{{1, 2}, {3}} {
+			sb = selects.New(nil).From("users").Where("id", operator.In, ids)
+			if _, err := exec.Query(ctx, sb, r); err != nil {
+				t.Fatal(err)
+			}
+		}
+		recs := records()
+		if recs[0]["fingerprint"] != recs[1]["fingerprint"] || recs[0]["fingerprint"] != sb.Fingerprint() {
+			t.Errorf("expected statements of one shape to share the builder fingerprint %s, got %v and %v",
+				sb.Fingerprint(), recs[0]["fingerprint"], recs[1]["fingerprint"])
+		}
+
+		e := &exec.Event{SQL: "SELECT * FROM users WHERE id IN (:1, :2)"}
+		if got, want := e.Fingerprint(), builder.Fingerprint(e.SQL); got != want {
+			t.Errorf("expected events without builder to fingerprint SQL as %s, got %s", want, got)
+		}
+	})
+
+	t.Run("Neutral", func(t *testing.T) {
+		_, r, records := setup(t, false, nil)
+		_, _ = exec.Query(ctx, login(), r)
+		if got := records()[0]["args"]; !reflect.DeepEqual(got, []any{"ada", exec.Redacted}) {
+			t.Errorf("expected redacted :password_hash, got %v", got)
+		}
+	})
+
+	t.Run("Sensitive", func(t *testing.T) {
+		drv, r, records := setup(t, true, &exec.LogOptions{SensitiveColumns: []string{}})
+		sb := login().AndWhere("email", operator.Equal, exec.Sensitive("ada@example.com"))
+		if _, err := exec.Query(ctx, sb, r); err != nil {
+			t.Fatal(err)
+		}
+		if got := records()[0]["args"]; !reflect.DeepEqual(got, []any{"ada", "hunter2", exec.Redacted}) {
+			t.Errorf("expected only the marked value to be redacted, got %v", got)
+		}
+
+		bound := drv.Calls()[0].Args[2].(driver.Valuer)
+		if v, err := bound.Value(); err != nil || v != "ada@example.com" {
+			t.Errorf("expected the driver to receive the value, got %v (%v)", v, err)
+		}
+		for _, s := range []string{fmt.Sprint(bound), fmt.Sprintf("%q %#v %d", bound, bound, bound)} {
+			if strings.Contains(s, "ada@") {
+				t.Errorf("fmt leaked the value: %s", s)
+			}
+		}
+	})
+
+	t.Run("Exec", func(t *testing.T) {
+		drv, r, records := setup(t, true, nil)
+		drv.Respond = func(string, []any) (drivertest.Result, error) {
+			return drivertest.Result{RowsAffected: 3}, nil
+		}
+		if _, err := exec.Exec(ctx, login(), r); err != nil {
+			t.Fatal(err)
+		}
+		if rec := records()[0]; rec["op"] != exec.OpExec || rec["rows"] != 3.0 {
+			t.Errorf("expected Exec record with 3 rows, got %v", rec)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		drv, r, records := setup(t, true, nil)
+		drv.Respond = func(string, []any) (drivertest.Result, error) {
+			return drivertest.Result{}, stateError("23505")
+		}
+		_, err := exec.Exec(ctx, login(), r)
+		_, _ = exec.Exec(ctx, selects.New(nil), r)
+
+		recs := records()
+		if len(recs) != 2 {
+			t.Fatalf("expected 2 records, got %v", recs)
+		}
+		if rec := recs[0]; rec["level"] != "ERROR" || rec["error_class"] != "unique violation" {
+			t.Errorf("expected classified error record, got %v", rec)
+		}
+		var execErr *exec.Error
+		if !errors.As(err, &execErr) {
+			t.Fatalf("expected *exec.Error, got %v", err)
+		}
+		if got, want := recs[0]["error"], execErr.Err.Error(); got != want {
+			t.Errorf("expected the driver error %q, got %q", want, got)
+		}
+		for k, v := range recs[0] {
+			if s := fmt.Sprint(v); strings.Contains(s, "hunter2") || strings.Contains(s, execErr.Debug) {
+				t.Errorf("sensitive value leaked in %s: %s", k, s)
+			}
+		}
+		if rec := recs[1]; rec["level"] != "ERROR" || rec["error"] == nil || rec["sql"] != nil {
+			t.Errorf("expected build error record, got %v", rec)
+		}
+	})
+
+	t.Run("Slow", func(t *testing.T) {
+		_, r, records := setup(t, true, &exec.LogOptions{Level: slog.LevelInfo, SlowThreshold: time.Nanosecond})
+		_, _ = exec.Query(ctx, login(), r)
+		if got := records()[0]["level"]; got != "WARN" {
+			t.Errorf("expected slow statement at WARN, got %v", got)
+		}
+	})
+}
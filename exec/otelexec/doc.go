@@ -0,0 +1,29 @@
+/*
+Package otelexec traces the builders executed by package exec with
+OpenTelemetry.
+
+Package exec defines its own small Tracer interface, so that it depends on
+no tracing library. This package adapts OpenTelemetry tracers to it, and
+lives in a module of its own, so that only applications importing it
+depend on OpenTelemetry.
+
+# Usage
+
+	db := exec.WithHooks(exec.Bind(sqlDB, oracle.New()), otelexec.NewHook(nil, nil))
+
+NewHook uses the global tracer provider when given nil. Each execution
+yields a build span and a client span around the statement, carrying the
+db.system, db.operation, db.sql.table, db.statement and db.rows_affected
+attributes; see exec.NewTraceHook. Pass exec.TraceOptions to sanitize,
+inline or omit db.statement:
+
+	hook := otelexec.NewHook(tp, &exec.TraceOptions{Statement: exec.StatementSanitized})
+
+Tracer adapts a trace.Tracer obtained otherwise.
+
+Traceparent tags statements with the trace they run in, through
+exec.NewCommentHook:
+
+	hook := exec.NewCommentHook(&exec.CommentOptions{Func: otelexec.Traceparent})
+*/
+package otelexec
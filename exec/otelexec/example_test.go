@@ -0,0 +1,41 @@
+// File: db/exec/otelexec/example_test.go
+
+package otelexec_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/entiqon/db/builder/selects"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/exec"
+	"github.com/entiqon/db/exec/otelexec"
+	"github.com/entiqon/db/internal/drivertest"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func ExampleNewHook() {
+	ctx := context.Background()
+	sqlDB := drivertest.New().DB()
+	defer sqlDB.Close()
+
+	spans := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+	defer tp.Shutdown(ctx)
+
+	hook := otelexec.NewHook(tp, &exec.TraceOptions{Statement: exec.StatementSanitized})
+	db := exec.WithHooks(exec.Bind(sqlDB, oracle.New()), hook)
+
+	sb := selects.New(nil).Fields("id").From("users")
+	if _, err := exec.Query(ctx, sb, db); err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, s := range spans.Ended() {
+		fmt.Printf("%s (%s)\n", s.Name(), s.SpanKind())
+	}
+	// Output:
+	// build users (internal)
+	// SELECT users (client)
+}
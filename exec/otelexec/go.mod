@@ -0,0 +1,26 @@
+module github.com/entiqon/db/exec/otelexec
+
+go 1.24.2
+
+require (
+	github.com/entiqon/db v1.0.0
+	go.opentelemetry.io/otel v1.37.0
+	go.opentelemetry.io/otel/sdk v1.37.0
+	go.opentelemetry.io/otel/trace v1.37.0
+)
+
+require (
+	github.com/entiqon/common v1.0.0 // indirect
+	github.com/go-logr/logr v1.4.3 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	go.opentelemetry.io/auto/sdk v1.1.0 // indirect
+	go.opentelemetry.io/otel/metric v1.37.0 // indirect
+	golang.org/x/sys v0.33.0 // indirect
+)
+
+// The exec package is not part of a tagged release of github.com/entiqon/db
+// yet, and v1.0.0 predates it, so the adapter builds against the parent
+// module in this repository. Replace the requirement with the first tag
+// that ships exec, and drop this directive, when it is published.
+replace github.com/entiqon/db => ../..
@@ -0,0 +1,118 @@
+// File: db/exec/otelexec/otelexec.go
+
+package otelexec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/entiqon/db/exec"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope of the tracer NewHook obtains
+// from its provider.
+const ScopeName = "github.com/entiqon/db/exec"
+
+// Tracer adapts t to exec.Tracer. Spans carrying db.operation, those
+// around statements, are client spans; build spans are internal ones.
+// Spans ended with an error record it and get an error status; an
+// *exec.Error is recorded as the driver error it wraps.
+func Tracer(t trace.Tracer) exec.Tracer {
+	return tracer{t}
+}
+
+// NewHook returns exec.NewTraceHook with a tracer of tp, or of the global
+// provider when tp is nil:
+//
+//	db := exec.WithHooks(exec.Bind(sqlDB, oracle.New()), otelexec.NewHook(nil, nil))
+func NewHook(tp trace.TracerProvider, opts *exec.TraceOptions) exec.Hook {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	return exec.NewTraceHook(Tracer(tp.Tracer(ScopeName)), opts)
+}
+
+// Traceparent returns the traceparent tag, and tracestate when set, of the
+// span held by ctx in the W3C Trace Context format, or nil when ctx holds
+// no valid span. It fits exec.CommentOptions.Func, so statements carry the
+// trace they ran in:
+//
+//	hook := exec.NewCommentHook(&exec.CommentOptions{Func: otelexec.Traceparent})
+//	// /*traceparent='00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01'*/
+func Traceparent(ctx context.Context, _ *exec.Event) map[string]string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return nil
+	}
+	tags := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, tags)
+	return tags
+}
+
+// tracer is the exec.Tracer returned by Tracer.
+type tracer struct {
+	t trace.Tracer
+}
+
+// Start starts an OpenTelemetry span.
+func (t tracer) Start(ctx context.Context, name string, attrs ...exec.Attribute) (context.Context, exec.Span) {
+	kind := trace.SpanKindInternal
+	for _, a := range attrs {
+		if a.Key == exec.AttrDBOperation {
+			kind = trace.SpanKindClient
+		}
+	}
+	ctx, s := t.t.Start(ctx, name, trace.WithSpanKind(kind), trace.WithAttributes(convert(attrs)...))
+	return ctx, span{s}
+}
+
+// span is the exec.Span of an OpenTelemetry span.
+type span struct {
+	s trace.Span
+}
+
+// SetAttributes sets attributes on the span.
+func (s span) SetAttributes(attrs ...exec.Attribute) {
+	s.s.SetAttributes(convert(attrs)...)
+}
+
+// End records err, if any, and ends the span. An *exec.Error is recorded
+// as the driver error it wraps, without its args and Debug() output.
+func (s span) End(err error) {
+	var e *exec.Error
+	if errors.As(err, &e) {
+		err = e.Err
+	}
+	if err != nil {
+		s.s.RecordError(err)
+		s.s.SetStatus(codes.Error, err.Error())
+	}
+	s.s.End()
+}
+
+// convert returns attrs as OpenTelemetry attributes.
+func convert(attrs []exec.Attribute) []attribute.KeyValue {
+	out := make([]attribute.KeyValue, len(attrs))
+	for i, a := range attrs {
+		switch v := a.Value.(type) {
+		case string:
+			out[i] = attribute.String(a.Key, v)
+		case int64:
+			out[i] = attribute.Int64(a.Key, v)
+		case int:
+			out[i] = attribute.Int(a.Key, v)
+		case bool:
+			out[i] = attribute.Bool(a.Key, v)
+		case float64:
+			out[i] = attribute.Float64(a.Key, v)
+		default:
+			out[i] = attribute.String(a.Key, fmt.Sprint(v))
+		}
+	}
+	return out
+}
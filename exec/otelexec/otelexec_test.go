@@ -0,0 +1,136 @@
+// File: db/exec/otelexec/otelexec_test.go
+
+package otelexec_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/entiqon/db/builder/selects"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/exec"
+	"github.com/entiqon/db/exec/otelexec"
+	"github.com/entiqon/db/internal/drivertest"
+	"github.com/entiqon/db/token/types/operator"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNewHook(t *testing.T) {
+	ctx := context.Background()
+
+	// setup returns a runner bound to oracle and traced into a recorder.
+	setup := func(t *testing.T, opts *exec.TraceOptions) (*drivertest.Driver, exec.Runner, *tracetest.SpanRecorder) {
+		drv := drivertest.New()
+		db := drv.DB()
+		t.Cleanup(func() { _ = db.Close() })
+
+		rec := tracetest.NewSpanRecorder()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+		t.Cleanup(func() { _ = tp.Shutdown(ctx) })
+		return drv, exec.WithHooks(exec.Bind(db, oracle.New()), otelexec.NewHook(tp, opts)), rec
+	}
+	users := selects.New(nil).Fields("id").From("users").Where("id", operator.Equal, 7)
+
+	t.Run("Spans", func(t *testing.T) {
+		drv, r, rec := setup(t, nil)
+		drv.Respond = func(string, []any) (drivertest.Result, error) {
+			return drivertest.Result{RowsAffected: 1}, nil
+		}
+		if _, err := exec.Exec(ctx, users, r); err != nil {
+			t.Fatal(err)
+		}
+		spans := rec.Ended()
+		if len(spans) != 2 {
+			t.Fatalf("expected 2 spans, got %d", len(spans))
+		}
+
+		build, stmt := spans[0], spans[1]
+		if build.Name() != "build users" || build.SpanKind() != trace.SpanKindInternal {
+			t.Errorf("unexpected build span %q (%s)", build.Name(), build.SpanKind())
+		}
+		if stmt.Name() != "SELECT users" || stmt.SpanKind() != trace.SpanKindClient {
+			t.Errorf("unexpected statement span %q (%s)", stmt.Name(), stmt.SpanKind())
+		}
+		if scope := stmt.InstrumentationScope().Name; scope != otelexec.ScopeName {
+			t.Errorf("expected scope %q, got %q", otelexec.ScopeName, scope)
+		}
+		want := map[attribute.Key]attribute.Value{
+			exec.AttrDBSystem:       attribute.StringValue("oracle"),
+			exec.AttrDBOperation:    attribute.StringValue("SELECT"),
+			exec.AttrDBTable:        attribute.StringValue("users"),
+			exec.AttrDBStatement:    attribute.StringValue("SELECT id FROM users WHERE id = :1"),
+			exec.AttrDBRowsAffected: attribute.Int64Value(1),
+		}
+		got := map[attribute.Key]attribute.Value{}
+		for _, kv := range stmt.Attributes() {
+			got[kv.Key] = kv.Value
+		}
+		for k, v := range want {
+			if got[k] != v {
+				t.Errorf("expected %s=%v, got %v", k, v.Emit(), got[k].Emit())
+			}
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		login := selects.New(nil).Fields("id").From("users").Where("password_hash", operator.Equal, "hunter2")
+		modes := []exec.StatementMode{
+			exec.StatementRendered,
+			exec.StatementSanitized,
+			exec.StatementInlined,
+			exec.StatementOmitted,
+		}
+		for _, mode := range modes {
+			drv, r, rec := setup(t, &exec.TraceOptions{Statement: mode})
+			drv.Respond = func(string, []any) (drivertest.Result, error) {
+				return drivertest.Result{}, errors.New("boom")
+			}
+			if _, err := exec.Query(ctx, login, r); err == nil {
+				t.Fatalf("mode %d: expected error", mode)
+			}
+			stmt := rec.Ended()[1]
+			if stmt.Status().Code != codes.Error || stmt.Status().Description != "boom" {
+				t.Errorf("mode %d: expected the driver error as status, got %+v", mode, stmt.Status())
+			}
+			events := stmt.Events()
+			if len(events) != 1 || events[0].Name != "exception" {
+				t.Fatalf("mode %d: expected recorded error, got %+v", mode, events)
+			}
+			for _, kv := range append(stmt.Attributes(), events[0].Attributes...) {
+				if strings.Contains(kv.Value.Emit(), "hunter2") {
+					t.Errorf("mode %d: sensitive value leaked in %s: %s", mode, kv.Key, kv.Value.Emit())
+				}
+			}
+		}
+	})
+
+	t.Run("Traceparent", func(t *testing.T) {
+		if tags := otelexec.Traceparent(ctx, nil); tags != nil {
+			t.Errorf("expected no tags without a span, got %v", tags)
+		}
+
+		drv := drivertest.New()
+		db := drv.DB()
+		t.Cleanup(func() { _ = db.Close() })
+		tp := sdktrace.NewTracerProvider()
+		ctx, span := tp.Tracer("test").Start(ctx, "request")
+		defer span.End()
+
+		hook := exec.NewCommentHook(&exec.CommentOptions{Func: otelexec.Traceparent})
+		if _, err := exec.Exec(ctx, users, exec.WithHooks(exec.Bind(db, oracle.New()), hook)); err != nil {
+			t.Fatal(err)
+		}
+		sc := span.SpanContext()
+		want := "SELECT id FROM users WHERE id = :1 /*traceparent='00-" +
+			sc.TraceID().String() + "-" + sc.SpanID().String() + "-01'*/"
+		if got := drv.Queries(); len(got) != 1 || got[0] != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	})
+}
@@ -0,0 +1,250 @@
+// File: db/exec/scan.go
+
+package exec
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/internal/record"
+	"github.com/entiqon/db/token/field"
+	"github.com/entiqon/db/token/types/identifier"
+)
+
+var (
+	// ErrUnknownColumn is returned when a result column has no destination
+	// field in the target struct.
+	ErrUnknownColumn = errors.New("unknown column")
+
+	// ErrTooManyRows is returned by ScanOne when the query returns more
+	// than one row.
+	ErrTooManyRows = errors.New("too many rows")
+)
+
+// OpScan is reported in Error.Op when rows cannot be mapped.
+const OpScan = "Scan"
+
+// ScanAll runs q on r and maps every row onto a T.
+//
+// T is either a struct (or pointer to struct), whose fields receive the
+// columns of the same name, or a single-column type such as int, string,
+// time.Time or any sql.Scanner.
+//
+// Columns map onto struct fields by their `db:"name"` tag, or by the
+// snake_case form of the field name (CreatedAt → created_at). Matching is
+// case-insensitive, so upper-case columns from Oracle or Snowflake map as
+// well. Fields of embedded structs are promoted, pointer fields receive
+// NULL as nil, and `db:"-"` skips a field.
+//
+// Mapping is strict: a column without a destination fails with
+// ErrUnknownColumn, and values that cannot be converted fail with the
+// driver's scan error, both wrapped in an *Error. Struct fields without a
+// column are left zero. A trailing dialect.RowNumberColumn, added by the
+// ROWNUM pagination of Oracle 11g, is skipped.
+//
+// Column names are taken from the aliases of q's fields when it exposes
+// them, so "SELECT total AS order_total" maps onto `db:"order_total"`.
+//
+// Example:
+//
+//	type User struct {
+//	    ID    int64   `db:"id"`
+//	    Email *string `db:"email"`
+//	}
+//	users, err := exec.ScanAll[User](ctx, selects.New(nil).Fields("id, email").From("users"), db)
+func ScanAll[T any](ctx context.Context, q Builder, r Runner) ([]T, error) {
+	return scanQuery[T](ctx, q, r, 0)
+}
+
+// ScanOne runs q on r and maps its single row onto a T, as ScanAll does.
+// It fails with sql.ErrNoRows when there is no row and with ErrTooManyRows
+// when there is more than one.
+func ScanOne[T any](ctx context.Context, q Builder, r Runner) (T, error) {
+	var zero T
+	out, err := scanQuery[T](ctx, q, r, 2)
+	if err != nil {
+		return zero, err
+	}
+	return out[0], nil
+}
+
+// ScanRows maps the remaining rows of rows onto T values, with the rules
+// of ScanAll. Column names are those reported by the driver. rows is
+// consumed but not closed.
+func ScanRows[T any](rows *sql.Rows) ([]T, error) {
+	return scanRows[T](rows, nil, 0)
+}
+
+// scanQuery runs q and scans at most limit rows (all rows when limit is
+// zero). With a limit, it fails unless exactly one row was read.
+func scanQuery[T any](ctx context.Context, q Builder, r Runner, limit int) ([]T, error) {
+	s := newStatement(OpQuery, q, r, nil)
+	if err := s.render(ctx, nil); err != nil {
+		return nil, err
+	}
+	rows, err := s.query(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out, err := scanRows[T](rows, aliases(q), limit)
+	switch {
+	case err != nil:
+	case limit > 0 && len(out) == 0:
+		err = sql.ErrNoRows
+	case limit > 0 && len(out) > 1:
+		err = ErrTooManyRows
+	}
+	if err != nil {
+		return nil, s.wrap(OpScan, err)
+	}
+	return out, nil
+}
+
+// scanRows reads up to limit rows (all when zero) into T values. Names
+// override the driver's column names when they match in number.
+func scanRows[T any](rows *sql.Rows, names []string, limit int) ([]T, error) {
+	next, err := scanner[T](rows, names)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []T
+	for rows.Next() {
+		v, err := next()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+		if limit > 0 && len(out) == limit {
+			break
+		}
+	}
+	return out, rows.Err()
+}
+
+// scanner resolves the columns of rows onto T and returns a function
+// scanning the current row into a new T. Names override the driver's
+// column names when they match in number.
+func scanner[T any](rows *sql.Rows, names []string) (func() (T, error), error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	numbered := len(columns) > 1 && strings.EqualFold(columns[len(columns)-1], dialect.RowNumberColumn)
+	if numbered {
+		columns = columns[:len(columns)-1]
+	}
+	if len(names) == len(columns) {
+		for i, n := range names {
+			if n != "" {
+				columns[i] = n
+			}
+		}
+	}
+
+	m, err := newMapping(reflect.TypeFor[T](), columns)
+	if err != nil {
+		return nil, err
+	}
+	m.numbered = numbered
+	return func() (T, error) {
+		var v T
+		err := rows.Scan(m.destinations(reflect.ValueOf(&v).Elem())...)
+		return v, err
+	}, nil
+}
+
+// aliases returns the column names announced by q's fields, or nil when
+// q does not expose its fields or selects a wildcard. Aliased fields use
+// their alias and plain columns their unqualified name (u.id → id); other
+// expressions keep the name reported by the driver ("").
+func aliases(q Builder) []string {
+	f, ok := q.(interface{ GetFields() []field.Token })
+	if !ok {
+		return nil
+	}
+	fields := f.GetFields()
+	names := make([]string, len(fields))
+	for i, tok := range fields {
+		if tok == nil || tok.ExpressionKind() == identifier.TypeWildcard {
+			return nil
+		}
+		name := tok.Alias()
+		if name == "" && tok.ExpressionKind() == identifier.TypeExpression {
+			parts := dialect.SplitQualified(tok.Expr(), `"`, `"`)
+			name = parts[len(parts)-1]
+		}
+		if u, quoted := dialect.Unquote(name); quoted {
+			name = u
+		}
+		names[i] = name
+	}
+	return names
+}
+
+// mapping holds, for each column, its destination field. A nil fields
+// slice means T itself is the single destination. When numbered, a
+// trailing row number column is read and discarded.
+type mapping struct {
+	ptr      bool
+	fields   []record.Field
+	numbered bool
+}
+
+// newMapping resolves the destinations of columns in t.
+func newMapping(t reflect.Type, columns []string) (*mapping, error) {
+	m := &mapping{}
+	st := t
+	if st.Kind() == reflect.Pointer && record.IsStruct(st.Elem()) {
+		m.ptr, st = true, st.Elem()
+	}
+	if !record.IsStruct(st) {
+		if len(columns) != 1 {
+			return nil, fmt.Errorf("%s expects 1 column, got %d", t, len(columns))
+		}
+		return m, nil
+	}
+
+	meta, err := record.Of(st)
+	if err != nil {
+		return nil, err
+	}
+	m.fields = make([]record.Field, len(columns))
+	for i, c := range columns {
+		f, ok := meta.Lookup(c)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q has no field in %s", ErrUnknownColumn, c, st)
+		}
+		m.fields[i] = f
+	}
+	return m, nil
+}
+
+// destinations returns the scan destinations of the columns in v,
+// allocating pointer-to-struct values and embedded struct pointers.
+func (m *mapping) destinations(v reflect.Value) []any {
+	if m.ptr {
+		v.Set(reflect.New(v.Type().Elem()))
+		v = v.Elem()
+	}
+	var dest []any
+	if m.fields == nil {
+		dest = []any{v.Addr().Interface()}
+	} else {
+		dest = make([]any, len(m.fields), len(m.fields)+1)
+		for i, f := range m.fields {
+			dest[i] = record.Addr(v, f)
+		}
+	}
+	if m.numbered {
+		dest = append(dest, new(any))
+	}
+	return dest
+}
@@ -0,0 +1,216 @@
+// File: db/exec/scan_test.go
+
+package exec_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/entiqon/db/builder/selects"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/exec"
+	"github.com/entiqon/db/internal/drivertest"
+)
+
+// Audit is embedded by reference to test promoted pointer fields.
+type Audit struct {
+	CreatedAt time.Time
+	UpdatedBy *string `db:"updated_by"`
+}
+
+type base struct {
+	ID int64 `db:"id"`
+}
+
+type order struct {
+	base
+	*Audit
+	Customer   string
+	Total      float64        `db:"order_total"`
+	Note       sql.NullString `db:"note"`
+	Coupon     *string        `db:"coupon"`
+	UserID     int64
+	Ignored    string `db:"-"`
+	unexported string
+}
+
+func TestScan(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 9, 19, 3, 30, 0, 0, time.UTC)
+
+	respond := func(drv *drivertest.Driver, columns []string, rows ...[]any) {
+		drv.Respond = func(string, []any) (drivertest.Result, error) {
+			return drivertest.Result{Columns: columns, Rows: rows}, nil
+		}
+	}
+	setup := func(t *testing.T) (*drivertest.Driver, *sql.DB) {
+		drv := drivertest.New()
+		db := drv.DB()
+		t.Cleanup(func() { _ = db.Close() })
+		return drv, db
+	}
+	orders := selects.New(nil).From("orders")
+
+	t.Run("ScanAll", func(t *testing.T) {
+		drv, db := setup(t)
+		respond(drv,
+			[]string{"ID", "CUSTOMER", "ORDER_TOTAL", "note", "coupon", "created_at", "updated_by", "user_id"},
+			[]any{int64(1), "Ada", 9.5, "gift", nil, now, "ops", int64(7)},
+			[]any{int64(2), "Grace", 3.0, nil, "SAVE", now, nil, int64(8)},
+		)
+
+		got, err := exec.ScanAll[order](ctx, orders, db)
+		if err != nil || len(got) != 2 {
+			t.Fatalf("expected 2 orders, got %v (%v)", got, err)
+		}
+		first, second := got[0], got[1]
+		if first.ID != 1 || first.Customer != "Ada" || first.Total != 9.5 || first.UserID != 7 {
+			t.Errorf("unexpected first order %+v", first)
+		}
+		if !first.Note.Valid || first.Note.String != "gift" || first.Coupon != nil {
+			t.Errorf("unexpected nullable fields %+v", first)
+		}
+		if first.Audit == nil || !first.CreatedAt.Equal(now) || *first.UpdatedBy != "ops" {
+			t.Errorf("expected embedded pointer struct to be filled, got %+v", first.Audit)
+		}
+		if second.Note.Valid || second.Coupon == nil || *second.Coupon != "SAVE" || second.UpdatedBy != nil {
+			t.Errorf("unexpected second order %+v", second)
+		}
+
+		ptrs, err := exec.ScanAll[*order](ctx, orders, db)
+		if err != nil || len(ptrs) != 2 || ptrs[1].Customer != "Grace" {
+			t.Errorf("expected pointers to orders, got %v (%v)", ptrs, err)
+		}
+	})
+
+	t.Run("Aliases", func(t *testing.T) {
+		drv, db := setup(t)
+		respond(drv, []string{"TOTAL", "CUSTOMER"}, []any{12.5, "Ada"})
+
+		sb := selects.New(oracle.New()).
+			Fields("o.total", "order_total").
+			AppendFields("o.customer").
+			From("orders o")
+		got, err := exec.ScanOne[order](ctx, sb, db)
+		if err != nil || got.Total != 12.5 || got.Customer != "Ada" {
+			t.Errorf("expected alias to map onto order_total, got %+v (%v)", got, err)
+		}
+	})
+
+	t.Run("RowNumber", func(t *testing.T) {
+		drv, db := setup(t)
+		var query string
+		drv.Respond = func(q string, _ []any) (drivertest.Result, error) {
+			query = q
+			return drivertest.Result{
+				Columns: []string{"TOTAL", "CUSTOMER", "RN_"},
+				Rows:    [][]any{{12.5, "Ada", int64(21)}, {3.0, "Grace", int64(22)}},
+			}, nil
+		}
+
+		sb := selects.New(oracle.NewWithVersion(11, 2)).
+			Fields("o.total", "order_total").
+			AppendFields("o.customer").
+			From("orders o").
+			Take(10).
+			Skip(20)
+		got, err := exec.ScanAll[order](ctx, sb, db)
+		if err != nil || len(got) != 2 || got[0].Total != 12.5 || got[1].Customer != "Grace" {
+			t.Errorf("expected rn_ to be skipped, got %+v (%v)", got, err)
+		}
+		if !strings.Contains(query, "ROWNUM rn_") {
+			t.Errorf("expected ROWNUM pagination, got %q", query)
+		}
+
+		drv.Respond = func(string, []any) (drivertest.Result, error) {
+			return drivertest.Result{Columns: []string{"ID", "RN_"}, Rows: [][]any{{int64(7), int64(1)}}}, nil
+		}
+		ids, err := exec.ScanAll[int64](ctx, sb, db)
+		if err != nil || len(ids) != 1 || ids[0] != 7 {
+			t.Errorf("expected scalar with rn_ skipped, got %v (%v)", ids, err)
+		}
+	})
+
+	t.Run("Scalar", func(t *testing.T) {
+		drv, db := setup(t)
+		respond(drv, []string{"count"}, []any{int64(3)}, []any{nil})
+
+		counts, err := exec.ScanAll[*int](ctx, orders, db)
+		if err != nil || len(counts) != 2 || *counts[0] != 3 || counts[1] != nil {
+			t.Errorf("unexpected counts %v (%v)", counts, err)
+		}
+
+		respond(drv, []string{"a", "b"}, []any{int64(1), int64(2)})
+		if _, err := exec.ScanAll[int](ctx, orders, db); err == nil || !strings.Contains(err.Error(), "expects 1 column") {
+			t.Errorf("expected column count error, got %v", err)
+		}
+	})
+
+	t.Run("ScanOne", func(t *testing.T) {
+		drv, db := setup(t)
+		respond(drv, []string{"id"})
+		if _, err := exec.ScanOne[order](ctx, orders, db); !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("expected sql.ErrNoRows, got %v", err)
+		}
+
+		respond(drv, []string{"id"}, []any{int64(1)}, []any{int64(2)})
+		_, err := exec.ScanOne[order](ctx, orders, db)
+		var execErr *exec.Error
+		if !errors.Is(err, exec.ErrTooManyRows) || !errors.As(err, &execErr) || execErr.Op != exec.OpScan {
+			t.Errorf("expected ErrTooManyRows, got %v", err)
+		}
+	})
+
+	t.Run("Strict", func(t *testing.T) {
+		drv, db := setup(t)
+		respond(drv, []string{"id", "extra"}, []any{int64(1), "x"})
+		if _, err := exec.ScanAll[order](ctx, orders, db); !errors.Is(err, exec.ErrUnknownColumn) {
+			t.Errorf("expected ErrUnknownColumn, got %v", err)
+		}
+
+		respond(drv, []string{"ignored"}, []any{"x"})
+		if _, err := exec.ScanAll[order](ctx, orders, db); !errors.Is(err, exec.ErrUnknownColumn) {
+			t.Errorf("expected db:\"-\" field to be skipped, got %v", err)
+		}
+
+		respond(drv, []string{"id"}, []any{"not a number"})
+		_, err := exec.ScanAll[order](ctx, orders, db)
+		var execErr *exec.Error
+		if !errors.As(err, &execErr) || execErr.Op != exec.OpScan {
+			t.Errorf("expected wrapped scan error, got %v", err)
+		}
+	})
+
+	t.Run("ScanRows", func(t *testing.T) {
+		drv, db := setup(t)
+		respond(drv, []string{"id", "customer"}, []any{int64(5), "Ada"})
+
+		rows, err := db.QueryContext(ctx, "SELECT id, customer FROM orders")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rows.Close()
+		got, err := exec.ScanRows[order](rows)
+		if err != nil || len(got) != 1 || got[0].ID != 5 {
+			t.Errorf("unexpected rows %v (%v)", got, err)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		drv, db := setup(t)
+		if _, err := exec.ScanAll[order](ctx, selects.New(nil), db); err == nil {
+			t.Error("expected build error")
+		}
+		drv.Respond = func(string, []any) (drivertest.Result, error) {
+			return drivertest.Result{}, errors.New("boom")
+		}
+		var execErr *exec.Error
+		if _, err := exec.ScanOne[order](ctx, orders, db); !errors.As(err, &execErr) || execErr.Op != exec.OpQuery {
+			t.Errorf("expected wrapped query error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,325 @@
+// File: db/exec/stmt.go
+
+package exec
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+
+	"github.com/entiqon/db/dialect"
+	dberrors "github.com/entiqon/db/errors"
+)
+
+// DefaultStmtCacheSize is the number of prepared statements a StmtCache
+// keeps when created with a size of zero.
+const DefaultStmtCacheSize = 256
+
+// StmtCache is a Runner that prepares every distinct SQL string it runs
+// once, on its *sql.DB, and reuses the *sql.Stmt afterwards. Builders
+// rendering the same statement shape share one statement, whatever their
+// arguments.
+//
+// Statements are kept in least recently used order and the oldest is
+// closed when the cache is full. A statement whose preparation fails is
+// not cached, so the next call prepares it again; one whose execution
+// fails with an error not classified by dialect.ClassifyError (a dropped
+// connection, a changed schema) is evicted for the same reason. Errors are
+// classified for the dialect the cache is bound to (see Bind), so vendor
+// codes such as SQL Server error numbers are recognized; on QueryRow, the
+// error is the one reported by sql.Row.Err.
+//
+// database/sql prepares a cached statement again on every connection it
+// runs on. Inside WithTx transactions begun on the cache, cached statements
+// are rebound to the transaction's connection with sql.Tx.StmtContext;
+// statements not cached yet run unprepared there, since preparing them on
+// the database would need a connection besides the transaction's.
+//
+// A StmtCache is safe for concurrent use. Bind it like any Runner:
+//
+//	stmts := exec.NewStmtCache(sqlDB, 0)
+//	defer stmts.Close()
+//	db := exec.Bind(stmts, oracle.New())
+//	rows, err := exec.Query(ctx, sb, db)
+type StmtCache struct {
+	db   *sql.DB
+	size int
+
+	mu      sync.Mutex
+	lru     *list.List // of *cachedStmt, most recently used first
+	entries map[string]*list.Element
+	stats   StmtCacheStats
+}
+
+// StmtCacheStats reports the activity of a StmtCache.
+type StmtCacheStats struct {
+	// Hits counts statements found in the cache.
+	Hits uint64
+
+	// Misses counts statements not found in the cache: prepared, or run
+	// unprepared in a transaction.
+	Misses uint64
+
+	// Evictions counts statements closed to make room, or because they
+	// failed.
+	Evictions uint64
+
+	// Len is the number of statements cached.
+	Len int
+}
+
+// cachedStmt is a statement prepared, or being prepared, by a StmtCache.
+type cachedStmt struct {
+	query string
+	stmt  *sql.Stmt
+	err   error
+	ready chan struct{} // closed once stmt or err is set
+
+	users   int  // calls holding stmt
+	evicted bool // removed from the cache; closed when users drops to 0
+}
+
+// Compile-time checks: a StmtCache runs statements and begins transactions.
+var (
+	_ Runner   = (*StmtCache)(nil)
+	_ beginner = (*StmtCache)(nil)
+)
+
+// NewStmtCache returns a cache of at most size statements prepared on db,
+// or DefaultStmtCacheSize when size is zero or negative.
+func NewStmtCache(db *sql.DB, size int) *StmtCache {
+	if size <= 0 {
+		size = DefaultStmtCacheSize
+	}
+	return &StmtCache{
+		db:      db,
+		size:    size,
+		lru:     list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// DB returns the database statements are prepared on.
+func (c *StmtCache) DB() *sql.DB {
+	return c.db
+}
+
+// ExecContext runs query with the statement cached for it.
+func (c *StmtCache) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return c.execFor(ctx, nil, query, args...)
+}
+
+// QueryContext runs query with the statement cached for it. The statement
+// stays usable until the rows are closed, even if it is evicted meanwhile.
+func (c *StmtCache) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return c.queryFor(ctx, nil, query, args...)
+}
+
+// QueryRowContext runs query with the statement cached for it. When the
+// statement cannot be prepared, the query runs unprepared on the database,
+// so the failure is reported by Row.Scan.
+func (c *StmtCache) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	return c.queryRowFor(ctx, nil, query, args...)
+}
+
+// execFor is ExecContext, classifying failures for d.
+func (c *StmtCache) execFor(ctx context.Context, d dialect.SQLDialect, query string, args ...any) (sql.Result, error) {
+	var res sql.Result
+	err := c.run(ctx, d, query, func(stmt *sql.Stmt) (err error) {
+		res, err = stmt.ExecContext(ctx, args...)
+		return err
+	})
+	return res, err
+}
+
+// queryFor is QueryContext, classifying failures for d.
+func (c *StmtCache) queryFor(ctx context.Context, d dialect.SQLDialect, query string, args ...any) (*sql.Rows, error) {
+	var rows *sql.Rows
+	err := c.run(ctx, d, query, func(stmt *sql.Stmt) (err error) {
+		rows, err = stmt.QueryContext(ctx, args...)
+		return err
+	})
+	return rows, err
+}
+
+// queryRowFor is QueryRowContext, classifying the error of the row for d.
+func (c *StmtCache) queryRowFor(ctx context.Context, d dialect.SQLDialect, query string, args ...any) *sql.Row {
+	e, err := c.acquire(ctx, query)
+	if err != nil {
+		return c.db.QueryRowContext(ctx, query, args...)
+	}
+	row := e.stmt.QueryRowContext(ctx, args...)
+	c.done(e, d, row.Err())
+	return row
+}
+
+// BeginTx begins a transaction on the database. WithTx transactions begun
+// on c run their statements through the cache.
+func (c *StmtCache) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	return c.db.BeginTx(ctx, opts)
+}
+
+// Invalidate closes and forgets the statement cached for query, if any.
+func (c *StmtCache) Invalidate(query string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[query]; ok {
+		c.evict(el)
+	}
+}
+
+// Stats returns the cache counters.
+func (c *StmtCache) Stats() StmtCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := c.stats
+	stats.Len = c.lru.Len()
+	return stats
+}
+
+// Close closes every cached statement. The database stays open and the
+// cache usable; statements are prepared again when needed.
+func (c *StmtCache) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var errs []error
+	for el := c.lru.Front(); el != nil; el = c.lru.Front() {
+		e := el.Value.(*cachedStmt)
+		c.lru.Remove(el)
+		delete(c.entries, e.query)
+		e.evicted = true
+		if e.users == 0 && e.stmt != nil {
+			errs = append(errs, e.stmt.Close())
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// run calls fn with the statement cached for query, preparing it first
+// when needed. Failures are classified for d.
+func (c *StmtCache) run(ctx context.Context, d dialect.SQLDialect, query string, fn func(*sql.Stmt) error) error {
+	e, err := c.acquire(ctx, query)
+	if err != nil {
+		return err
+	}
+	err = fn(e.stmt)
+	c.done(e, d, err)
+	return err
+}
+
+// cached returns the statement cached for query, reserved for the caller
+// until done, without preparing it nor waiting for it to be prepared.
+func (c *StmtCache) cached(query string) (*cachedStmt, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[query]; ok {
+		e := el.Value.(*cachedStmt)
+		select {
+		case <-e.ready:
+			if e.err == nil {
+				e.users++
+				c.lru.MoveToFront(el)
+				c.stats.Hits++
+				return e, true
+			}
+		default:
+		}
+	}
+	c.stats.Misses++
+	return nil, false
+}
+
+// done releases e after a call failing with err, evicting it first when
+// err, classified for d, shows it is stale.
+func (c *StmtCache) done(e *cachedStmt, d dialect.SQLDialect, err error) {
+	if stale(d, err) {
+		c.mu.Lock()
+		if el, ok := c.entries[e.query]; ok && el.Value == e {
+			c.evict(el)
+		}
+		c.mu.Unlock()
+	}
+	c.release(e)
+}
+
+// acquire returns the prepared statement for query, reserved for the
+// caller until release.
+func (c *StmtCache) acquire(ctx context.Context, query string) (*cachedStmt, error) {
+	c.mu.Lock()
+	if el, ok := c.entries[query]; ok {
+		e := el.Value.(*cachedStmt)
+		e.users++
+		c.lru.MoveToFront(el)
+		c.stats.Hits++
+		c.mu.Unlock()
+
+		<-e.ready
+		if e.err != nil {
+			c.release(e)
+			return nil, e.err
+		}
+		return e, nil
+	}
+
+	e := &cachedStmt{query: query, ready: make(chan struct{}), users: 1}
+	c.entries[query] = c.lru.PushFront(e)
+	c.stats.Misses++
+	for c.lru.Len() > c.size {
+		c.evict(c.lru.Back())
+	}
+	c.mu.Unlock()
+
+	e.stmt, e.err = c.db.PrepareContext(ctx, query)
+	close(e.ready)
+	if e.err != nil {
+		c.mu.Lock()
+		if el, ok := c.entries[query]; ok && el.Value == e {
+			c.lru.Remove(el)
+			delete(c.entries, query)
+		}
+		e.evicted = true
+		c.mu.Unlock()
+		c.release(e)
+		return nil, e.err
+	}
+	return e, nil
+}
+
+// release ends a reservation taken by acquire, closing the statement when
+// it was evicted meanwhile.
+func (c *StmtCache) release(e *cachedStmt) {
+	c.mu.Lock()
+	e.users--
+	closing := e.evicted && e.users == 0 && e.stmt != nil
+	c.mu.Unlock()
+	if closing {
+		_ = e.stmt.Close()
+	}
+}
+
+// evict removes el from the cache and closes its statement unless it is
+// in use, in which case release closes it. c.mu must be held.
+func (c *StmtCache) evict(el *list.Element) {
+	e := el.Value.(*cachedStmt)
+	c.lru.Remove(el)
+	delete(c.entries, e.query)
+	e.evicted = true
+	c.stats.Evictions++
+	if e.users == 0 && e.stmt != nil {
+		_ = e.stmt.Close()
+	}
+}
+
+// stale reports whether a statement failing with err should be prepared
+// again. Failures caused by the data or the caller (errors classified for
+// d, sql.ErrNoRows, canceled contexts) leave the statement cached.
+func stale(d dialect.SQLDialect, err error) bool {
+	if err == nil || errors.Is(err, sql.ErrNoRows) ||
+		errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var classified *dberrors.DriverError
+	return !errors.As(dialect.ClassifyError(d, err), &classified)
+}
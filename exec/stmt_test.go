@@ -0,0 +1,255 @@
+// File: db/exec/stmt_test.go
+
+package exec_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/entiqon/db/builder/selects"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/exec"
+	"github.com/entiqon/db/internal/drivertest"
+	"github.com/entiqon/db/token/types/operator"
+)
+
+func TestStmtCache(t *testing.T) {
+	ctx := context.Background()
+	errBoom := errors.New("boom")
+
+	setup := func(t *testing.T, size int) (*drivertest.Driver, *exec.StmtCache) {
+		drv := drivertest.New()
+		db := drv.DB()
+		db.SetMaxOpenConns(1)
+		stmts := exec.NewStmtCache(db, size)
+		t.Cleanup(func() {
+			_ = stmts.Close()
+			_ = db.Close()
+		})
+		return drv, stmts
+	}
+	run := func(t *testing.T, r exec.Runner, queries ...string) {
+		t.Helper()
+		for _, q := range queries {
+			if _, err := r.ExecContext(ctx, q); err != nil {
+				t.Fatalf("%s: %v", q, err)
+			}
+		}
+	}
+	expect := func(t *testing.T, stmts *exec.StmtCache, want exec.StmtCacheStats) {
+		t.Helper()
+		if got := stmts.Stats(); got != want {
+			t.Errorf("expected %+v, got %+v", want, got)
+		}
+	}
+
+	t.Run("Reuse", func(t *testing.T) {
+		drv, stmts := setup(t, 0)
+		users := func(id int) selects.SelectBuilder {
+			return selects.New(nil).From("users").Where("id", operator.Equal, id)
+		}
+		db := exec.Bind(stmts, oracle.New())
+		for id := range 3 {
+			var got int64
+			if err := exec.QueryRow(ctx, users(id), db).Scan(&got); !errors.Is(err, sql.ErrNoRows) {
+				t.Fatalf("expected no rows, got %v", err)
+			}
+		}
+		if n := drv.Prepares(); n != 1 {
+			t.Errorf("expected 1 prepare, got %d", n)
+		}
+		expect(t, stmts, exec.StmtCacheStats{Hits: 2, Misses: 1, Len: 1})
+
+		calls := drv.Calls()
+		if len(calls) != 3 || calls[2].Query != "SELECT * FROM users WHERE id = :1" ||
+			!reflect.DeepEqual(calls[2].Args, []any{2}) {
+			t.Errorf("unexpected calls %v", calls)
+		}
+	})
+
+	t.Run("LRU", func(t *testing.T) {
+		_, stmts := setup(t, 2)
+		run(t, stmts, "A", "B", "A", "C")
+		expect(t, stmts, exec.StmtCacheStats{Hits: 1, Misses: 3, Evictions: 1, Len: 2})
+
+		run(t, stmts, "A", "B")
+		expect(t, stmts, exec.StmtCacheStats{Hits: 2, Misses: 4, Evictions: 2, Len: 2})
+	})
+
+	t.Run("PrepareError", func(t *testing.T) {
+		drv, stmts := setup(t, 0)
+		fail := true
+		drv.PrepareErr = func(string) error {
+			if fail {
+				return errBoom
+			}
+			return nil
+		}
+
+		if _, err := stmts.ExecContext(ctx, "A"); !errors.Is(err, errBoom) {
+			t.Fatalf("expected prepare error, got %v", err)
+		}
+		expect(t, stmts, exec.StmtCacheStats{Misses: 1})
+
+		fail = false
+		run(t, stmts, "A", "A")
+		expect(t, stmts, exec.StmtCacheStats{Hits: 1, Misses: 2, Len: 1})
+		if n := drv.Prepares(); n != 2 {
+			t.Errorf("expected 2 prepares, got %d", n)
+		}
+	})
+
+	t.Run("QueryRowPrepareError", func(t *testing.T) {
+		drv, stmts := setup(t, 0)
+		drv.PrepareErr = func(string) error { return errBoom }
+		drv.Respond = func(string, []any) (drivertest.Result, error) {
+			return drivertest.Result{}, errBoom
+		}
+
+		var n int
+		if err := stmts.QueryRowContext(ctx, "A").Scan(&n); !errors.Is(err, errBoom) {
+			t.Errorf("expected error from Scan, got %v", err)
+		}
+	})
+
+	t.Run("Invalidation", func(t *testing.T) {
+		drv, stmts := setup(t, 0)
+		var err error
+		drv.Respond = func(string, []any) (drivertest.Result, error) {
+			return drivertest.Result{}, err
+		}
+
+		err = stateError("23505")
+		if _, got := stmts.ExecContext(ctx, "A"); !errors.Is(got, err) {
+			t.Fatalf("expected unique violation, got %v", got)
+		}
+		expect(t, stmts, exec.StmtCacheStats{Misses: 1, Len: 1})
+
+		err = errBoom
+		if _, got := stmts.ExecContext(ctx, "A"); !errors.Is(got, errBoom) {
+			t.Fatalf("expected boom, got %v", got)
+		}
+		expect(t, stmts, exec.StmtCacheStats{Hits: 1, Misses: 1, Evictions: 1})
+
+		err = nil
+		run(t, stmts, "A")
+		stmts.Invalidate("A")
+		expect(t, stmts, exec.StmtCacheStats{Hits: 1, Misses: 2, Evictions: 2})
+	})
+
+	t.Run("BoundDialect", func(t *testing.T) {
+		drv, stmts := setup(t, 0)
+		drv.Respond = func(string, []any) (drivertest.Result, error) {
+			return drivertest.Result{}, oraError(1)
+		}
+
+		// ORA-00001 is a unique violation for Oracle only.
+		db := exec.Bind(stmts, oracle.New())
+		if _, err := db.ExecContext(ctx, "A"); !errors.Is(err, oraError(1)) {
+			t.Fatalf("expected ORA-00001, got %v", err)
+		}
+		expect(t, stmts, exec.StmtCacheStats{Misses: 1, Len: 1})
+
+		if _, err := stmts.ExecContext(ctx, "A"); !errors.Is(err, oraError(1)) {
+			t.Fatalf("expected ORA-00001, got %v", err)
+		}
+		expect(t, stmts, exec.StmtCacheStats{Hits: 1, Misses: 1, Evictions: 1})
+	})
+
+	t.Run("QueryRowError", func(t *testing.T) {
+		drv, stmts := setup(t, 0)
+		run(t, stmts, "A")
+		drv.Respond = func(string, []any) (drivertest.Result, error) {
+			return drivertest.Result{}, errBoom
+		}
+
+		var n int
+		if err := exec.Bind(stmts, oracle.New()).QueryRowContext(ctx, "A").Scan(&n); !errors.Is(err, errBoom) {
+			t.Fatalf("expected boom, got %v", err)
+		}
+		expect(t, stmts, exec.StmtCacheStats{Hits: 1, Misses: 1, Evictions: 1})
+
+		drv.Respond = nil
+		run(t, stmts, "A")
+		err := exec.WithTx(ctx, stmts, nil, func(tx exec.Runner) error {
+			drv.Respond = func(string, []any) (drivertest.Result, error) {
+				return drivertest.Result{}, errBoom
+			}
+			return tx.QueryRowContext(ctx, "A").Err()
+		})
+		if !errors.Is(err, errBoom) {
+			t.Fatalf("expected boom, got %v", err)
+		}
+		expect(t, stmts, exec.StmtCacheStats{Hits: 2, Misses: 2, Evictions: 2})
+	})
+
+	t.Run("Tx", func(t *testing.T) {
+		drv, stmts := setup(t, 0)
+		db := exec.Bind(stmts, oracle.New())
+		run(t, db, "A")
+		drv.Reset()
+		err := exec.WithTx(ctx, db, nil, func(tx exec.Runner) error {
+			run(t, tx, "A", "B")
+			return exec.WithTx(ctx, tx, nil, func(tx exec.Runner) error {
+				run(t, tx, "A")
+				return nil
+			})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []string{drivertest.Begin, "A", "B", "SAVEPOINT sp_1", "A", drivertest.Commit}
+		if got := drv.Queries(); !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+		// A is rebound to the transaction; B, not cached, runs unprepared.
+		if n := drv.Prepares(); n != 0 {
+			t.Errorf("expected no prepare in the transaction, got %d", n)
+		}
+		expect(t, stmts, exec.StmtCacheStats{Hits: 2, Misses: 2, Len: 1})
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		drv := drivertest.New()
+		db := drv.DB()
+		defer db.Close()
+		stmts := exec.NewStmtCache(db, 4)
+		defer stmts.Close()
+
+		var wg sync.WaitGroup
+		for i := range 32 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := range 20 {
+					q := string(rune('A' + (i+j)%6))
+					rows, err := stmts.QueryContext(ctx, q)
+					if err != nil {
+						t.Error(err)
+						return
+					}
+					_ = rows.Close()
+				}
+			}()
+		}
+		wg.Wait()
+		if s := stmts.Stats(); s.Hits+s.Misses != 32*20 || s.Len > 4 {
+			t.Errorf("unexpected stats %+v", s)
+		}
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		_, stmts := setup(t, 0)
+		run(t, stmts, "A", "B")
+		if err := stmts.Close(); err != nil {
+			t.Fatal(err)
+		}
+		expect(t, stmts, exec.StmtCacheStats{Misses: 2})
+		run(t, stmts, "A")
+		expect(t, stmts, exec.StmtCacheStats{Misses: 3, Len: 1})
+	})
+}
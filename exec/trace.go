@@ -0,0 +1,244 @@
+// File: db/exec/trace.go
+
+package exec
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/token/table"
+	"github.com/entiqon/db/token/types/identifier"
+)
+
+// Span attributes set by NewTraceHook, named after the OpenTelemetry
+// semantic conventions for database clients.
+const (
+	AttrDBSystem       = "db.system"
+	AttrDBStatement    = "db.statement"
+	AttrDBOperation    = "db.operation"
+	AttrDBTable        = "db.sql.table"
+	AttrDBRowsAffected = "db.rows_affected"
+)
+
+// Attribute is a key/value pair set on a span. Values are strings or
+// int64.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+// Tracer starts spans. It is the part of a tracing library NewTraceHook
+// needs, so that this package depends on none: package otelexec adapts
+// OpenTelemetry tracers to it, and other libraries take a few lines.
+type Tracer interface {
+	// Start starts a span named name, child of the span held by ctx, and
+	// returns a context holding the new span.
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+// Span is a span started by a Tracer.
+type Span interface {
+	// SetAttributes sets attributes on the span.
+	SetAttributes(attrs ...Attribute)
+
+	// End ends the span, recording err as its failure when not nil. For
+	// statements, err is the driver error, not the *Error wrapping it, so
+	// spans never carry args nor Debug() output.
+	End(err error)
+}
+
+// StatementMode selects how NewTraceHook records db.statement.
+type StatementMode int
+
+const (
+	// StatementRendered records the statement as run, with placeholders
+	// in place of its args.
+	StatementRendered StatementMode = iota
+
+	// StatementSanitized also replaces the literals written into the
+	// statement with "?", as dialect.Sanitize does.
+	StatementSanitized
+
+	// StatementInlined replaces placeholders with their args, as
+	// dialect.Interpolate does, sensitive args redacted as by NewLogHook.
+	// Dialect-neutral statements are recorded as rendered.
+	StatementInlined
+
+	// StatementOmitted leaves db.statement out.
+	StatementOmitted
+)
+
+// TraceOptions configures NewTraceHook. The zero value, like a nil
+// *TraceOptions, records statements as rendered.
+type TraceOptions struct {
+	// Statement selects how db.statement is recorded.
+	Statement StatementMode
+
+	// SensitiveColumns are the column patterns whose args are redacted by
+	// StatementInlined, as in LogOptions. Nil means DefaultSensitiveColumns.
+	SensitiveColumns []string
+}
+
+// systems maps dialect names to db.system values, where they differ.
+var systems = map[string]string{
+	"":         "other_sql",
+	"generic":  "other_sql",
+	"postgres": "postgresql",
+}
+
+// traceHook is the Hook returned by NewTraceHook.
+type traceHook struct {
+	tracer Tracer
+	opts   TraceOptions
+
+	builds sync.Map // *Event → Span, from BeforeBuild to AfterBuild
+	execs  sync.Map // *Event → Span, from BeforeExec to AfterExec
+}
+
+// NewTraceHook returns a Hook tracing the builders executed by this
+// package with tracer. Each execution yields two sibling spans:
+//
+//   - "build <table>", around rendering, with db.system and db.sql.table
+//   - "<operation> <table>", around the statement, with db.system,
+//     db.operation (SELECT, INSERT, ...), db.sql.table, db.statement and,
+//     for Exec, db.rows_affected
+//
+// db.system is the dialect name, spelled as the conventions do
+// ("postgresql", and "other_sql" for generic or neutral SQL); db.sql.table
+// is the table of builders exposing Table(), such as SelectBuilder. The
+// statement span is held by the context the statement runs with, so
+// instrumented drivers nest their spans under it. Failed spans record the
+// error: for statements, the driver error only, whatever the
+// StatementMode, since the *Error wrapping it holds the args and the
+// builder's Debug() output.
+//
+// Example:
+//
+//	db := exec.WithHooks(exec.Bind(sqlDB, oracle.New()),
+//	    exec.NewTraceHook(otelexec.Tracer(otel.Tracer("billing")), nil))
+func NewTraceHook(tracer Tracer, opts *TraceOptions) Hook {
+	h := &traceHook{tracer: tracer}
+	if opts != nil {
+		h.opts = *opts
+	}
+	h.opts.SensitiveColumns = sensitivePatterns(h.opts.SensitiveColumns)
+	return h
+}
+
+// BeforeBuild starts the build span.
+func (h *traceHook) BeforeBuild(ctx context.Context, e *Event) error {
+	attrs := []Attribute{{AttrDBSystem, dbSystem(e.Dialect)}}
+	name := "build"
+	if t := tableOf(e.Builder); t != "" {
+		attrs = append(attrs, Attribute{AttrDBTable, t})
+		name += " " + t
+	}
+	_, span := h.tracer.Start(ctx, name, attrs...)
+	h.builds.Store(e, span)
+	return nil
+}
+
+// AfterBuild ends the build span.
+func (h *traceHook) AfterBuild(_ context.Context, e *Event) error {
+	if span, ok := h.builds.LoadAndDelete(e); ok {
+		span.(Span).End(e.Err)
+	}
+	return nil
+}
+
+// BeforeExec starts the statement span and returns the context holding it.
+func (h *traceHook) BeforeExec(ctx context.Context, e *Event) (context.Context, error) {
+	attrs := []Attribute{{AttrDBSystem, dbSystem(e.Dialect)}}
+	name := e.Op
+	if op := operation(e.SQL); op != "" {
+		attrs = append(attrs, Attribute{AttrDBOperation, op})
+		name = op
+	}
+	if t := tableOf(e.Builder); t != "" {
+		attrs = append(attrs, Attribute{AttrDBTable, t})
+		name += " " + t
+	}
+	if h.opts.Statement != StatementOmitted {
+		attrs = append(attrs, Attribute{AttrDBStatement, h.statement(e)})
+	}
+
+	ctx, span := h.tracer.Start(ctx, name, attrs...)
+	h.execs.Store(e, span)
+	return ctx, nil
+}
+
+// AfterExec ends the statement span.
+func (h *traceHook) AfterExec(_ context.Context, e *Event) {
+	v, ok := h.execs.LoadAndDelete(e)
+	if !ok {
+		return
+	}
+	span := v.(Span)
+	if e.Result != nil {
+		if n, err := e.Result.RowsAffected(); err == nil {
+			span.SetAttributes(Attribute{AttrDBRowsAffected, n})
+		}
+	}
+	span.End(driverError(e.Err))
+}
+
+// statement returns the db.statement of e.
+func (h *traceHook) statement(e *Event) string {
+	switch h.opts.Statement {
+	case StatementSanitized:
+		return dialect.Sanitize(e.dialect, e.SQL)
+	case StatementInlined:
+		if e.dialect == nil {
+			break
+		}
+		if sql, err := dialect.Interpolate(e.dialect, e.SQL, redact(e, h.opts.SensitiveColumns)); err == nil {
+			return sql
+		}
+	}
+	return e.SQL
+}
+
+// dbSystem returns the db.system of the dialect named name.
+func dbSystem(name string) string {
+	if s, ok := systems[name]; ok {
+		return s
+	}
+	return name
+}
+
+// operation returns the first keyword of sql, uppercased, past leading
+// parentheses and comments.
+func operation(sql string) string {
+	for {
+		sql = strings.TrimLeft(sql, " \t\r\n(")
+		switch {
+		case strings.HasPrefix(sql, "--"):
+			_, sql, _ = strings.Cut(sql, "\n")
+		case strings.HasPrefix(sql, "/*"):
+			_, sql, _ = strings.Cut(sql, "*/")
+		default:
+			end := strings.IndexFunc(sql, func(r rune) bool {
+				return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z')
+			})
+			if end < 0 {
+				end = len(sql)
+			}
+			return strings.ToUpper(sql[:end])
+		}
+	}
+}
+
+// tableOf returns the name of the table of q, when q exposes one.
+func tableOf(q Builder) string {
+	b, ok := q.(interface{ Table() table.Token })
+	if !ok {
+		return ""
+	}
+	t := b.Table()
+	if t == nil || !t.IsValid() || t.ExpressionKind() == identifier.TypeSubquery {
+		return ""
+	}
+	return t.Name()
+}
@@ -0,0 +1,247 @@
+// File: db/exec/trace_test.go
+
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/entiqon/db/builder/selects"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/exec"
+	"github.com/entiqon/db/internal/drivertest"
+	"github.com/entiqon/db/token/types/operator"
+)
+
+// spanKey holds the span of contexts returned by memTracer.Start.
+type spanKey struct{}
+
+// memSpan is a span recorded by memTracer.
+type memSpan struct {
+	name   string
+	parent *memSpan
+	attrs  map[string]any
+	err    error
+	ended  bool
+}
+
+func (s *memSpan) SetAttributes(attrs ...exec.Attribute) {
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value
+	}
+}
+
+func (s *memSpan) End(err error) {
+	s.err, s.ended = err, true
+}
+
+// memTracer is an in-memory exec.Tracer.
+type memTracer struct {
+	mu    sync.Mutex
+	spans []*memSpan
+}
+
+func (t *memTracer) Start(ctx context.Context, name string, attrs ...exec.Attribute) (context.Context, exec.Span) {
+	parent, _ := ctx.Value(spanKey{}).(*memSpan)
+	s := &memSpan{name: name, parent: parent, attrs: map[string]any{}}
+	s.SetAttributes(attrs...)
+	t.mu.Lock()
+	t.spans = append(t.spans, s)
+	t.mu.Unlock()
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+// ctxProbe records the span of the context statements run with.
+type ctxProbe struct {
+	exec.NopHook
+	span *memSpan
+}
+
+func (p *ctxProbe) BeforeExec(ctx context.Context, _ *exec.Event) (context.Context, error) {
+	p.span, _ = ctx.Value(spanKey{}).(*memSpan)
+	return ctx, nil
+}
+
+func TestTraceHook(t *testing.T) {
+	ctx := context.Background()
+
+	// setup returns a runner traced by a memTracer, bound to oracle when
+	// bind is set.
+	setup := func(t *testing.T, bind bool, opts *exec.TraceOptions, hooks ...exec.Hook) (*drivertest.Driver, exec.Runner, *memTracer) {
+		drv := drivertest.New()
+		db := drv.DB()
+		t.Cleanup(func() { _ = db.Close() })
+
+		var r exec.Runner = db
+		if bind {
+			r = exec.Bind(db, oracle.New())
+		}
+		tracer := &memTracer{}
+		hooks = append([]exec.Hook{exec.NewTraceHook(tracer, opts)}, hooks...)
+		return drv, exec.WithHooks(r, hooks...), tracer
+	}
+	login := func() selects.SelectBuilder {
+		return selects.New(nil).
+			Fields("id").
+			AppendFields("'vip'", "tier").
+			From("users").
+			Where("login", operator.Equal, "ada").
+			AndWhere("password_hash", operator.Equal, "hunter2")
+	}
+	attrs := func(t *testing.T, s *memSpan, want map[string]any) {
+		t.Helper()
+		if !reflect.DeepEqual(s.attrs, want) {
+			t.Errorf("span %q: expected attributes %v, got %v", s.name, want, s.attrs)
+		}
+	}
+
+	t.Run("Spans", func(t *testing.T) {
+		drv, r, tracer := setup(t, true, nil)
+		drv.Respond = func(string, []any) (drivertest.Result, error) {
+			return drivertest.Result{RowsAffected: 3}, nil
+		}
+		if _, err := exec.Exec(ctx, login(), r); err != nil {
+			t.Fatal(err)
+		}
+		if n := len(tracer.spans); n != 2 {
+			t.Fatalf("expected 2 spans, got %d", n)
+		}
+
+		build, stmt := tracer.spans[0], tracer.spans[1]
+		if build.name != "build users" || !build.ended || build.err != nil || build.parent != nil {
+			t.Errorf("unexpected build span %+v", build)
+		}
+		attrs(t, build, map[string]any{
+			exec.AttrDBSystem: "oracle",
+			exec.AttrDBTable:  "users",
+		})
+		if stmt.name != "SELECT users" || !stmt.ended || stmt.err != nil || stmt.parent != nil {
+			t.Errorf("unexpected statement span %+v", stmt)
+		}
+		attrs(t, stmt, map[string]any{
+			exec.AttrDBSystem:       "oracle",
+			exec.AttrDBOperation:    "SELECT",
+			exec.AttrDBTable:        "users",
+			exec.AttrDBStatement:    "SELECT id, 'vip' AS tier FROM users WHERE login = :1 AND password_hash = :2",
+			exec.AttrDBRowsAffected: int64(3),
+		})
+	})
+
+	t.Run("Context", func(t *testing.T) {
+		probe := &ctxProbe{}
+		_, r, tracer := setup(t, true, nil, probe)
+		if _, err := exec.Query(ctx, login(), r); err != nil {
+			t.Fatal(err)
+		}
+		if probe.span == nil || probe.span != tracer.spans[1] {
+			t.Errorf("expected statement to run with the statement span, got %+v", probe.span)
+		}
+	})
+
+	t.Run("Statement", func(t *testing.T) {
+		tests := []struct {
+			mode exec.StatementMode
+			want any
+		}{
+			{exec.StatementSanitized, "SELECT id, ? AS tier FROM users WHERE login = :1 AND password_hash = :2"},
+			{exec.StatementInlined, "SELECT id, 'vip' AS tier FROM users WHERE login = 'ada' AND password_hash = '[REDACTED]'"},
+			{exec.StatementOmitted, nil},
+		}
+		for _, tt := range tests {
+			_, r, tracer := setup(t, true, &exec.TraceOptions{Statement: tt.mode})
+			if _, err := exec.Query(ctx, login(), r); err != nil {
+				t.Fatal(err)
+			}
+			if got := tracer.spans[1].attrs[exec.AttrDBStatement]; got != tt.want {
+				t.Errorf("mode %d: expected statement %v, got %v", tt.mode, tt.want, got)
+			}
+		}
+	})
+
+	t.Run("Neutral", func(t *testing.T) {
+		_, r, tracer := setup(t, false, &exec.TraceOptions{Statement: exec.StatementInlined})
+		sb := selects.New(nil).From("(SELECT 1) t")
+		if _, err := exec.Query(ctx, sb, r); err != nil {
+			t.Fatal(err)
+		}
+		build, stmt := tracer.spans[0], tracer.spans[1]
+		if build.name != "build" || stmt.name != "SELECT" {
+			t.Errorf("expected spans without table, got %q and %q", build.name, stmt.name)
+		}
+		attrs(t, stmt, map[string]any{
+			exec.AttrDBSystem:    "other_sql",
+			exec.AttrDBOperation: "SELECT",
+			exec.AttrDBStatement: "SELECT * FROM (SELECT 1) AS t",
+		})
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		drv, r, tracer := setup(t, true, nil)
+		errBoom := errors.New("boom")
+		drv.Respond = func(string, []any) (drivertest.Result, error) {
+			return drivertest.Result{}, errBoom
+		}
+		_, err := exec.Query(ctx, login(), r)
+		if stmt := tracer.spans[1]; !stmt.ended || stmt.err != errBoom {
+			t.Errorf("expected statement span to record the driver error %v, got %+v", errBoom, stmt)
+		}
+
+		_, err = exec.Query(ctx, selects.New(nil), r)
+		if build := tracer.spans[2]; err == nil || !build.ended || build.err != err {
+			t.Errorf("expected build span to record %v, got %+v", err, build)
+		}
+		if n := len(tracer.spans); n != 3 {
+			t.Errorf("expected no statement span for a build error, got %d spans", n)
+		}
+	})
+
+	t.Run("ErrorsByMode", func(t *testing.T) {
+		errBoom := errors.New("boom")
+		modes := []exec.StatementMode{
+			exec.StatementRendered,
+			exec.StatementSanitized,
+			exec.StatementInlined,
+			exec.StatementOmitted,
+		}
+		for _, mode := range modes {
+			drv, r, tracer := setup(t, true, &exec.TraceOptions{Statement: mode})
+			drv.Respond = func(string, []any) (drivertest.Result, error) {
+				return drivertest.Result{}, errBoom
+			}
+			if _, err := exec.Query(ctx, login(), r); err == nil {
+				t.Fatalf("mode %d: expected error", mode)
+			}
+			stmt := tracer.spans[1]
+			if stmt.err != errBoom {
+				t.Errorf("mode %d: expected the driver error, got %v", mode, stmt.err)
+			}
+			if s := fmt.Sprint(stmt.attrs, stmt.err); strings.Contains(s, "hunter2") {
+				t.Errorf("mode %d: sensitive value leaked: %s", mode, s)
+			}
+		}
+	})
+
+	t.Run("ShortCircuit", func(t *testing.T) {
+		deny := &recorder{name: "deny", mu: &sync.Mutex{}, calls: &[]string{}}
+		for _, method := range []string{"BeforeBuild", "BeforeExec"} {
+			deny.fail = method
+			_, r, tracer := setup(t, true, nil, deny)
+			if _, err := exec.Exec(ctx, login(), r); err != errHook {
+				t.Fatalf("%s: expected hook error, got %v", method, err)
+			}
+			for _, s := range tracer.spans {
+				if !s.ended {
+					t.Errorf("%s: expected span %q to end, got %+v", method, s.name, s)
+				}
+			}
+			if last := tracer.spans[len(tracer.spans)-1]; last.err != errHook {
+				t.Errorf("%s: expected span %q to record the hook error, got %v", method, last.name, last.err)
+			}
+		}
+	})
+}
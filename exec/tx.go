@@ -0,0 +1,293 @@
+// File: db/exec/tx.go
+
+package exec
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+	dberrors "github.com/entiqon/db/errors"
+)
+
+// ErrNoTransactions is returned by WithTx when the runner can neither
+// begin a transaction nor is one.
+var ErrNoTransactions = errors.New("runner cannot begin transactions")
+
+// OpTx is reported in Error.Op when a transaction statement (BEGIN,
+// COMMIT, ROLLBACK or a savepoint) fails.
+const OpTx = "Tx"
+
+// DefaultMaxAttempts is the number of times WithTx runs a transaction
+// failing with retryable errors, unless TxOptions says otherwise.
+const DefaultMaxAttempts = 3
+
+// TxOptions configures WithTx. The zero value, like a nil *TxOptions,
+// uses the driver's isolation level and DefaultMaxAttempts.
+type TxOptions struct {
+	// Isolation is the isolation level of the transaction.
+	Isolation sql.IsolationLevel
+
+	// ReadOnly starts a read-only transaction.
+	ReadOnly bool
+
+	// MaxAttempts is the number of times the transaction is run when it
+	// fails with a retryable error. Zero means DefaultMaxAttempts and 1
+	// disables retries.
+	MaxAttempts int
+
+	// Backoff returns the delay before the attempt following attempt. Nil
+	// means exponential backoff with jitter, from 10ms up to 1s.
+	Backoff func(attempt int) time.Duration
+
+	// Retryable reports whether a failed transaction can be run again.
+	// Nil means IsRetryable, with the error codes of the bound dialect.
+	Retryable func(err error) bool
+}
+
+// beginner starts transactions. It is satisfied by *sql.DB and *sql.Conn.
+type beginner interface {
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+// txRunner is the Runner handed to WithTx callbacks. It remembers its
+// nesting depth, so nested WithTx calls open savepoints, the StmtCache
+// the transaction was begun on, if any, and the hooks of its runner.
+type txRunner struct {
+	*sql.Tx
+	dialect dialect.SQLDialect
+	depth   int
+	stmts   *StmtCache
+	hooks   []Hook
+}
+
+// ExecContext runs query in the transaction, with the cached statement
+// for it when the transaction was begun on a StmtCache.
+func (t *txRunner) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	stmt, done := t.stmt(ctx, query)
+	if stmt == nil {
+		return t.Tx.ExecContext(ctx, query, args...)
+	}
+	defer stmt.Close()
+	res, err := stmt.ExecContext(ctx, args...)
+	done(err)
+	return res, err
+}
+
+// QueryContext runs query in the transaction like ExecContext.
+func (t *txRunner) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	stmt, done := t.stmt(ctx, query)
+	if stmt == nil {
+		return t.Tx.QueryContext(ctx, query, args...)
+	}
+	rows, err := stmt.QueryContext(ctx, args...)
+	done(err)
+	return rows, err
+}
+
+// QueryRowContext runs query in the transaction like ExecContext.
+func (t *txRunner) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	stmt, done := t.stmt(ctx, query)
+	if stmt == nil {
+		return t.Tx.QueryRowContext(ctx, query, args...)
+	}
+	row := stmt.QueryRowContext(ctx, args...)
+	done(row.Err())
+	return row
+}
+
+// stmt returns the statement cached for query rebound to the transaction,
+// and the function to call with its outcome, or nil when the transaction
+// has no StmtCache or query is not cached. Statements used by rows are
+// closed with the transaction.
+func (t *txRunner) stmt(ctx context.Context, query string) (*sql.Stmt, func(error)) {
+	if t.stmts == nil {
+		return nil, nil
+	}
+	e, ok := t.stmts.cached(query)
+	if !ok {
+		return nil, nil
+	}
+	return t.Tx.StmtContext(ctx, e.stmt), func(err error) { t.stmts.done(e, t.dialect, err) }
+}
+
+// WithTx runs fn in a transaction begun on r, committing it when fn
+// returns nil and rolling it back when fn fails or panics. The panic is
+// then propagated.
+//
+// Calling WithTx again with the Runner received by fn, or with a *sql.Tx,
+// nests: fn runs inside a savepoint, released on success and rolled back
+// to on failure, leaving the enclosing transaction usable. Savepoint
+// statements are rendered for the dialect r is bound to (see Bind):
+// standard SAVEPOINT by default, ON ROLLBACK RETAIN CURSORS on Db2, no
+// RELEASE on Oracle, SAVE TRANSACTION and ROLLBACK TRANSACTION without
+// RELEASE on SQL Server (dialect/mssql).
+//
+// A top-level transaction failing with a retryable error (serialization
+// failures and deadlocks, classified for the bound dialect; see
+// IsRetryable) is rolled back and run again, up to opts.MaxAttempts times
+// with backoff between attempts, so fn must be safe to repeat. Nested
+// calls are never retried: the error belongs to the enclosing transaction.
+//
+// Transactions begun on a StmtCache run their statements through it.
+//
+// Example:
+//
+//	err := exec.WithTx(ctx, db, nil, func(tx exec.Runner) error {
+//	    if _, err := exec.Exec(ctx, debit, tx); err != nil {
+//	        return err
+//	    }
+//	    return exec.WithTx(ctx, tx, nil, func(tx exec.Runner) error {
+//	        _, err := exec.Exec(ctx, audit, tx) // rolled back alone on failure
+//	        return err
+//	    })
+//	})
+func WithTx(ctx context.Context, r Runner, opts *TxOptions, fn func(tx Runner) error) error {
+	if opts == nil {
+		opts = &TxOptions{}
+	}
+	d := DialectOf(r)
+
+	target := r
+	var hooks []Hook
+	switch t := r.(type) {
+	case *txRunner:
+		nested := *t
+		nested.depth++
+		return savepoint(ctx, &nested, fn)
+	case *boundRunner:
+		target, hooks = t.Runner, t.hooks
+	}
+	if tx, ok := target.(*sql.Tx); ok {
+		return savepoint(ctx, &txRunner{Tx: tx, dialect: d, depth: 1, hooks: hooks}, fn)
+	}
+	db, ok := target.(beginner)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrNoTransactions, target)
+	}
+	stmts, _ := target.(*StmtCache)
+	run := txRunner{dialect: d, stmts: stmts, hooks: hooks}
+
+	attempts := opts.MaxAttempts
+	if attempts <= 0 {
+		attempts = DefaultMaxAttempts
+	}
+	retryable := opts.Retryable
+	if retryable == nil {
+		retryable = func(err error) bool { return isRetryable(d, err) }
+	}
+	backoff := opts.Backoff
+	if backoff == nil {
+		backoff = defaultBackoff
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := runTx(ctx, db, run, opts, fn)
+		if err == nil || attempt >= attempts || !retryable(err) {
+			return err
+		}
+		timer := time.NewTimer(backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// runTx runs fn in one transaction on db, with a copy of run.
+func runTx(ctx context.Context, db beginner, run txRunner, opts *TxOptions, fn func(Runner) error) error {
+	d := run.dialect
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: opts.Isolation, ReadOnly: opts.ReadOnly})
+	if err != nil {
+		return txError(d, "BEGIN", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	run.Tx = tx
+	if err := fn(&run); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			return errors.Join(err, txError(d, "ROLLBACK", rbErr))
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return txError(d, "COMMIT", err)
+	}
+	return nil
+}
+
+// savepoint runs fn inside savepoint sp_<depth> of tx.
+func savepoint(ctx context.Context, tx *txRunner, fn func(Runner) error) error {
+	d := tx.dialect
+	name := fmt.Sprintf("sp_%d", tx.depth)
+	set, err := dialect.Savepoint(d, name)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Tx.ExecContext(ctx, set); err != nil {
+		return txError(d, set, err)
+	}
+
+	undo := dialect.RollbackToSavepoint(d, name)
+	defer func() {
+		if p := recover(); p != nil {
+			_, _ = tx.Tx.ExecContext(ctx, undo)
+			panic(p)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		if _, rbErr := tx.Tx.ExecContext(ctx, undo); rbErr != nil {
+			return errors.Join(err, txError(d, undo, rbErr))
+		}
+		return err
+	}
+	if release := dialect.ReleaseSavepoint(d, name); release != "" {
+		if _, err := tx.Tx.ExecContext(ctx, release); err != nil {
+			return txError(d, release, err)
+		}
+	}
+	return nil
+}
+
+// txError builds the *Error reported for a failed transaction statement.
+func txError(d dialect.SQLDialect, stmt string, err error) error {
+	return &Error{Op: OpTx, SQL: stmt, Err: dialect.ClassifyError(d, err)}
+}
+
+// IsRetryable reports whether err, or an error it wraps, is a
+// serialization failure or a deadlock (dberrors.ErrSerialization,
+// dberrors.ErrDeadlock): a transaction aborted by the database that can
+// succeed when run again. Errors not yet classified are classified with
+// dialect.StandardErrorCodes; WithTx also uses the codes of the bound
+// dialect.
+func IsRetryable(err error) bool {
+	return isRetryable(nil, err)
+}
+
+// isRetryable reports whether err is retryable once classified for d.
+func isRetryable(d dialect.SQLDialect, err error) bool {
+	err = dialect.ClassifyError(d, err)
+	return errors.Is(err, dberrors.ErrSerialization) || errors.Is(err, dberrors.ErrDeadlock)
+}
+
+// defaultBackoff doubles the delay on every attempt, from 10ms up to 1s,
+// and waits a random duration between half and all of it.
+func defaultBackoff(attempt int) time.Duration {
+	d := 10 * time.Millisecond << (attempt - 1)
+	if d <= 0 || d > time.Second {
+		d = time.Second
+	}
+	return d/2 + rand.N(d/2+1)
+}
@@ -0,0 +1,233 @@
+// File: db/exec/tx_test.go
+
+package exec_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/mssql"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/dialect/snowflake"
+	"github.com/entiqon/db/exec"
+	"github.com/entiqon/db/internal/drivertest"
+)
+
+// stateError is a driver error reporting a SQLSTATE.
+type stateError string
+
+func (e stateError) Error() string    { return "sqlstate " + string(e) }
+func (e stateError) SQLState() string { return string(e) }
+
+func TestWithTx(t *testing.T) {
+	ctx := context.Background()
+	errBoom := errors.New("boom")
+	noWait := &exec.TxOptions{Backoff: func(int) time.Duration { return 0 }}
+
+	setup := func(t *testing.T) (*drivertest.Driver, *sql.DB) {
+		drv := drivertest.New()
+		db := drv.DB()
+		t.Cleanup(func() { _ = db.Close() })
+		return drv, db
+	}
+	run := func(stmt string) func(exec.Runner) error {
+		return func(tx exec.Runner) error {
+			_, err := tx.ExecContext(ctx, stmt)
+			return err
+		}
+	}
+	expect := func(t *testing.T, drv *drivertest.Driver, want ...string) {
+		t.Helper()
+		if got := drv.Queries(); !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	}
+
+	t.Run("Commit", func(t *testing.T) {
+		drv, db := setup(t)
+		if err := exec.WithTx(ctx, db, nil, run("INSERT 1")); err != nil {
+			t.Fatal(err)
+		}
+		expect(t, drv, drivertest.Begin, "INSERT 1", drivertest.Commit)
+	})
+
+	t.Run("Rollback", func(t *testing.T) {
+		drv, db := setup(t)
+		err := exec.WithTx(ctx, db, nil, func(tx exec.Runner) error {
+			_ = run("INSERT 1")(tx)
+			return errBoom
+		})
+		if !errors.Is(err, errBoom) {
+			t.Fatalf("expected boom, got %v", err)
+		}
+		expect(t, drv, drivertest.Begin, "INSERT 1", drivertest.Rollback)
+	})
+
+	t.Run("Panic", func(t *testing.T) {
+		drv, db := setup(t)
+		defer func() {
+			if p := recover(); p != "boom" {
+				t.Errorf("expected the panic to propagate, got %v", p)
+			}
+			expect(t, drv, drivertest.Begin, drivertest.Rollback)
+		}()
+		_ = exec.WithTx(ctx, db, nil, func(exec.Runner) error { panic("boom") })
+	})
+
+	t.Run("Nested", func(t *testing.T) {
+		drv, db := setup(t)
+		err := exec.WithTx(ctx, db, nil, func(tx exec.Runner) error {
+			if err := exec.WithTx(ctx, tx, nil, run("INSERT 1")); err != nil {
+				return err
+			}
+			err := exec.WithTx(ctx, tx, nil, func(tx exec.Runner) error {
+				return exec.WithTx(ctx, tx, nil, func(exec.Runner) error { return errBoom })
+			})
+			if !errors.Is(err, errBoom) {
+				t.Errorf("expected boom, got %v", err)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect(t, drv,
+			drivertest.Begin,
+			"SAVEPOINT sp_1", "INSERT 1", "RELEASE SAVEPOINT sp_1",
+			"SAVEPOINT sp_1", "SAVEPOINT sp_2", "ROLLBACK TO SAVEPOINT sp_2", "ROLLBACK TO SAVEPOINT sp_1",
+			drivertest.Commit,
+		)
+	})
+
+	t.Run("Dialects", func(t *testing.T) {
+		cases := []struct {
+			d    dialect.SQLDialect
+			want []string
+		}{
+			{oracle.New(), []string{"SAVEPOINT sp_1", "x"}},
+			{mssql.New(), []string{"SAVE TRANSACTION sp_1", "x"}},
+		}
+		for _, c := range cases {
+			drv, db := setup(t)
+			err := exec.WithTx(ctx, exec.Bind(db, c.d), nil, func(tx exec.Runner) error {
+				if exec.DialectOf(tx) != c.d {
+					t.Errorf("expected the transaction to keep %s", c.d.Name())
+				}
+				return exec.WithTx(ctx, tx, nil, run("x"))
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			expect(t, drv, append(append([]string{drivertest.Begin}, c.want...), drivertest.Commit)...)
+		}
+
+		drv, db := setup(t)
+		err := exec.WithTx(ctx, exec.Bind(db, mssql.New()), nil, func(tx exec.Runner) error {
+			if err := exec.WithTx(ctx, tx, nil, func(exec.Runner) error { return errBoom }); !errors.Is(err, errBoom) {
+				t.Errorf("expected boom, got %v", err)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect(t, drv, drivertest.Begin, "SAVE TRANSACTION sp_1", "ROLLBACK TRANSACTION sp_1", drivertest.Commit)
+
+		_, db = setup(t)
+		err = exec.WithTx(ctx, exec.Bind(db, snowflake.New()), nil, func(tx exec.Runner) error {
+			return exec.WithTx(ctx, tx, nil, run("x"))
+		})
+		if !errors.Is(err, dialect.ErrNoSavepoints) {
+			t.Errorf("expected ErrNoSavepoints, got %v", err)
+		}
+	})
+
+	t.Run("SQLTx", func(t *testing.T) {
+		drv, db := setup(t)
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer tx.Rollback()
+		if err := exec.WithTx(ctx, tx, nil, run("x")); err != nil {
+			t.Fatal(err)
+		}
+		expect(t, drv, drivertest.Begin, "SAVEPOINT sp_1", "x", "RELEASE SAVEPOINT sp_1")
+	})
+
+	t.Run("Retry", func(t *testing.T) {
+		drv, db := setup(t)
+		commits := 0
+		drv.Respond = func(query string, _ []any) (drivertest.Result, error) {
+			if query == drivertest.Commit {
+				if commits++; commits == 1 {
+					return drivertest.Result{}, stateError("40001")
+				}
+			}
+			return drivertest.Result{}, nil
+		}
+		attempts := 0
+		err := exec.WithTx(ctx, db, noWait, func(tx exec.Runner) error {
+			attempts++
+			return run("x")(tx)
+		})
+		if err != nil || attempts != 2 {
+			t.Fatalf("expected success on attempt 2, got %d: %v", attempts, err)
+		}
+	})
+
+	t.Run("RetryDialect", func(t *testing.T) {
+		_, db := setup(t)
+		attempts := 0
+		err := exec.WithTx(ctx, exec.Bind(db, oracle.New()), noWait, func(exec.Runner) error {
+			if attempts++; attempts == 1 {
+				return oraError(8177) // ORA-08177 can't serialize access
+			}
+			return nil
+		})
+		if err != nil || attempts != 2 {
+			t.Fatalf("expected success on attempt 2, got %d: %v", attempts, err)
+		}
+	})
+
+	t.Run("RetryExhausted", func(t *testing.T) {
+		_, db := setup(t)
+		attempts := 0
+		opts := *noWait
+		opts.MaxAttempts = 4
+		err := exec.WithTx(ctx, db, &opts, func(exec.Runner) error {
+			attempts++
+			return fmt.Errorf("insert: %w", stateError("40P01"))
+		})
+		if !exec.IsRetryable(err) || attempts != 4 {
+			t.Errorf("expected 4 attempts, got %d: %v", attempts, err)
+		}
+
+		attempts = 0
+		_ = exec.WithTx(ctx, db, noWait, func(exec.Runner) error {
+			attempts++
+			return stateError("23505")
+		})
+		if attempts != 1 {
+			t.Errorf("expected no retry for a unique violation, got %d attempts", attempts)
+		}
+	})
+
+	t.Run("NoTransactions", func(t *testing.T) {
+		err := exec.WithTx(ctx, runnerOnly{}, nil, run("x"))
+		if !errors.Is(err, exec.ErrNoTransactions) {
+			t.Errorf("expected ErrNoTransactions, got %v", err)
+		}
+	})
+}
+
+// runnerOnly is a Runner unable to begin transactions.
+type runnerOnly struct {
+	exec.Runner
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/entiqon/db/dialect"
 	"github.com/entiqon/db/driver"
 	"github.com/entiqon/db/internal/core/builder"
 	"github.com/entiqon/db/internal/core/builder/bind"
@@ -91,6 +92,28 @@ func (b *DeleteBuilder) Limit(n int) *DeleteBuilder {
 	return b
 }
 
+// BuildFor assembles the DELETE query for d instead of the builder's own
+// dialect, as Build does. A nil d uses the builder's dialect.
+//
+// Example:
+//
+//	sql, args, err := NewDelete(nil).From("users").Where("id = ?", 7).BuildFor(oracle.New())
+//	// DELETE FROM users WHERE id = :1
+func (b *DeleteBuilder) BuildFor(d dialect.SQLDialect) (string, []any, error) {
+	if d == nil {
+		return b.Build()
+	}
+	cp := *b
+	cp.Dialect = FromDialect(d)
+	return cp.Build()
+}
+
+// Debug returns a developer-facing summary of the builder state.
+func (b *DeleteBuilder) Debug() string {
+	return fmt.Sprintf("DeleteBuilder{dialect:%s, table:%q, conditions:%d, limit:%d, errors:%t}",
+		b.GetDialect().GetName(), b.table, len(b.conditions), b.limit, b.HasErrors())
+}
+
 // Build assembles the DELETE SQL query and returns the final SQL string and arguments.
 //
 // If validation fails, an error is returned describing any missing elements or invalid conditions.
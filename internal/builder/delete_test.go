@@ -4,6 +4,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/dialect/sqlite"
 	"github.com/entiqon/db/internal/core/token"
 )
 
@@ -138,3 +140,18 @@ func TestDeleteBuilder_BuildValidations(t *testing.T) {
 		}
 	})
 }
+
+func TestDeleteBuilder_BuildFor(t *testing.T) {
+	b := NewDelete(nil).From("users").Where("id = ?", 7)
+
+	sql, args, err := b.BuildFor(oracle.New())
+	if err != nil || sql != "DELETE FROM users WHERE id = :1" {
+		t.Errorf("unexpected SQL %q (%v)", sql, err)
+	}
+	if len(args) != 1 || args[0] != 7 {
+		t.Errorf("unexpected args: %#v", args)
+	}
+	if sql, _, _ := b.Limit(5).BuildFor(sqlite.New()); sql != "DELETE FROM users WHERE id = ? LIMIT 5" {
+		t.Errorf("unexpected SQL %q", sql)
+	}
+}
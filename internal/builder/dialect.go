@@ -0,0 +1,111 @@
+// File: db/internal/builder/dialect.go
+
+package builder
+
+import (
+	"strings"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/driver"
+	"github.com/entiqon/db/driver/styling"
+)
+
+// sqlDialect adapts a dialect.SQLDialect to the driver.Dialect the
+// builders of this package render with, so they can be built for the
+// dialects of the dialect packages.
+type sqlDialect struct {
+	dialect.SQLDialect
+	counter int
+}
+
+// FromDialect returns d as a driver.Dialect, or nil when d is nil so the
+// builders fall back to the generic driver dialect.
+//
+// Example:
+//
+//	NewInsert(FromDialect(postgres.New())).Into("users")
+func FromDialect(d dialect.SQLDialect) driver.Dialect {
+	if d == nil {
+		return nil
+	}
+	return &sqlDialect{SQLDialect: d}
+}
+
+// SQLDialect returns the dialect.SQLDialect wrapped by d, or nil when d
+// is a driver dialect.
+func SQLDialect(d driver.Dialect) dialect.SQLDialect {
+	if s, ok := d.(*sqlDialect); ok {
+		return s.SQLDialect
+	}
+	return nil
+}
+
+// BuildLimitOffset renders the pagination clause of the dialect; negative
+// values mean "not set".
+func (s *sqlDialect) BuildLimitOffset(limit, offset int) string {
+	return strings.TrimSpace(s.PaginationSyntax(max(limit, 0), max(offset, 0)))
+}
+
+// GetName returns the dialect name.
+func (s *sqlDialect) GetName() string {
+	return s.Name()
+}
+
+// NextPlaceholder returns the next sequential placeholder.
+func (s *sqlDialect) NextPlaceholder() string {
+	s.counter++
+	return s.Placeholder(s.counter)
+}
+
+// PlaceholderLimit returns Options().MaxPlaceholderIndex.
+func (s *sqlDialect) PlaceholderLimit() int {
+	return s.Options().MaxPlaceholderIndex
+}
+
+// QuoteType returns the driver quote style matching Options().QuoteStyle.
+func (s *sqlDialect) QuoteType() styling.QuoteStyle {
+	switch s.Options().QuoteStyle {
+	case `"`:
+		return styling.QuoteDouble
+	case "`":
+		return styling.QuoteBacktick
+	case "[", "[]":
+		return styling.QuoteBracket
+	default:
+		return styling.QuoteNone
+	}
+}
+
+// PlaceholderNamed returns the named placeholder of dialects implementing
+// dialect.NamedBinder, and :name otherwise.
+func (s *sqlDialect) PlaceholderNamed(name string) string {
+	if n, ok := s.SQLDialect.(dialect.NamedBinder); ok {
+		return n.PlaceholderNamed(name)
+	}
+	return ":" + name
+}
+
+// RenderFrom renders a quoted table with its alias (see dialect.AliasTable).
+func (s *sqlDialect) RenderFrom(table, alias string) string {
+	return dialect.AliasTable(s.SQLDialect, s.QuoteIdentifier(table), alias)
+}
+
+// ResetPlaceholders resets the NextPlaceholder counter.
+func (s *sqlDialect) ResetPlaceholders() {
+	s.counter = 0
+}
+
+// SupportsReturning reports Options().EnableReturning.
+func (s *sqlDialect) SupportsReturning() bool {
+	return s.Options().EnableReturning
+}
+
+// SupportsUpsert reports Options().AllowUpsert.
+func (s *sqlDialect) SupportsUpsert() bool {
+	return s.Options().AllowUpsert
+}
+
+// Validate always succeeds: dialect.SQLDialect values are complete.
+func (s *sqlDialect) Validate() error {
+	return nil
+}
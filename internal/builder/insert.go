@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/entiqon/db/dialect"
 	"github.com/entiqon/db/driver"
 	"github.com/entiqon/db/internal/core/builder/bind"
 	"github.com/entiqon/db/internal/core/errors"
@@ -87,6 +88,43 @@ func (b *InsertBuilder) Values(row ...any) *InsertBuilder {
 	return b
 }
 
+// FromStruct sets the columns and value rows from v, a struct, a pointer
+// to one, or a slice of either, tagged with `db:"name,pk,omitempty,readonly,default"`.
+//
+// Read-only fields are never inserted. Omitempty and default fields are
+// left out when they are zero in every row, so the database fills them in;
+// when the dialect supports RETURNING, the generated fields left out are
+// returned. Columns, rows and returned columns replace those set before.
+//
+// Example:
+//
+//	type User struct {
+//	    ID        int64     `db:"id,pk,default"`
+//	    Email     string    `db:"email"`
+//	    CreatedAt time.Time `db:"created_at,readonly"`
+//	}
+//	NewInsert(driver.NewPostgresDialect()).Into("users").FromStruct(User{Email: "a@b.c"})
+//	// INSERT INTO "users" ("email") VALUES ($1) RETURNING "id", "created_at"
+func (b *InsertBuilder) FromStruct(v any) *InsertBuilder {
+	meta, rows, err := structRows(v)
+	if err != nil {
+		b.AddStageError(errors.StageValues, err)
+		return b
+	}
+
+	fields := writable(meta, rows)
+	b.Columns(columnNames(fields)...)
+	b.values = make([][]any, 0, len(rows))
+	for _, row := range rows {
+		b.Values(valuesOf(row, fields)...)
+	}
+	b.returning = []token.FieldToken{}
+	if b.Dialect.SupportsReturning() {
+		b.Returning(generated(meta, fields)...)
+	}
+	return b
+}
+
 // Returning adds one or more column names to the RETURNING clause.
 // It parses string expressions into FieldTokens.
 // If called multiple times, it appends to the existing list.
@@ -98,13 +136,59 @@ func (b *InsertBuilder) Returning(fields ...string) *InsertBuilder {
 }
 
 // Build compiles the full INSERT SQL statement along with arguments.
-// Returns an error if the structure is invalid or values are missing.
-// Build compiles the full INSERT SQL statement along with arguments.
-// Returns an error if the structure is invalid or values are missing.
+// Returns an error if the structure is invalid or values are missing, and
+// a *dialect.PlaceholderLimitError when the rows bind more placeholders
+// than the dialect accepts; use BuildBatches to split them.
 func (b *InsertBuilder) Build() (string, []any, error) {
 	return b.buildQuery(len(b.returning) > 0)
 }
 
+// BuildFor compiles the INSERT statement for d instead of the builder's
+// own dialect, as Build does. A nil d uses the builder's dialect.
+//
+// Example:
+//
+//	sql, args, err := NewInsert(nil).Into("users").Columns("id").Values(1).BuildFor(oracle.New())
+//	// INSERT INTO users (id) VALUES (:1)
+func (b *InsertBuilder) BuildFor(d dialect.SQLDialect) (string, []any, error) {
+	if d == nil {
+		return b.Build()
+	}
+	cp := *b
+	cp.Dialect = FromDialect(d)
+	return cp.Build()
+}
+
+// Debug returns a developer-facing summary of the builder state.
+func (b *InsertBuilder) Debug() string {
+	return fmt.Sprintf("InsertBuilder{dialect:%s, table:%q, columns:%d, rows:%d, returning:%d, errors:%t}",
+		b.GetDialect().GetName(), b.table, len(b.columns), len(b.values), len(b.returning), b.HasErrors())
+}
+
+// BuildBatches compiles the INSERT as one statement per batch of rows, so
+// that each statement binds no more placeholders than the dialect accepts
+// (see driver.BaseDialect.MaxPlaceholderIndex). Without a limit, a single
+// statement is returned. Each statement carries the RETURNING clause.
+//
+// Example:
+//
+//	stmts, err := NewInsert(driver.NewMSSQLDialect()).Into("events").
+//	    FromStruct(events).BuildBatches()
+//	// with 3 columns per row: at most 700 rows per statement
+func (b *InsertBuilder) BuildBatches() ([]Statement, error) {
+	var stmts []Statement
+	for _, rows := range batches(b.Dialect, len(b.columns), b.values) {
+		batch := *b
+		batch.values = rows
+		sql, args, err := batch.Build()
+		if err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, Statement{SQL: sql, Args: args})
+	}
+	return stmts, nil
+}
+
 // BuildInsertOnly compiles a full INSERT SQL statement with arguments,
 // excluding any RETURNING clause.
 //
@@ -170,6 +254,9 @@ func (b *InsertBuilder) buildQuery(withReturning bool) (string, []any, error) {
 	if err := b.Validate(); err != nil {
 		return "", nil, err
 	}
+	if err := checkPlaceholders(b.Dialect, len(args)); err != nil {
+		return "", nil, err
+	}
 
 	tokens := []string{
 		"INSERT INTO",
@@ -179,11 +266,21 @@ func (b *InsertBuilder) buildQuery(withReturning bool) (string, []any, error) {
 	}
 
 	if withReturning {
-		returnCols := make([]string, len(b.returning))
-		for i, col := range b.returning {
-			returnCols[i] = b.Dialect.QuoteIdentifier(col.Name)
+		// Dialects returning through out-binds (Oracle) render their own
+		// clause after the bound values.
+		if r, ok := SQLDialect(b.Dialect).(dialect.Returner); ok {
+			names := make([]string, len(b.returning))
+			for i, col := range b.returning {
+				names[i] = col.Name
+			}
+			tokens = append(tokens, r.ReturningSyntax(names, len(args)+1))
+		} else {
+			returnCols := make([]string, len(b.returning))
+			for i, col := range b.returning {
+				returnCols[i] = b.Dialect.QuoteIdentifier(col.Name)
+			}
+			tokens = append(tokens, "RETURNING", strings.Join(returnCols, ", "))
 		}
-		tokens = append(tokens, "RETURNING", strings.Join(returnCols, ", "))
 	}
 
 	return strings.Join(tokens, " "), args, nil
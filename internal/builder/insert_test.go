@@ -3,10 +3,16 @@
 package builder_test
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/mssql"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/dialect/postgres"
 	"github.com/entiqon/db/driver"
 	"github.com/entiqon/db/internal/builder"
 	"github.com/entiqon/db/internal/core/token"
@@ -318,3 +324,156 @@ func TestInsertBuilder_BuildValidations(t *testing.T) {
 		}
 	})
 }
+
+type insertAccount struct {
+	ID        int64     `db:"id,pk,default"`
+	Email     string    `db:"email"`
+	Nickname  string    `db:"nickname,omitempty"`
+	CreatedAt time.Time `db:"created_at,readonly"`
+	Internal  string    `db:"-"`
+}
+
+func TestInsertBuilder_FromStruct(t *testing.T) {
+	t.Run("Returning", func(t *testing.T) {
+		sql, args, err := builder.NewInsert(driver.NewPostgresDialect()).
+			Into("accounts").
+			FromStruct(&insertAccount{Email: "watson@example.com", Internal: "x"}).
+			Build()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := `INSERT INTO "accounts" ("email") VALUES ($1) RETURNING "id", "created_at"`
+		if sql != expected {
+			t.Errorf("expected %q, got %q", expected, sql)
+		}
+		if len(args) != 1 || args[0] != "watson@example.com" {
+			t.Errorf("unexpected args: %#v", args)
+		}
+	})
+
+	t.Run("Slice", func(t *testing.T) {
+		rows := []insertAccount{
+			{ID: 1, Email: "a@example.com"},
+			{ID: 2, Email: "b@example.com", Nickname: "bee"},
+		}
+		sql, args, err := builder.NewInsert(nil).Into("accounts").FromStruct(rows).BuildInsertOnly()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := `INSERT INTO accounts (id, email, nickname) VALUES (?, ?, ?), (?, ?, ?)`
+		if sql != expected {
+			t.Errorf("expected %q, got %q", expected, sql)
+		}
+		if fmt.Sprint(args) != "[1 a@example.com  2 b@example.com bee]" {
+			t.Errorf("unexpected args: %#v", args)
+		}
+	})
+
+	t.Run("Repeated", func(t *testing.T) {
+		b := builder.NewInsert(driver.NewPostgresDialect()).Into("accounts")
+		b.FromStruct(&insertAccount{Email: "a@example.com"})
+		sql, args, err := b.FromStruct(&insertAccount{Email: "b@example.com"}).Build()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := `INSERT INTO "accounts" ("email") VALUES ($1) RETURNING "id", "created_at"`
+		if sql != expected {
+			t.Errorf("expected %q, got %q", expected, sql)
+		}
+		if len(args) != 1 || args[0] != "b@example.com" {
+			t.Errorf("unexpected args: %#v", args)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for _, v := range []any{nil, 42, []insertAccount{}} {
+			_, _, err := builder.NewInsert(nil).Into("accounts").FromStruct(v).Build()
+			if err == nil {
+				t.Errorf("expected error for %#v", v)
+			}
+		}
+	})
+}
+
+func TestInsertBuilder_PlaceholderLimit(t *testing.T) {
+	sqlite := driver.NewSQLiteDialect()
+	rows := func(n int) *builder.InsertBuilder {
+		b := builder.NewInsert(sqlite).Into("events").Columns("id", "name", "at")
+		for i := 0; i < n; i++ {
+			b.Values(i, "e", i)
+		}
+		return b
+	}
+
+	t.Run("Build", func(t *testing.T) {
+		if _, _, err := rows(333).Build(); err != nil {
+			t.Fatalf("unexpected error at the limit: %v", err)
+		}
+		_, _, err := rows(334).Build()
+		var limit *dialect.PlaceholderLimitError
+		if !errors.As(err, &limit) || limit.Count != 1002 || limit.Max != 999 {
+			t.Fatalf("expected PlaceholderLimitError, got %v", err)
+		}
+		if _, _, err := rows(1000).BuildInsertOnly(); !errors.Is(err, dialect.ErrTooManyPlaceholders) {
+			t.Errorf("expected ErrTooManyPlaceholders, got %v", err)
+		}
+	})
+
+	t.Run("BuildBatches", func(t *testing.T) {
+		stmts, err := rows(700).BuildBatches()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(stmts) != 3 {
+			t.Fatalf("expected 3 statements, got %d", len(stmts))
+		}
+		for i, want := range []int{999, 999, 102} {
+			if got := len(stmts[i].Args); got != want {
+				t.Errorf("statement %d: expected %d args, got %d", i+1, want, got)
+			}
+		}
+		if stmts[2].Args[0] != 666 {
+			t.Errorf("expected the last batch to start at row 666, got %v", stmts[2].Args[0])
+		}
+
+		stmts, err = builder.NewInsert(nil).Into("events").Columns("id").Values(1).Values(2).BuildBatches()
+		if err != nil || len(stmts) != 1 || stmts[0].SQL != "INSERT INTO events (id) VALUES (?), (?)" {
+			t.Errorf("expected a single statement without a limit, got %v (%v)", stmts, err)
+		}
+
+		if _, err := builder.NewInsert(sqlite).Columns("id").Values(1).BuildBatches(); err == nil {
+			t.Error("expected the missing table to be reported")
+		}
+	})
+}
+
+func TestInsertBuilder_BuildFor(t *testing.T) {
+	b := builder.NewInsert(nil).
+		Into("users").
+		Columns("id", "name").
+		Values(1, "Watson").
+		Returning("id")
+
+	cases := []struct {
+		d    dialect.SQLDialect
+		want string
+	}{
+		{postgres.New(), "INSERT INTO users (id, name) VALUES ($1, $2) RETURNING id"},
+		{oracle.New(), "INSERT INTO users (id, name) VALUES (:1, :2) RETURNING id INTO :3"},
+	}
+	for _, c := range cases {
+		sql, args, err := b.BuildFor(c.d)
+		if err != nil || sql != c.want {
+			t.Errorf("expected %q, got %q (%v)", c.want, sql, err)
+		}
+		if fmt.Sprint(args) != "[1 Watson]" {
+			t.Errorf("unexpected args: %#v", args)
+		}
+	}
+	if _, _, err := b.BuildFor(mssql.New()); err == nil {
+		t.Error("expected RETURNING to fail on a dialect without it")
+	}
+	if sql, _, _ := b.BuildInsertOnly(); sql != "INSERT INTO users (id, name) VALUES (?, ?)" {
+		t.Errorf("expected the builder dialect to be unchanged, got %q", sql)
+	}
+}
@@ -0,0 +1,53 @@
+// File: db/internal/builder/limits.go
+
+package builder
+
+import (
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/driver"
+)
+
+// Statement is one SQL statement with its bound arguments, as returned by
+// the batched builds.
+type Statement struct {
+	SQL  string
+	Args []any
+}
+
+// placeholderLimit returns the maximum number of placeholders a statement
+// may bind for d, or 0 when d has no limit.
+func placeholderLimit(d driver.Dialect) int {
+	if l, ok := d.(interface{ PlaceholderLimit() int }); ok {
+		return l.PlaceholderLimit()
+	}
+	return 0
+}
+
+// checkPlaceholders returns a *dialect.PlaceholderLimitError when count
+// exceeds the placeholder limit of d.
+func checkPlaceholders(d driver.Dialect, count int) error {
+	if limit := placeholderLimit(d); limit > 0 && count > limit {
+		return &dialect.PlaceholderLimitError{Dialect: d.GetName(), Count: count, Max: limit}
+	}
+	return nil
+}
+
+// batches splits rows into groups small enough for each statement to bind
+// at most the placeholder limit of d, given perRow parameters per row.
+// Without a limit, rows are returned as a single group. A row binding more
+// than the limit still gets a group of its own, so Build reports it.
+func batches(d driver.Dialect, perRow int, rows [][]any) [][][]any {
+	size := len(rows)
+	if limit := placeholderLimit(d); limit > 0 && perRow > 0 {
+		size = max(limit/perRow, 1)
+	}
+	if size <= 0 || size >= len(rows) {
+		return [][][]any{rows}
+	}
+	out := make([][][]any, 0, (len(rows)+size-1)/size)
+	for start := 0; start < len(rows); start += size {
+		end := min(start+size, len(rows))
+		out = append(out, rows[start:end:end])
+	}
+	return out
+}
@@ -0,0 +1,117 @@
+// File: db/internal/builder/record.go
+
+package builder
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/entiqon/db/internal/record"
+)
+
+// structRows returns the column mapping of v and the struct values it
+// holds. v is a struct, a pointer to one, or a slice of either.
+func structRows(v any) (*record.Meta, []reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return nil, nil, fmt.Errorf("expected a struct or a slice of structs, got nil")
+	}
+	if rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	var rows []reflect.Value
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			row := rv.Index(i)
+			if row.Kind() == reflect.Pointer {
+				if row.IsNil() {
+					return nil, nil, fmt.Errorf("row %d is nil", i+1)
+				}
+				row = row.Elem()
+			}
+			rows = append(rows, row)
+		}
+		if len(rows) == 0 {
+			return nil, nil, fmt.Errorf("at least one row is required")
+		}
+	default:
+		rows = []reflect.Value{rv}
+	}
+
+	meta, err := record.Of(rows[0].Type())
+	if err != nil {
+		return nil, nil, fmt.Errorf("expected a struct or a slice of structs: %w", err)
+	}
+	for i, row := range rows {
+		if row.Type() != meta.Type {
+			return nil, nil, fmt.Errorf("row %d is a %s, expected %s", i+1, row.Type(), meta.Type)
+		}
+	}
+	return meta, rows, nil
+}
+
+// writable returns the fields of meta written by a statement on rows.
+// Read-only fields are never written; omitempty and default fields are
+// left out when they are zero in every row, so the database applies its
+// default.
+func writable(meta *record.Meta, rows []reflect.Value) []record.Field {
+	var out []record.Field
+	for _, f := range meta.Fields {
+		if f.ReadOnly {
+			continue
+		}
+		if f.OmitEmpty || f.Default {
+			set := false
+			for _, row := range rows {
+				if v, ok := record.Value(row, f); ok && !v.IsZero() {
+					set = true
+					break
+				}
+			}
+			if !set {
+				continue
+			}
+		}
+		out = append(out, f)
+	}
+	return out
+}
+
+// generated returns the names of the generated fields of meta that are
+// not among written, for the RETURNING clause of an insert.
+func generated(meta *record.Meta, written []record.Field) []string {
+	skip := map[string]bool{}
+	for _, f := range written {
+		skip[f.Column] = true
+	}
+	var out []string
+	for _, f := range meta.Fields {
+		if f.Generated() && !skip[f.Column] {
+			out = append(out, f.Column)
+		}
+	}
+	return out
+}
+
+// columnNames returns the column names of fields.
+func columnNames(fields []record.Field) []string {
+	out := make([]string, len(fields))
+	for i, f := range fields {
+		out[i] = f.Column
+	}
+	return out
+}
+
+// valuesOf returns the values of fields in row. Fields behind a nil
+// embedded pointer are NULL.
+func valuesOf(row reflect.Value, fields []record.Field) []any {
+	out := make([]any, len(fields))
+	for i, f := range fields {
+		if v, ok := record.Value(row, f); ok {
+			out[i] = v.Interface()
+		}
+	}
+	return out
+}
@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/entiqon/db/dialect"
 	"github.com/entiqon/db/driver"
 	"github.com/entiqon/db/internal/core/builder"
 	"github.com/entiqon/db/internal/core/builder/bind"
 	"github.com/entiqon/db/internal/core/errors"
 	"github.com/entiqon/db/internal/core/token"
+	"github.com/entiqon/db/internal/record"
 )
 
 // UpdateBuilder builds a SQL UPDATE query with fluent syntax and dialect_engine.md support.
@@ -89,6 +91,51 @@ func (b *UpdateBuilder) OrWhere(condition string, values ...any) *UpdateBuilder
 	return b
 }
 
+// FromStruct assigns the columns of v, a struct or a pointer to one,
+// tagged with `db:"name,pk,omitempty,readonly,default"`, and matches the
+// row by its pk fields.
+//
+// Primary key and read-only fields are not assigned, and omitempty and
+// default fields are skipped when zero. The pk fields replace the WHERE
+// clause, joined with AND; a struct without pk fields is an error.
+//
+// Example:
+//
+//	NewUpdate(nil).Table("users").FromStruct(User{ID: 7, Email: "a@b.c"})
+//	// UPDATE users SET email = ? WHERE id = ?
+func (b *UpdateBuilder) FromStruct(v any) *UpdateBuilder {
+	meta, rows, err := structRows(v)
+	if err == nil && len(rows) != 1 {
+		err = fmt.Errorf("expected a single struct, got %d rows", len(rows))
+	}
+	if err != nil {
+		b.AddStageError(errors.StageSet, err)
+		return b
+	}
+
+	row := rows[0]
+	for _, f := range writable(meta, rows) {
+		if f.PK {
+			continue
+		}
+		b.Set(f.Column, valuesOf(row, []record.Field{f})[0])
+	}
+
+	pk := meta.PrimaryKey()
+	if len(pk) == 0 {
+		b.AddStageError(errors.StageWhere, fmt.Errorf("%s has no pk field", meta.Type))
+		return b
+	}
+	for i, value := range valuesOf(row, pk) {
+		if i == 0 {
+			b.Where(pk[i].Column, value)
+		} else {
+			b.AndWhere(pk[i].Column, value)
+		}
+	}
+	return b
+}
+
 // UseDialect resolves and applies the dialect_engine.md by name (e.g., "postgres").
 // It replaces any previously set dialect_engine.md on the builder.
 func (b *UpdateBuilder) UseDialect(name string) *UpdateBuilder {
@@ -96,6 +143,28 @@ func (b *UpdateBuilder) UseDialect(name string) *UpdateBuilder {
 	return b
 }
 
+// BuildFor renders the UPDATE query for d instead of the builder's own
+// dialect, as Build does. A nil d uses the builder's dialect.
+//
+// Example:
+//
+//	sql, args, err := NewUpdate(nil).Table("users").Set("name", "Ada").Where("id = ?", 7).BuildFor(postgres.New())
+//	// UPDATE users SET name = $1 WHERE id = $2
+func (b *UpdateBuilder) BuildFor(d dialect.SQLDialect) (string, []any, error) {
+	if d == nil {
+		return b.Build()
+	}
+	cp := *b
+	cp.Dialect = FromDialect(d)
+	return cp.Build()
+}
+
+// Debug returns a developer-facing summary of the builder state.
+func (b *UpdateBuilder) Debug() string {
+	return fmt.Sprintf("UpdateBuilder{dialect:%s, table:%q, assignments:%d, conditions:%d, errors:%t}",
+		b.GetDialect().GetName(), b.table, len(b.assignments), len(b.conditions), b.HasErrors())
+}
+
 // Build renders the UPDATE SQL query and returns the query + args.
 func (b *UpdateBuilder) Build() (string, []any, error) {
 	if b.table == "" {
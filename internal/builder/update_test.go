@@ -4,7 +4,12 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/mssql"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/dialect/postgres"
 	"github.com/entiqon/db/internal/core/errors"
 	"github.com/entiqon/db/internal/core/token"
 )
@@ -281,3 +286,72 @@ func TestUpdateBuilder_BuildValidations(t *testing.T) {
 		}
 	})
 }
+
+type updateAccount struct {
+	TenantID  int64     `db:"tenant_id,pk"`
+	ID        int64     `db:"id,pk"`
+	Email     string    `db:"email"`
+	Nickname  string    `db:"nickname,omitempty"`
+	CreatedAt time.Time `db:"created_at,readonly"`
+}
+
+func TestUpdateBuilder_FromStruct(t *testing.T) {
+	sql, args, err := NewUpdate(nil).
+		Table("accounts").
+		FromStruct(updateAccount{TenantID: 3, ID: 7, Email: "watson@example.com"}).
+		Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "UPDATE accounts SET email = ? WHERE tenant_id = ? AND id = ?"
+	if sql != expected {
+		t.Errorf("expected %q, got %q", expected, sql)
+	}
+	if fmt.Sprint(args) != "[watson@example.com 3 7]" {
+		t.Errorf("unexpected args: %#v", args)
+	}
+
+	_, _, err = NewUpdate(nil).
+		Table("accounts").
+		FromStruct(struct{ Email string }{"watson@example.com"}).
+		Build()
+	if err == nil || !strings.Contains(err.Error(), "no pk field") {
+		t.Errorf("expected missing pk error, got %v", err)
+	}
+
+	_, _, err = NewUpdate(nil).
+		Table("accounts").
+		FromStruct([]updateAccount{{ID: 1}, {ID: 2}}).
+		Build()
+	if err == nil || !strings.Contains(err.Error(), "expected a single struct") {
+		t.Errorf("expected single struct error, got %v", err)
+	}
+}
+
+func TestUpdateBuilder_BuildFor(t *testing.T) {
+	b := NewUpdate(nil).
+		Table("users").
+		Set("name", "Watson").
+		Where("id = ?", 7)
+
+	cases := []struct {
+		d    dialect.SQLDialect
+		want string
+	}{
+		{postgres.New(), "UPDATE users SET name = $1 WHERE id = $2"},
+		{oracle.New(), "UPDATE users SET name = :1 WHERE id = :2"},
+		{mssql.New(), "UPDATE users SET name = @p1 WHERE id = @p2"},
+	}
+	for _, c := range cases {
+		sql, args, err := b.BuildFor(c.d)
+		if err != nil || sql != c.want {
+			t.Errorf("expected %q, got %q (%v)", c.want, sql, err)
+		}
+		if fmt.Sprint(args) != "[Watson 7]" {
+			t.Errorf("unexpected args: %#v", args)
+		}
+	}
+	if sql, _, _ := b.BuildFor(nil); sql != "UPDATE users SET name = ? WHERE id = ?" {
+		t.Errorf("expected BuildFor(nil) to use the builder dialect, got %q", sql)
+	}
+}
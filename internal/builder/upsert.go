@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/entiqon/db/dialect"
 	"github.com/entiqon/db/driver"
+	"github.com/entiqon/db/internal/core/errors"
 )
 
 // Assignment represents a column update assignment like col = expr.
@@ -28,6 +30,9 @@ type UpsertBuilder struct {
 	conflictColumns []string
 	// updateSet holds the assignments applied when a conflict occurs.
 	updateSet []Assignment
+	// fromRow is set by FromStruct: the non-key columns are updated from
+	// the inserted row, as the dialect spells it.
+	fromRow   bool
 	returning []string
 }
 
@@ -85,6 +90,70 @@ func (b *UpsertBuilder) Returning(columns ...string) *UpsertBuilder {
 // DoUpdateSet defines how to update columns if a conflict is found.
 func (b *UpsertBuilder) DoUpdateSet(assignments ...Assignment) *UpsertBuilder {
 	b.updateSet = append(b.updateSet, assignments...)
+	b.fromRow = false
+	return b
+}
+
+// FromStruct sets the insert portion from v, as InsertBuilder.FromStruct
+// does, and derives the rest of the UPSERT from its tags: the pk fields
+// are the conflict target, and the other written columns are updated
+// from the inserted row. Generated fields are returned when the dialect
+// supports RETURNING on upserts, which MERGE does not. Calling it again replaces the previous columns,
+// rows, conflict target, assignments and returned columns; DoUpdateSet
+// afterwards renders the assignments as given instead.
+//
+// Built for a dialect.SQLDialect (see FromDialect), the upsert is
+// rendered by the dialect: ON CONFLICT ... EXCLUDED.col for PostgreSQL
+// and SQLite, ON DUPLICATE KEY UPDATE ... VALUES(col) for MySQL, and
+// the MERGE of dialect.Merger for Oracle or SQL Server. MERGE and
+// Firebird's UPDATE OR INSERT take one row per statement: Build fails
+// on several rows, and BuildBatches renders one statement per row.
+// Driver dialects use EXCLUDED.
+//
+// Example:
+//
+//	NewUpsert(driver.NewPostgresDialect()).Into("users").FromStruct(User{ID: 7, Email: "a@b.c"})
+//	// INSERT INTO "users" ("id", "email") VALUES ($1, $2)
+//	// ON CONFLICT ("id") DO UPDATE SET "email" = EXCLUDED."email" RETURNING "created_at"
+//
+//	NewUpsert(FromDialect(mysql.New())).Into("users").FromStruct(User{ID: 7, Email: "a@b.c"})
+//	// INSERT INTO users (id, email) VALUES (?, ?)
+//	// ON DUPLICATE KEY UPDATE email = VALUES(email)
+func (b *UpsertBuilder) FromStruct(v any) *UpsertBuilder {
+	meta, rows, err := structRows(v)
+	if err != nil {
+		b.insert.AddStageError(errors.StageValues, err)
+		return b
+	}
+
+	fields := writable(meta, rows)
+	b.insert.Columns(columnNames(fields)...)
+	b.insert.values = make([][]any, 0, len(rows))
+	for _, row := range rows {
+		b.insert.Values(valuesOf(row, fields)...)
+	}
+
+	pk := meta.PrimaryKey()
+	if len(pk) == 0 {
+		b.insert.AddStageError(errors.StageValues, fmt.Errorf("%s has no pk field to detect conflicts", meta.Type))
+		return b
+	}
+	b.conflictColumns = columnNames(pk)
+	b.updateSet = b.updateSet[:0]
+	for _, f := range fields {
+		if f.PK {
+			continue
+		}
+		b.updateSet = append(b.updateSet, Assignment{
+			Column: f.Column,
+			Expr:   "EXCLUDED." + b.Dialect.QuoteIdentifier(f.Column),
+		})
+	}
+	b.fromRow = true
+	b.returning = b.returning[:0]
+	if b.Dialect.SupportsReturning() && !b.merges() {
+		b.returning = append(b.returning, generated(meta, fields)...)
+	}
 	return b
 }
 
@@ -96,13 +165,95 @@ func (b *UpsertBuilder) UseDialect(name string) *UpsertBuilder {
 	return b
 }
 
+// BuildBatches compiles the UPSERT as one statement per batch of rows, so
+// that each statement binds no more placeholders than the dialect accepts,
+// as InsertBuilder.BuildBatches does. Upserts the dialect renders as
+// MERGE or UPDATE OR INSERT (see FromStruct) get one statement per row.
+func (b *UpsertBuilder) BuildBatches() ([]Statement, error) {
+	groups := batches(b.Dialect, len(b.insert.columns), b.insert.values)
+	if b.statementPerRow() {
+		groups = make([][][]any, len(b.insert.values))
+		for i, row := range b.insert.values {
+			groups[i] = [][]any{row}
+		}
+	}
+
+	var stmts []Statement
+	for _, rows := range groups {
+		insert := *b.insert
+		insert.values = rows
+		batch := *b
+		batch.insert = &insert
+		sql, args, err := batch.Build()
+		if err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, Statement{SQL: sql, Args: args})
+	}
+	return stmts, nil
+}
+
+// BuildFor compiles the UPSERT statement for d instead of the builder's
+// own dialect, as Build does. A nil d uses the builder's dialect.
+func (b *UpsertBuilder) BuildFor(d dialect.SQLDialect) (string, []any, error) {
+	if d == nil {
+		return b.Build()
+	}
+	insert := *b.insert
+	insert.Dialect = FromDialect(d)
+	cp := *b
+	cp.Dialect = insert.Dialect
+	cp.insert = &insert
+	return cp.Build()
+}
+
+// Debug returns a developer-facing summary of the builder state.
+func (b *UpsertBuilder) Debug() string {
+	return fmt.Sprintf("UpsertBuilder{dialect:%s, table:%q, columns:%d, rows:%d, conflict:%d, updates:%d, returning:%d, errors:%t}",
+		b.GetDialect().GetName(), b.insert.table, len(b.insert.columns), len(b.insert.values),
+		len(b.conflictColumns), len(b.updateSet), len(b.returning), b.HasErrors() || b.insert.HasErrors())
+}
+
 // Build compiles the UPSERT SQL statement and returns the query and arguments.
+// A *dialect.PlaceholderLimitError is returned when the rows bind more
+// placeholders than the dialect accepts; use BuildBatches to split them.
 func (b *UpsertBuilder) Build() (string, []any, error) {
 	insertSQL, args, err := b.insert.BuildInsertOnly()
 	if err != nil {
 		return "", nil, fmt.Errorf("UPSERT: %w", err)
 	}
 
+	var tokens []string
+	if sd := SQLDialect(b.Dialect); sd != nil && b.fromRow {
+		tokens, err = b.rowTokens(sd, insertSQL)
+	} else {
+		tokens, err = b.conflictTokens(insertSQL)
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	// ───────────────────────────────────────────────
+	// RETURNING (dialect-aware)
+	// ───────────────────────────────────────────────
+	if len(b.returning) > 0 {
+		if b.Dialect.SupportsReturning() {
+			var returnCols []string
+			for _, col := range b.returning {
+				returnCols = append(returnCols, b.Dialect.QuoteIdentifier(col))
+			}
+			tokens = append(tokens, "RETURNING", strings.Join(returnCols, ", "))
+		} else {
+			return "", nil, fmt.Errorf("RETURNING not supported in dialect: %s", b.Dialect.GetName())
+		}
+	}
+
+	return strings.Join(tokens, " "), args, nil
+}
+
+// conflictTokens renders insertSQL followed by the ON CONFLICT clause and
+// its DO UPDATE SET assignments, or DO NOTHING without assignments.
+func (b *UpsertBuilder) conflictTokens(insertSQL string) ([]string, error) {
 	tokens := []string{insertSQL}
 
 	// ───────────────────────────────────────────────
@@ -112,7 +263,7 @@ func (b *UpsertBuilder) Build() (string, []any, error) {
 		var quoted []string
 		for _, col := range b.conflictColumns {
 			if col == "" {
-				return "", nil, fmt.Errorf("UPSERT: empty conflict column name")
+				return nil, fmt.Errorf("UPSERT: empty conflict column name")
 			}
 			quoted = append(quoted, b.Dialect.QuoteIdentifier(col))
 		}
@@ -128,7 +279,7 @@ func (b *UpsertBuilder) Build() (string, []any, error) {
 		var assignments []string
 		for _, assign := range b.updateSet {
 			if assign.Column == "" || assign.Expr == "" {
-				return "", nil, fmt.Errorf("UPSERT: column or expression is empty")
+				return nil, fmt.Errorf("UPSERT: column or expression is empty")
 			}
 			col := b.Dialect.QuoteIdentifier(assign.Column)
 			assignments = append(assignments, fmt.Sprintf("%s = %s", col, assign.Expr))
@@ -137,21 +288,85 @@ func (b *UpsertBuilder) Build() (string, []any, error) {
 			tokens = append(tokens, "DO UPDATE SET", strings.Join(assignments, ", "))
 		}
 	}
+	return tokens, nil
+}
 
-	// ───────────────────────────────────────────────
-	// RETURNING (dialect-aware)
-	// ───────────────────────────────────────────────
-	if len(b.returning) > 0 {
-		if b.Dialect.SupportsReturning() {
-			var returnCols []string
-			for _, col := range b.returning {
-				returnCols = append(returnCols, b.Dialect.QuoteIdentifier(col))
-			}
-			tokens = append(tokens, "RETURNING", strings.Join(returnCols, ", "))
-		} else {
-			return "", nil, fmt.Errorf("RETURNING not supported in dialect: %s", b.Dialect.GetName())
+// rowTokens renders the upsert of the rows set by FromStruct through sd.
+// The clause sd appends to a single-row INSERT follows insertSQL, so
+// every row is upserted at once; dialects upserting with a statement of
+// their own render it for the only row.
+func (b *UpsertBuilder) rowTokens(sd dialect.SQLDialect, insertSQL string) ([]string, error) {
+	if clause, ok := b.rowClause(sd); ok {
+		return []string{insertSQL, clause}, nil
+	}
+	if len(b.insert.values) > 1 {
+		return nil, fmt.Errorf("UPSERT: %s upserts one row per statement, got %d rows; use BuildBatches", sd.Name(), len(b.insert.values))
+	}
+
+	var sql string
+	switch d := sd.(type) {
+	case dialect.Upserter:
+		sql = d.UpsertSyntax(b.insert.table, b.insertColumns(), b.conflictColumns)
+	case dialect.Merger:
+		if len(b.returning) > 0 {
+			return nil, fmt.Errorf("UPSERT: %s upserts with MERGE, which cannot return columns", sd.Name())
 		}
+		sql = d.MergeSyntax(b.insert.table, b.insertColumns(), b.conflictColumns)
+	}
+	if sql == "" {
+		return nil, fmt.Errorf("UPSERT: dialect %s does not support upserts", sd.Name())
 	}
+	return []string{sql}, nil
+}
 
-	return strings.Join(tokens, " "), args, nil
+// rowClause returns the clause sd appends to an INSERT of the builder
+// columns to upsert it, cut from its dialect.Upserter statement, such as
+// ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name. It reports false
+// when sd upserts with a statement of its own, such as MERGE or UPDATE
+// OR INSERT.
+func (b *UpsertBuilder) rowClause(sd dialect.SQLDialect) (string, bool) {
+	u, ok := sd.(dialect.Upserter)
+	if !ok {
+		return "", false
+	}
+	names := b.insertColumns()
+	cols := make([]string, len(names))
+	marks := make([]string, len(names))
+	for i, name := range names {
+		cols[i] = sd.QuoteIdentifier(name)
+		marks[i] = sd.Placeholder(i + 1)
+	}
+	head := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s) ",
+		sd.QuoteIdentifier(b.insert.table), strings.Join(cols, ", "), strings.Join(marks, ", "))
+	return strings.CutPrefix(u.UpsertSyntax(b.insert.table, names, b.conflictColumns), head)
+}
+
+// statementPerRow reports whether the rows set by FromStruct are
+// upserted one statement per row (see FromStruct).
+func (b *UpsertBuilder) statementPerRow() bool {
+	sd := SQLDialect(b.Dialect)
+	if sd == nil || !b.fromRow {
+		return false
+	}
+	_, ok := b.rowClause(sd)
+	return !ok
+}
+
+// merges reports whether the builder dialect upserts with MERGE only.
+func (b *UpsertBuilder) merges() bool {
+	sd := SQLDialect(b.Dialect)
+	if _, ok := sd.(dialect.Upserter); ok {
+		return false
+	}
+	_, ok := sd.(dialect.Merger)
+	return ok
+}
+
+// insertColumns returns the names of the insert columns.
+func (b *UpsertBuilder) insertColumns() []string {
+	names := make([]string, len(b.insert.columns))
+	for i, col := range b.insert.columns {
+		names[i] = col.Name
+	}
+	return names
 }
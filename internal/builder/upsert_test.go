@@ -1,9 +1,17 @@
 package builder
 
 import (
+	"errors"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/mssql"
+	"github.com/entiqon/db/dialect/mysql"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/dialect/postgres"
+	"github.com/entiqon/db/dialect/sqlite"
 	"github.com/entiqon/db/driver"
 )
 
@@ -210,3 +218,160 @@ func TestUpsertBuilder_BuildValidations(t *testing.T) {
 		}
 	})
 }
+
+type upsertAccount struct {
+	ID        int64     `db:"id,pk"`
+	Email     string    `db:"email"`
+	CreatedAt time.Time `db:"created_at,readonly"`
+}
+
+func TestUpsertBuilder_FromStruct(t *testing.T) {
+	sql, args, err := NewUpsert(driver.NewPostgresDialect()).
+		Into("accounts").
+		FromStruct([]*upsertAccount{{ID: 1, Email: "a@example.com"}, {ID: 2, Email: "b@example.com"}}).
+		Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `INSERT INTO "accounts" ("id", "email") VALUES ($1, $2), ($3, $4) ON CONFLICT ("id") DO UPDATE SET "email" = EXCLUDED."email" RETURNING "created_at"`
+	if sql != expected {
+		t.Errorf("expected %q, got %q", expected, sql)
+	}
+	if len(args) != 4 {
+		t.Errorf("unexpected args: %#v", args)
+	}
+
+	q := NewUpsert(driver.NewPostgresDialect()).Into("accounts")
+	q.FromStruct(&upsertAccount{ID: 1, Email: "a@example.com"})
+	sql, _, err = q.FromStruct(&upsertAccount{ID: 2, Email: "b@example.com"}).Build()
+	expected = `INSERT INTO "accounts" ("id", "email") VALUES ($1, $2) ON CONFLICT ("id") DO UPDATE SET "email" = EXCLUDED."email" RETURNING "created_at"`
+	if err != nil || sql != expected {
+		t.Errorf("expected %q after a repeated FromStruct, got %q (%v)", expected, sql, err)
+	}
+
+	_, _, err = NewUpsert(nil).
+		Into("accounts").
+		FromStruct(struct{ Email string }{"a@example.com"}).
+		Build()
+	if err == nil || !strings.Contains(err.Error(), "no pk field") {
+		t.Errorf("expected missing pk error, got %v", err)
+	}
+}
+
+func TestUpsertBuilder_PlaceholderLimit(t *testing.T) {
+	accounts := make([]upsertAccount, 1500)
+	for i := range accounts {
+		accounts[i] = upsertAccount{ID: int64(i + 1), Email: "a@example.com"}
+	}
+	q := NewUpsert(driver.NewMSSQLDialect()).Into("accounts").FromStruct(accounts)
+
+	if _, _, err := q.Build(); !errors.Is(err, dialect.ErrTooManyPlaceholders) {
+		t.Fatalf("expected ErrTooManyPlaceholders, got %v", err)
+	}
+
+	stmts, err := q.BuildBatches()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(stmts) != 2 || len(stmts[0].Args) != 2098 || len(stmts[1].Args) != 902 {
+		t.Fatalf("expected batches of 1049 and 451 rows, got %d statements", len(stmts))
+	}
+	for _, s := range stmts {
+		if !strings.HasSuffix(s.SQL, `ON CONFLICT ([id]) DO UPDATE SET [email] = EXCLUDED.[email]`) {
+			t.Errorf("expected the conflict clause in every batch, got %q", s.SQL)
+		}
+	}
+}
+
+func TestUpsertBuilder_BuildFor(t *testing.T) {
+	b := NewUpsert(nil).
+		Into("users").
+		Columns("id", "name").
+		Values(1, "Watson").
+		OnConflict("id").
+		DoUpdateSet(Assignment{Column: "name", Expr: "EXCLUDED.name"})
+
+	sql, args, err := b.BuildFor(postgres.New())
+	expected := "INSERT INTO users (id, name) VALUES ($1, $2) ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name"
+	if err != nil || sql != expected {
+		t.Errorf("expected %q, got %q (%v)", expected, sql, err)
+	}
+	if len(args) != 2 || args[0] != 1 || args[1] != "Watson" {
+		t.Errorf("unexpected args: %#v", args)
+	}
+	if sql, _, _ := b.Build(); !strings.Contains(sql, "VALUES (?, ?)") {
+		t.Errorf("expected the builder dialect to be unchanged, got %q", sql)
+	}
+}
+
+func TestUpsertBuilder_FromStruct_Dialects(t *testing.T) {
+	one := []upsertAccount{{ID: 1, Email: "a@example.com"}}
+	two := []upsertAccount{{ID: 1, Email: "a@example.com"}, {ID: 2, Email: "b@example.com"}}
+	cases := []struct {
+		name string
+		d    dialect.SQLDialect
+		rows []upsertAccount
+		want string
+	}{
+		{"postgres", postgres.New(), two,
+			"INSERT INTO accounts (id, email) VALUES ($1, $2), ($3, $4) ON CONFLICT (id) DO UPDATE SET email = EXCLUDED.email RETURNING created_at"},
+		{"sqlite", sqlite.New(), two,
+			"INSERT INTO accounts (id, email) VALUES (?, ?), (?, ?) ON CONFLICT (id) DO UPDATE SET email = EXCLUDED.email RETURNING created_at"},
+		{"mysql", mysql.New(), two,
+			"INSERT INTO accounts (id, email) VALUES (?, ?), (?, ?) ON DUPLICATE KEY UPDATE email = VALUES(email)"},
+		{"oracle", oracle.New(), one,
+			"MERGE INTO accounts tgt USING (SELECT :1 AS id, :2 AS email FROM dual) src ON (tgt.id = src.id) " +
+				"WHEN MATCHED THEN UPDATE SET tgt.email = src.email WHEN NOT MATCHED THEN INSERT (id, email) VALUES (src.id, src.email)"},
+		{"mssql", mssql.New(), one,
+			"MERGE INTO accounts tgt USING (VALUES (@p1, @p2)) AS src (id, email) ON (tgt.id = src.id) " +
+				"WHEN MATCHED THEN UPDATE SET tgt.email = src.email WHEN NOT MATCHED THEN INSERT (id, email) VALUES (src.id, src.email);"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			sql, args, err := NewUpsert(FromDialect(c.d)).Into("accounts").FromStruct(c.rows).Build()
+			if err != nil || sql != c.want {
+				t.Errorf("expected %q, got %q (%v)", c.want, sql, err)
+			}
+			if len(args) != 2*len(c.rows) {
+				t.Errorf("unexpected args: %#v", args)
+			}
+		})
+	}
+
+	t.Run("BuildFor", func(t *testing.T) {
+		b := NewUpsert(FromDialect(postgres.New())).Into("accounts").FromStruct(one)
+		_, _, err := b.BuildFor(oracle.New())
+		if err == nil || !strings.Contains(err.Error(), "cannot return columns") {
+			t.Errorf("expected the generated columns to be refused by MERGE, got %v", err)
+		}
+		if _, _, err := b.BuildFor(mysql.New()); err == nil || !strings.Contains(err.Error(), "RETURNING") {
+			t.Errorf("expected RETURNING to be refused by mysql, got %v", err)
+		}
+	})
+
+	t.Run("StatementPerRow", func(t *testing.T) {
+		b := NewUpsert(FromDialect(oracle.New())).Into("accounts").FromStruct(two)
+		if _, _, err := b.Build(); err == nil || !strings.Contains(err.Error(), "use BuildBatches") {
+			t.Errorf("expected MERGE to refuse several rows, got %v", err)
+		}
+		stmts, err := b.BuildBatches()
+		if err != nil || len(stmts) != 2 {
+			t.Fatalf("expected one statement per row, got %d (%v)", len(stmts), err)
+		}
+		if stmts[1].Args[0] != int64(2) || !strings.HasPrefix(stmts[1].SQL, "MERGE INTO accounts") {
+			t.Errorf("unexpected second statement: %+v", stmts[1])
+		}
+	})
+
+	t.Run("DoUpdateSet", func(t *testing.T) {
+		sql, _, err := NewUpsert(FromDialect(postgres.New())).
+			Into("accounts").
+			FromStruct(one).
+			DoUpdateSet(Assignment{Column: "updated_at", Expr: "now()"}).
+			Build()
+		want := "INSERT INTO accounts (id, email) VALUES ($1, $2) ON CONFLICT (id) DO UPDATE SET email = EXCLUDED.email, updated_at = now() RETURNING created_at"
+		if err != nil || sql != want {
+			t.Errorf("expected %q, got %q (%v)", want, sql, err)
+		}
+	})
+}
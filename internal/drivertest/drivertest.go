@@ -0,0 +1,269 @@
+// File: db/internal/drivertest/drivertest.go
+
+// Package drivertest provides a stub database/sql driver for tests.
+//
+// Connections record every statement they receive and answer with results
+// scripted by the test, so execution code can be exercised without a
+// database server:
+//
+//	drv := drivertest.New()
+//	drv.Respond = func(query string, args []any) (drivertest.Result, error) {
+//	    return drivertest.Result{Columns: []string{"id"}, Rows: [][]any{{1}}}, nil
+//	}
+//	db := drv.DB()
+//	defer db.Close()
+package drivertest
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+)
+
+// Statements recorded for transaction control.
+const (
+	Begin    = "BEGIN"
+	Commit   = "COMMIT"
+	Rollback = "ROLLBACK"
+)
+
+// Call is a statement received by the driver.
+type Call struct {
+	Query string
+	Args  []any
+}
+
+// Result is the scripted answer to a statement.
+type Result struct {
+	// Columns and Rows are returned by queries. Row values must be valid
+	// driver.Value types: int64, float64, bool, []byte, string, time.Time
+	// or nil.
+	Columns []string
+	Rows    [][]any
+
+	// RowsAffected and LastInsertID are returned by Exec.
+	RowsAffected int64
+	LastInsertID int64
+}
+
+// Driver is a stub driver.Driver. The zero value is not usable; create
+// one with New.
+type Driver struct {
+	// Respond answers each statement. A nil Respond returns an empty
+	// result.
+	Respond func(query string, args []any) (Result, error)
+
+	// PrepareErr fails the preparation of query when it returns an error.
+	// A nil PrepareErr prepares every statement.
+	PrepareErr func(query string) error
+
+	mu       sync.Mutex
+	calls    []Call
+	prepares int
+}
+
+// New returns a stub driver answering every statement with an empty result.
+func New() *Driver {
+	return &Driver{}
+}
+
+// DB returns a *sql.DB backed by d.
+func (d *Driver) DB() *sql.DB {
+	return sql.OpenDB(connector{d})
+}
+
+// Open implements driver.Driver.
+func (d *Driver) Open(string) (driver.Conn, error) {
+	return &conn{d: d}, nil
+}
+
+// Calls returns the statements received so far, in order.
+func (d *Driver) Calls() []Call {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]Call(nil), d.calls...)
+}
+
+// Queries returns the SQL of the statements received so far.
+func (d *Driver) Queries() []string {
+	calls := d.Calls()
+	out := make([]string, len(calls))
+	for i, c := range calls {
+		out[i] = c.Query
+	}
+	return out
+}
+
+// Prepares returns the number of statements prepared so far.
+func (d *Driver) Prepares() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.prepares
+}
+
+// Reset forgets the recorded statements.
+func (d *Driver) Reset() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.calls, d.prepares = nil, 0
+}
+
+// run records a statement and returns its scripted result.
+func (d *Driver) run(query string, args []driver.NamedValue) (Result, error) {
+	values := make([]any, len(args))
+	for i, a := range args {
+		values[i] = a.Value
+	}
+	d.mu.Lock()
+	d.calls = append(d.calls, Call{Query: query, Args: values})
+	respond := d.Respond
+	d.mu.Unlock()
+
+	if respond == nil {
+		return Result{}, nil
+	}
+	return respond(query, values)
+}
+
+// connector opens connections on a Driver without registering it.
+type connector struct {
+	d *Driver
+}
+
+func (c connector) Connect(context.Context) (driver.Conn, error) { return c.d.Open("") }
+func (c connector) Driver() driver.Driver                        { return c.d }
+
+// conn is a stub connection.
+type conn struct {
+	d *Driver
+}
+
+// Compile-time checks: conn implements the optional interfaces used by
+// database/sql to skip prepared statements and conversions.
+var (
+	_ driver.QueryerContext    = (*conn)(nil)
+	_ driver.ExecerContext     = (*conn)(nil)
+	_ driver.ConnBeginTx       = (*conn)(nil)
+	_ driver.NamedValueChecker = (*conn)(nil)
+)
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	c.d.mu.Lock()
+	c.d.prepares++
+	fail := c.d.PrepareErr
+	c.d.mu.Unlock()
+	if fail != nil {
+		if err := fail(query); err != nil {
+			return nil, err
+		}
+	}
+	return &stmt{c: c, query: query}, nil
+}
+
+func (c *conn) Close() error { return nil }
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *conn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	if _, err := c.d.run(Begin, nil); err != nil {
+		return nil, err
+	}
+	return tx{c}, nil
+}
+
+func (c *conn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	res, err := c.d.run(query, args)
+	if err != nil {
+		return nil, err
+	}
+	return &rows{res: res}, nil
+}
+
+func (c *conn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	res, err := c.d.run(query, args)
+	if err != nil {
+		return nil, err
+	}
+	return result{res}, nil
+}
+
+// CheckNamedValue accepts every value, so tests can bind slices.
+func (c *conn) CheckNamedValue(*driver.NamedValue) error { return nil }
+
+// stmt is a stub prepared statement.
+type stmt struct {
+	c     *conn
+	query string
+}
+
+func (s *stmt) Close() error  { return nil }
+func (s *stmt) NumInput() int { return -1 }
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.c.ExecContext(context.Background(), s.query, named(args))
+}
+
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.c.QueryContext(context.Background(), s.query, named(args))
+}
+
+// tx is a stub transaction.
+type tx struct {
+	c *conn
+}
+
+func (t tx) Commit() error {
+	_, err := t.c.d.run(Commit, nil)
+	return err
+}
+
+func (t tx) Rollback() error {
+	_, err := t.c.d.run(Rollback, nil)
+	return err
+}
+
+// result is a stub driver.Result.
+type result struct {
+	res Result
+}
+
+func (r result) LastInsertId() (int64, error) { return r.res.LastInsertID, nil }
+func (r result) RowsAffected() (int64, error) { return r.res.RowsAffected, nil }
+
+// rows iterates over scripted rows.
+type rows struct {
+	res Result
+	pos int
+}
+
+func (r *rows) Columns() []string { return r.res.Columns }
+func (r *rows) Close() error      { return nil }
+
+func (r *rows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.res.Rows) {
+		return io.EOF
+	}
+	row := r.res.Rows[r.pos]
+	if len(row) != len(dest) {
+		return errors.New("drivertest: row width does not match columns")
+	}
+	for i, v := range row {
+		dest[i] = v
+	}
+	r.pos++
+	return nil
+}
+
+// named converts positional values to named values.
+func named(args []driver.Value) []driver.NamedValue {
+	out := make([]driver.NamedValue, len(args))
+	for i, a := range args {
+		out[i] = driver.NamedValue{Ordinal: i + 1, Value: a}
+	}
+	return out
+}
@@ -0,0 +1,233 @@
+// File: db/internal/record/record.go
+
+// Package record reads the `db` tags of Go structs.
+//
+// It is shared by the builders, which derive columns and values from
+// structs, and by exec, which scans rows into them, so both sides agree
+// on how a field maps onto a column. Metadata is computed once per type.
+//
+// Tag format:
+//
+//	db:"name,pk,omitempty,readonly,default"
+//
+// The name defaults to the snake_case field name, and "-" skips the field.
+// Options:
+//   - pk: part of the primary key (WHERE of updates, conflict target)
+//   - omitempty: not written when the value is the zero value
+//   - readonly: never written; the database maintains it
+//   - default: generated by the database (serials, DEFAULT now()); not
+//     written when zero, and returned after inserts
+package record
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// Field describes a struct field mapped onto a column.
+type Field struct {
+	// Column is the column name.
+	Column string
+
+	// Index is the index path of the field, through embedded structs.
+	Index []int
+
+	PK        bool
+	OmitEmpty bool
+	ReadOnly  bool
+	Default   bool
+}
+
+// Generated reports whether the database produces the column value, so
+// it is worth returning after an insert.
+func (f Field) Generated() bool {
+	return f.Default || f.ReadOnly
+}
+
+// Meta is the column mapping of a struct type.
+type Meta struct {
+	// Type is the struct type.
+	Type reflect.Type
+
+	// Fields are the mapped fields, in declaration order.
+	Fields []Field
+
+	// byColumn indexes Fields by lower-cased column name, and by the
+	// lower-cased Go name of untagged fields.
+	byColumn map[string]int
+}
+
+// cache holds the Meta of every struct type seen.
+var cache sync.Map // map[reflect.Type]*Meta
+
+// Of returns the mapping of struct type t, or of the struct t points to.
+// It fails for other types.
+func Of(t reflect.Type) (*Meta, error) {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if !IsStruct(t) {
+		return nil, fmt.Errorf("%s is not a struct", t)
+	}
+	if m, ok := cache.Load(t); ok {
+		return m.(*Meta), nil
+	}
+
+	m := &Meta{Type: t, byColumn: map[string]int{}}
+	depths := map[string]int{}
+	var walk func(t reflect.Type, index []int)
+	walk = func(t reflect.Type, index []int) {
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			tag, hasTag := sf.Tag.Lookup("db")
+			opts := strings.Split(tag, ",")
+			if opts[0] == "-" {
+				continue
+			}
+			path := append(append([]int(nil), index...), i)
+
+			ft := sf.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if sf.Anonymous && !hasTag && IsStruct(ft) {
+				// Like encoding/json, embedded pointers to unexported
+				// types are skipped: they cannot be allocated.
+				if sf.Type.Kind() == reflect.Pointer && !sf.IsExported() {
+					continue
+				}
+				walk(ft, path)
+				continue
+			}
+			if !sf.IsExported() {
+				continue
+			}
+
+			f := Field{Column: opts[0], Index: path}
+			for _, o := range opts[1:] {
+				switch strings.TrimSpace(o) {
+				case "pk":
+					f.PK = true
+				case "omitempty":
+					f.OmitEmpty = true
+				case "readonly":
+					f.ReadOnly = true
+				case "default":
+					f.Default = true
+				}
+			}
+			keys := []string{strings.ToLower(f.Column)}
+			if f.Column == "" {
+				f.Column = SnakeCase(sf.Name)
+				keys = []string{f.Column, strings.ToLower(sf.Name)}
+			}
+
+			// Shallower fields win over promoted ones; among fields at the
+			// same depth, the first one wins.
+			if d, seen := depths[keys[0]]; seen && d <= len(path) {
+				continue
+			}
+			if j, seen := m.byColumn[keys[0]]; seen {
+				m.Fields[j] = f
+			} else {
+				m.Fields = append(m.Fields, f)
+				j = len(m.Fields) - 1
+				for _, k := range keys {
+					if _, taken := m.byColumn[k]; !taken {
+						m.byColumn[k] = j
+					}
+				}
+			}
+			depths[keys[0]] = len(path)
+		}
+	}
+	walk(t, nil)
+
+	actual, _ := cache.LoadOrStore(t, m)
+	return actual.(*Meta), nil
+}
+
+// Lookup returns the field mapped onto column, ignoring case.
+func (m *Meta) Lookup(column string) (Field, bool) {
+	i, ok := m.byColumn[strings.ToLower(column)]
+	if !ok {
+		return Field{}, false
+	}
+	return m.Fields[i], true
+}
+
+// PrimaryKey returns the fields tagged pk.
+func (m *Meta) PrimaryKey() []Field {
+	var out []Field
+	for _, f := range m.Fields {
+		if f.PK {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// Value returns the value of f in struct value v. It reports false when a
+// nil embedded pointer is in the way; the field then has no value.
+func Value(v reflect.Value, f Field) (reflect.Value, bool) {
+	for _, i := range f.Index {
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v, true
+}
+
+// Addr returns a pointer to f in the addressable struct value v, for
+// scanning. Nil embedded pointers on the way are allocated.
+func Addr(v reflect.Value, f Field) any {
+	for _, i := range f.Index {
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v.Addr().Interface()
+}
+
+// scannerType is the sql.Scanner interface type.
+var scannerType = reflect.TypeFor[sql.Scanner]()
+
+// IsStruct reports whether t is a struct mapped field by field, rather than
+// a single value (time.Time, sql.NullString and other sql.Scanner types).
+func IsStruct(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct &&
+		t != reflect.TypeFor[time.Time]() &&
+		!reflect.PointerTo(t).Implements(scannerType)
+}
+
+// SnakeCase converts a Go field name to snake_case: CreatedAt → created_at,
+// UserID → user_id, HTTPCode → http_code.
+func SnakeCase(name string) string {
+	runes := []rune(name)
+	var sb strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			prevLower := i > 0 && !unicode.IsUpper(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if i > 0 && (prevLower || (nextLower && unicode.IsUpper(runes[i-1]))) {
+				sb.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
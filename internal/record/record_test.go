@@ -0,0 +1,94 @@
+package record_test
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/entiqon/db/internal/record"
+)
+
+type Audit struct {
+	CreatedAt time.Time `db:"created_at,readonly"`
+	UpdatedBy string
+}
+
+type user struct {
+	ID        int64          `db:"id,pk,default"`
+	Email     string         `db:"email"`
+	Nickname  sql.NullString `db:"nickname,omitempty"`
+	UpdatedBy string         `db:"editor"`
+	Secret    string         `db:"-"`
+	hidden    string
+	*Audit
+}
+
+func TestOf(t *testing.T) {
+	m, err := record.Of(reflect.TypeFor[*user]())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var columns []string
+	for _, f := range m.Fields {
+		columns = append(columns, f.Column)
+	}
+	want := []string{"id", "email", "nickname", "editor", "created_at", "updated_by"}
+	if !reflect.DeepEqual(columns, want) {
+		t.Errorf("expected %v, got %v", want, columns)
+	}
+
+	if pk := m.PrimaryKey(); len(pk) != 1 || pk[0].Column != "id" || !pk[0].Generated() {
+		t.Errorf("unexpected pk: %+v", pk)
+	}
+	if f, ok := m.Lookup("CREATED_AT"); !ok || !f.ReadOnly || len(f.Index) != 2 {
+		t.Errorf("unexpected created_at: %+v", f)
+	}
+	if f, ok := m.Lookup("updatedby"); !ok || f.Column != "updated_by" {
+		t.Errorf("expected Go name lookup, got %+v", f)
+	}
+	if _, ok := m.Lookup("secret"); ok {
+		t.Error("expected db:\"-\" field to be skipped")
+	}
+
+	again, _ := record.Of(reflect.TypeFor[user]())
+	if again != m {
+		t.Error("expected metadata to be cached")
+	}
+
+	if _, err := record.Of(reflect.TypeFor[time.Time]()); err == nil {
+		t.Error("expected error for time.Time")
+	}
+}
+
+func TestValue(t *testing.T) {
+	m, _ := record.Of(reflect.TypeFor[user]())
+	f, _ := m.Lookup("created_at")
+
+	u := user{}
+	if _, ok := record.Value(reflect.ValueOf(u), f); ok {
+		t.Error("expected no value behind a nil embedded pointer")
+	}
+
+	now := time.Now()
+	*record.Addr(reflect.ValueOf(&u).Elem(), f).(*time.Time) = now
+	v, ok := record.Value(reflect.ValueOf(u), f)
+	if !ok || !v.Interface().(time.Time).Equal(now) {
+		t.Errorf("expected %v, got %v", now, v)
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	for in, want := range map[string]string{
+		"ID":        "id",
+		"CreatedAt": "created_at",
+		"UserID":    "user_id",
+		"HTTPCode":  "http_code",
+		"Name":      "name",
+	} {
+		if got := record.SnakeCase(in); got != want {
+			t.Errorf("SnakeCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
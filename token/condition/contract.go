@@ -33,6 +33,11 @@ type Token interface {
 	// underlying expression (Identifier, Literal, Subquery, etc.).
 	contract.Kindable[condition.Type]
 
+	// Clonable returns an independent copy of the condition, so that
+	// SetKind or SetError on one does not affect the other. The bound
+	// value is shared.
+	contract.Clonable[Token]
+
 	// Identifiable is implemented by tokens that expose their core identity
 	// without aliasing.
 	contract.Identifiable
@@ -59,9 +64,15 @@ type Token interface {
 	// (e.g., non-empty name) are satisfied.
 	contract.Validable
 
+	// Node makes the token a leaf of the query tree walked by token.Walk.
+	contract.Node
+
 	// Name returns the binding key for the parameter if a value
 	Name() string
 
+	// Field returns the left-hand operand of the condition.
+	Field() string
+
 	Operator() operator.Type
 
 	// Value returns the bound value associated to the condition, if any.
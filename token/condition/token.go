@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/entiqon/db/contract"
 	"github.com/entiqon/db/token/helpers"
 	ct "github.com/entiqon/db/token/types/condition"
 	"github.com/entiqon/db/token/types/operator"
@@ -14,6 +15,7 @@ type token struct {
 	kind     ct.Type
 	input    string
 	name     string
+	field    string
 	operator operator.Type
 	expr     string
 	value    any
@@ -67,6 +69,7 @@ func New(kind ct.Type, input ...any) Token {
 		}
 
 		t.name = helpers.ToParamKey(expr)
+		t.field = expr
 		t.operator = op
 		t.value = input[2]
 		t.expr = fmt.Sprintf("%s %s :%s", expr, op, t.name)
@@ -87,6 +90,7 @@ func New(kind ct.Type, input ...any) Token {
 	}
 
 	t.name = helpers.ToParamKey(field)
+	t.field = field
 	if op != operator.IsNull && op != operator.IsNotNull {
 		t.expr = fmt.Sprintf("%s %s :%s", field, op, t.name)
 	}
@@ -155,6 +159,24 @@ func (t *token) SetKind(value ct.Type) {
 	t.kind = value
 }
 
+// Clone returns a copy of the token, preserving its kind, operator,
+// value and error. The value itself is shared, not copied.
+//
+// Example:
+//
+//	cond := condition.New(ct.Single, "age > ?", 18)
+//	cp := cond.Clone()
+//	cp.SetKind(ct.And)
+//	fmt.Println(cp.Kind()) // Output: And — cond is still Single
+func (t *token) Clone() Token {
+	cp := *t
+	return &cp
+}
+
+// Children returns nil: a condition is a leaf of the query tree. Its
+// expression and values are not split into nodes.
+func (t *token) Children() []contract.Node { return nil }
+
 // Input returns the original input string provided when the
 // token was constructed.
 //
@@ -193,6 +215,17 @@ func (t *token) Expr() string { return t.expr }
 // Otherwise Name is empty.
 func (t *token) Name() string { return t.name }
 
+// Field returns the left-hand operand of the condition, as written.
+//
+// Builders use it to render the condition for a specific dialect, with
+// positional placeholders or emulated operators.
+//
+// Example:
+//
+//	cond := condition.New(ct.Single, "u.age > 18")
+//	fmt.Println(cond.Field()) // "u.age"
+func (t *token) Field() string { return t.field }
+
 // Operator returns the structured SQL operator type for this condition.
 // Returns operator.Invalid if parsing failed or was unsupported.
 func (t *token) Operator() operator.Type { return t.operator }
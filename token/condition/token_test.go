@@ -152,6 +152,19 @@ func TestCondition(t *testing.T) {
 				}
 			})
 
+			t.Run("Clonable", func(t *testing.T) {
+				c := condition.New(ct.Single, "id", operator.In, []int{1, 2})
+				cp := c.Clone()
+				if cp == c || cp.Debug() != c.Debug() {
+					t.Errorf("expected an equal copy, got %s", cp.Debug())
+				}
+				cp.SetKind(ct.Or)
+				cp.SetError(errors.New("test error"))
+				if c.Kind() != ct.Single || c.IsErrored() {
+					t.Errorf("expected the original to be unchanged, got %s", c.Debug())
+				}
+			})
+
 			t.Run("Identifiable", func(t *testing.T) {
 				c := condition.New(ct.Single, "id", operator.GreaterThan, 10)
 				if len(c.Input()) != 3 && c.Input() != "id > 10" {
@@ -186,6 +199,12 @@ func TestCondition(t *testing.T) {
 				}
 			})
 
+			t.Run("Node", func(t *testing.T) {
+				if got := condition.New(ct.Single, "id", operator.Equal, 1).Children(); got != nil {
+					t.Errorf("expected a leaf, got %v", got)
+				}
+			})
+
 			t.Run("Rawable", func(t *testing.T) {
 				c := condition.New(ct.Single, "id", operator.GreaterThan, 10)
 				if c.IsRaw() {
@@ -230,6 +249,17 @@ func TestCondition(t *testing.T) {
 			}
 		})
 
+		t.Run("Field", func(t *testing.T) {
+			c := condition.New(ct.Single, "u.id", operator.GreaterThan, 10)
+			if c.Field() != "u.id" {
+				t.Error("expected 'u.id', got ", c.Field())
+			}
+			c = condition.New(ct.Single, "name ILIKE 'jo%'")
+			if c.Field() != "name" || c.Operator() != operator.ILike {
+				t.Errorf("expected name ILIKE, got %q %v", c.Field(), c.Operator())
+			}
+		})
+
 		t.Run("Operator", func(t *testing.T) {
 			c := condition.New(ct.Single, "id", operator.GreaterThan, 10)
 			if c.Operator() != operator.GreaterThan {
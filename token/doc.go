@@ -20,6 +20,20 @@
 //   - Stringable  — concise diagnostic/logging string
 //   - Ownerable   — ownership binding (HasOwner, Owner, SetOwner)
 //
+// # Walk and Rewrite
+//
+// Tokens and builders implement contract.Node and form a query tree. Walk
+// visits it, for analyses such as collecting the referenced tables;
+// Rewrite returns a modified copy, for instance to route a table to its
+// shard:
+//
+//	routed := token.Rewrite(sb, func(n contract.Node) contract.Node {
+//	    if t, ok := n.(table.Token); ok && t.Name() == "orders" {
+//	        return table.New("orders_07", t.Alias())
+//	    }
+//	    return n
+//	})
+//
 // # Subpackages
 //
 //   - field: represents a column, identifier, or computed expression
@@ -31,6 +45,9 @@
 //   - join: represents JOIN clauses (INNER, LEFT, etc.) with
 //     validation of join kind and conditions.
 //
+//   - fn: represents canonical function calls (COALESCE, SUBSTRING,
+//     DATE_TRUNC, ...) translated to each dialect's spelling.
+//
 // # Supporting modules
 //
 //   - resolver: centralizes input type validation and expression
@@ -46,7 +63,7 @@
 //
 // Future tokens will include:
 //   - conditions (WHERE / HAVING)
-//   - functions (aggregates, JSON, custom expressions)
+//   - aggregate and JSON functions on top of fn
 //
 // Contracts will progressively enforce stricter auditability across
 // all tokens.
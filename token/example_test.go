@@ -0,0 +1,54 @@
+package token_test
+
+import (
+	"fmt"
+
+	"github.com/entiqon/db/builder/selects"
+	"github.com/entiqon/db/contract"
+	"github.com/entiqon/db/token"
+	"github.com/entiqon/db/token/field"
+	"github.com/entiqon/db/token/table"
+	"github.com/entiqon/db/token/types/operator"
+)
+
+// ExampleWalk demonstrates collecting the tables and columns of a query.
+func ExampleWalk() {
+	sb := selects.New(nil).
+		Fields("u.id, o.total").
+		From("users u").
+		InnerJoin("users u", "orders o", "u.id = o.user_id")
+
+	token.Walk(sb, func(n contract.Node) bool {
+		switch v := n.(type) {
+		case table.Token:
+			fmt.Println("table", v.Name())
+		case field.Token:
+			fmt.Println("column", v.Expr())
+		}
+		return true
+	})
+	// Output:
+	// column u.id
+	// column o.total
+	// table users
+	// table users
+	// table orders
+}
+
+// ExampleRewrite demonstrates routing a table to its shard.
+func ExampleRewrite() {
+	sb := selects.New(nil).
+		From("orders o").
+		Where("o.status", operator.Equal, "paid")
+
+	routed := token.Rewrite(sb, func(n contract.Node) contract.Node {
+		if t, ok := n.(table.Token); ok && t.Name() == "orders" {
+			return table.New("orders_07", t.Alias())
+		}
+		return n
+	}).(selects.SelectBuilder)
+
+	sql, _, _ := routed.Build()
+	fmt.Println(sql)
+	// Output: SELECT * FROM orders_07 AS o WHERE o.status = :o_status
+}
@@ -2,7 +2,11 @@
 
 package field
 
-import "github.com/entiqon/db/contract"
+import (
+	"github.com/entiqon/db/contract"
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/token/fn"
+)
 
 // Token is the contract implemented by *Field.
 //
@@ -45,6 +49,14 @@ type Token interface {
 	// Renderable returns the SQL-safe representation of the field.
 	contract.Renderable
 
+	// RenderFor returns the field rendered for dialect d, with the alias
+	// quoted through it. A nil d renders like Raw.
+	RenderFor(d dialect.SQLDialect) string
+
+	// Func returns the canonical function the field was built from, or
+	// nil. Builders use it to reject dialects without a translation.
+	Func() *fn.Func
+
 	// Stringable provides a human-readable summary of the field,
 	// typically used for debugging.
 	contract.Stringable
@@ -54,6 +66,9 @@ type Token interface {
 	// (e.g., non-empty name) are satisfied.
 	contract.Validable
 
+	// Node makes the token a leaf of the query tree walked by token.Walk.
+	contract.Node
+
 	// HasOwner reports whether the field is qualified by a table name or alias.
 	//
 	// It returns true if an owner (e.g. table alias "u" in "u.id") has been
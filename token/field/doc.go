@@ -16,7 +16,7 @@
 //
 // # Construction
 //
-// Fields are created using New(...) or NewWithTable(...):
+// Fields are created using New(...), NewWithTable(...) or NewWithDialect(...):
 //
 //   - No argument:
 //     field.New() → errored (empty input)
@@ -52,6 +52,9 @@
 //     field.New(field.New("id"))     → errored (use Clone() instead)
 //     field.New(123)                 → errored (invalid type)
 //
+//   - Dialect-bound (reserved aliases are quoted on Render):
+//     field.NewWithDialect(oracle.New(), "depth", "level") → depth AS "LEVEL"
+//
 // # Contracts
 //
 // Field implements the following contracts from db/contract:
@@ -97,6 +100,12 @@
 //	fmt.Println(f.String())
 //	// field(*)
 //
+// Canonical function, translated per dialect on RenderFor:
+//
+//	f := field.New(fn.Coalesce("nickname", "name"), "display")
+//	f.RenderFor(oracle.New())
+//	// COALESCE(nickname, name) AS display
+//
 // Invalid input:
 //
 //	f := field.New("id as user_id foo")
@@ -7,7 +7,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/entiqon/db/contract"
+	"github.com/entiqon/db/dialect"
 	"github.com/entiqon/db/errors"
+	"github.com/entiqon/db/token/fn"
 	"github.com/entiqon/db/token/helpers"
 	"github.com/entiqon/db/token/types/identifier"
 )
@@ -43,6 +46,14 @@ type field struct {
 	// err holds any validation or parsing error encountered.
 	// It is nil when the field is considered valid.
 	err error
+
+	// dialect is the optional dialect the field was validated against.
+	// When set, Render quotes the alias through it.
+	dialect dialect.SQLDialect
+
+	// fn is the canonical function call the field was built from, if
+	// any. Its expression is translated for each dialect on RenderFor.
+	fn *fn.Func
 }
 
 // New constructs a *field token from the given arguments.
@@ -77,6 +88,10 @@ type field struct {
 //   - Passing an existing Field:
 //     New(field.New("id"))     → error "unsupported type; use Clone() instead"
 //
+//   - Passing a canonical function (see token/fn):
+//     New(fn.Coalesce("a", "b"), "c") → Function, expr="COALESCE(a, b)", alias="c"
+//     The expression is translated for each dialect on RenderFor.
+//
 // Validation is layered:
 //  1. Type validation — only strings are accepted; other tokens must be
 //     cloned, and non-strings are rejected with descriptive errors.
@@ -99,10 +114,31 @@ type field struct {
 // Errors never cause panics; instead, they are stored inside the *field
 // and exposed via IsErrored(), Error(), String(), or Debug().
 func New(input ...any) Token {
+	return newField(nil, input...)
+}
+
+// NewWithDialect constructs a field validated against dialect d.
+//
+// Arguments follow the same rules as New, but aliases are checked with
+// helpers.ValidateAliasFor: a word reserved by d, or by the dialect-agnostic
+// list, is accepted and quoted on Render instead of being rejected.
+// A nil d behaves like New.
+//
+// Example:
+//
+//	f := field.NewWithDialect(oracle.New(), "depth", "level")
+//	// Renders as: depth AS "LEVEL"
+func NewWithDialect(d dialect.SQLDialect, input ...any) Token {
+	return newField(d, input...)
+}
+
+// newField implements New and NewWithDialect.
+func newField(d dialect.SQLDialect, input ...any) Token {
 	f := &field{
-		kind:  identifier.Invalid,
-		owner: nil,
-		input: strings.Join(helpers.Stringify(input), " "), // keep audit trail
+		kind:    identifier.TypeInvalid,
+		owner:   nil,
+		input:   strings.Join(helpers.Stringify(input), " "), // keep audit trail
+		dialect: d,
 	}
 
 	if len(input) == 0 {
@@ -113,6 +149,15 @@ func New(input ...any) Token {
 		return f.SetError(fmt.Errorf("invalid field constructor signature: %d args", len(input)))
 	}
 
+	// Canonical functions are resolved structurally, not parsed
+	if call, ok := input[0].(fn.Func); ok {
+		if err := call.Error(); err != nil {
+			return f.SetError(err)
+		}
+		f.kind, f.expr, f.fn = identifier.TypeFunction, call.String(), &call
+		return f.withAlias(d, input[1:]...)
+	}
+
 	// Type validation (string only)
 	if err := helpers.ValidateType(input[0]); err != nil {
 		if stdErr.Is(err, errors.UnsupportedTypeError) {
@@ -124,18 +169,23 @@ func New(input ...any) Token {
 	}
 
 	// always resolve the first part
-	kind, expr, alias, err := helpers.ResolveExpression(fmt.Sprint(input[0]), true)
+	kind, expr, alias, err := helpers.ResolveExpressionFor(d, fmt.Sprint(input[0]), true)
 	if err != nil {
 		return f.SetError(err)
 	}
 	f.kind, f.expr, f.alias = kind, expr, alias
 
-	if len(input) == 2 {
-		a, ok := input[1].(string)
+	return f.withAlias(d, input[1:]...)
+}
+
+// withAlias applies the optional explicit alias and validates the result.
+func (f *field) withAlias(d dialect.SQLDialect, alias ...any) Token {
+	if len(alias) == 1 {
+		a, ok := alias[0].(string)
 		if !ok {
-			return f.SetError(fmt.Errorf("alias must be a string, got %T", input[1]))
+			return f.SetError(fmt.Errorf("alias must be a string, got %T", alias[0]))
 		}
-		if err := helpers.ValidateAlias(a); err != nil {
+		if err := helpers.ValidateAliasFor(d, a); err != nil {
 			return f.SetError(err)
 		}
 		f.alias = a
@@ -189,19 +239,26 @@ func (f *field) Expr() string { return f.expr }
 // Alias returns the optional alias.
 func (f *field) Alias() string { return f.alias }
 
+// Func returns the canonical function the field was built from, or nil
+// for fields built from a string expression.
+func (f *field) Func() *fn.Func { return f.fn }
+
 // IsAliased reports whether the field has a non-empty alias.
 func (f *field) IsAliased() bool { return strings.TrimSpace(f.alias) != "" }
 
 // Clone returns a semantic copy of the field, preserving all state and errors.
 func (f *field) Clone() Token {
-	cp := f
+	cp := *f
 	if f.owner != nil {
 		owner := *f.owner
 		cp.owner = &owner
 	}
-	return cp
+	return &cp
 }
 
+// Children returns nil: a field is a leaf of the query tree.
+func (f *field) Children() []contract.Node { return nil }
+
 // Debug returns a compact diagnostic view of the field.
 //
 // Example (valid):
@@ -230,7 +287,7 @@ func (f *field) Error() error { return f.err }
 
 // IsErrored reports whether the field is invalid (kind=Invalid or err non-nil).
 func (f *field) IsErrored() bool {
-	return f.ExpressionKind() == identifier.Invalid || f.err != nil
+	return f.ExpressionKind() == identifier.TypeInvalid || f.err != nil
 }
 
 // SetError assigns an error to the field and returns itself.
@@ -243,7 +300,7 @@ func (f *field) SetError(err error) Token {
 // (subquery, computed, function, aggregate).
 func (f *field) IsRaw() bool {
 	switch f.kind {
-	case identifier.Subquery, identifier.Computed, identifier.Function, identifier.Aggregate:
+	case identifier.TypeSubquery, identifier.TypeComputed, identifier.TypeFunction, identifier.TypeAggregate:
 		return true
 	default:
 		return false
@@ -254,9 +311,55 @@ func (f *field) IsRaw() bool {
 // If an alias is present, it returns "Expr AS Alias".
 // Errors do not suppress output — callers should check IsErrored().
 func (f *field) Raw() string {
-	base := f.expr
-	if f.alias != "" {
-		base = fmt.Sprintf("%s AS %s", base, f.alias)
+	return f.sql(f.alias)
+}
+
+// Render implements contract.Renderable. It returns Raw(), except that
+// fields built with NewWithDialect render their alias through the dialect's
+// QuoteIdentifier, so reserved words come out quoted.
+func (f *field) Render() string {
+	return f.RenderFor(f.dialect)
+}
+
+// RenderFor renders the field for dialect d regardless of the dialect it
+// was built with, quoting the alias through d. Fields built from a
+// canonical function are translated for d; when d has no translation the
+// neutral expression is kept (see Func). A nil d returns Raw().
+//
+// Example:
+//
+//	f := field.New("depth", "lvl")
+//	f.RenderFor(oracle.New()) // depth AS lvl
+//
+//	f = field.New(fn.Substring("name", 1, 3), "short")
+//	f.RenderFor(oracle.New()) // SUBSTR(name, 1, 3) AS short
+func (f *field) RenderFor(d dialect.SQLDialect) string {
+	if d == nil {
+		return f.Raw()
+	}
+	expr := f.expr
+	if f.fn != nil {
+		if s, err := f.fn.RenderFor(d); err == nil {
+			expr = s
+		}
+	}
+	alias := f.alias
+	if alias != "" {
+		alias = d.QuoteIdentifier(alias)
+	}
+	return f.render(expr, alias)
+}
+
+// sql renders the field with the given alias text.
+func (f *field) sql(alias string) string {
+	return f.render(f.expr, alias)
+}
+
+// render renders expr with the given alias text and the owner prefix.
+func (f *field) render(expr, alias string) string {
+	base := expr
+	if alias != "" {
+		base = fmt.Sprintf("%s AS %s", base, alias)
 	}
 	if f.owner != nil && !f.isRaw {
 		base = fmt.Sprintf("%s.%s", *f.owner, base)
@@ -264,9 +367,6 @@ func (f *field) Raw() string {
 	return base
 }
 
-// Render implements contract.Renderable by returning Raw().
-func (f *field) Render() string { return f.Raw() }
-
 // String implements fmt.Stringer, returning a user-friendly view of the field.
 // For developer diagnostics, use Debug(). For SQL, use Render()/Raw().
 func (f *field) String() string {
@@ -5,7 +5,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/entiqon/db/dialect/generic"
+	"github.com/entiqon/db/dialect/oracle"
 	"github.com/entiqon/db/token/field"
+	"github.com/entiqon/db/token/fn"
 	"github.com/entiqon/db/token/types/identifier"
 )
 
@@ -47,12 +50,12 @@ func TestField(t *testing.T) {
 
 				t.Run("Aliased", func(t *testing.T) {
 					f := field.New("field alias")
-					if f.ExpressionKind() != identifier.Expression || f.Expr() != "field" || f.Alias() != "alias" {
+					if f.ExpressionKind() != identifier.TypeExpression || f.Expr() != "field" || f.Alias() != "alias" {
 						t.Errorf("expected field, got %v", f.Expr())
 					}
 
 					f = field.New("field AS alias")
-					if f.ExpressionKind() != identifier.Expression || f.Expr() != "field" || f.Alias() != "alias" {
+					if f.ExpressionKind() != identifier.TypeExpression || f.Expr() != "field" || f.Alias() != "alias" {
 						t.Errorf("expected field, got %v", f.Expr())
 					}
 				})
@@ -78,7 +81,7 @@ func TestField(t *testing.T) {
 			t.Run("2-args", func(t *testing.T) {
 				t.Run("Default", func(t *testing.T) {
 					f := field.New("field", "alias")
-					if f.ExpressionKind() != identifier.Expression || f.Expr() != "field" || f.Alias() != "alias" {
+					if f.ExpressionKind() != identifier.TypeExpression || f.Expr() != "field" || f.Alias() != "alias" {
 						t.Errorf("expected id, got %v", f.Expr())
 					}
 				})
@@ -136,6 +139,69 @@ func TestField(t *testing.T) {
 				}
 			})
 		})
+
+		t.Run("NewWithDialect", func(t *testing.T) {
+			t.Run("ReservedAlias", func(t *testing.T) {
+				f := field.NewWithDialect(generic.New(), "id AS order")
+				if f.Error() != nil {
+					t.Fatalf("expected no error, got %v", f.Error())
+				}
+				if f.Raw() != "id AS order" || f.Render() != `id AS "order"` {
+					t.Errorf("unexpected Raw()=%q Render()=%q", f.Raw(), f.Render())
+				}
+
+				f = field.NewWithDialect(oracle.New(), "depth", "level")
+				if f.Error() != nil || f.Render() != `depth AS "LEVEL"` {
+					t.Errorf("expected depth AS \"LEVEL\", got %q (%v)", f.Render(), f.Error())
+				}
+			})
+
+			t.Run("Nil", func(t *testing.T) {
+				f := field.NewWithDialect(nil, "id", "order")
+				if f.Error() == nil || !strings.Contains(f.Error().Error(), "reserved keyword") {
+					t.Errorf("expected reserved keyword error, got %v", f.Error())
+				}
+			})
+
+			t.Run("RenderFor", func(t *testing.T) {
+				f := field.New("depth", "lvl")
+				if f.RenderFor(nil) != "depth AS lvl" {
+					t.Errorf("expected Raw() for nil dialect, got %q", f.RenderFor(nil))
+				}
+				f = field.NewWithDialect(generic.New(), "id AS order")
+				if got := f.RenderFor(oracle.New()); got != `id AS "ORDER"` {
+					t.Errorf("expected oracle quoting, got %q", got)
+				}
+				if got := field.New("id").RenderFor(oracle.New()); got != "id" {
+					t.Errorf("expected unaliased field, got %q", got)
+				}
+			})
+
+			t.Run("Func", func(t *testing.T) {
+				f := field.New(fn.Substring("name", 1, 3), "short")
+				if f.IsErrored() || f.ExpressionKind() != identifier.TypeFunction {
+					t.Fatalf("expected function field, got %s", f.Debug())
+				}
+				if f.Func() == nil || f.Func().Name() != "SUBSTRING" {
+					t.Errorf("expected SUBSTRING call, got %v", f.Func())
+				}
+				if got := f.Render(); got != "SUBSTRING(name FROM 1 FOR 3) AS short" {
+					t.Errorf("unexpected neutral render %q", got)
+				}
+				if got := f.RenderFor(oracle.New()); got != "SUBSTR(name, 1, 3) AS short" {
+					t.Errorf("unexpected oracle render %q", got)
+				}
+				if field.New("id").Func() != nil {
+					t.Error("expected no function for string fields")
+				}
+				if f := field.New(fn.Substring("name", 0)); !f.IsErrored() {
+					t.Error("expected invalid function to error the field")
+				}
+				if f := field.New(fn.Now(), 1); !f.IsErrored() {
+					t.Error("expected non-string alias to error the field")
+				}
+			})
+		})
 	})
 
 	t.Run("Contracts", func(t *testing.T) {
@@ -191,6 +257,12 @@ func TestField(t *testing.T) {
 			if cl.Owner() == &owner {
 				t.Error("expected clone to deep-copy owner, got same pointer")
 			}
+
+			other := "invoices"
+			cl.SetOwner(&other)
+			if *orig.Owner() != "orders" || orig == cl {
+				t.Errorf("expected clone to be independent of the original, got owner %q", *orig.Owner())
+			}
 		})
 
 		t.Run("Debuggable", func(t *testing.T) {
@@ -232,6 +304,12 @@ func TestField(t *testing.T) {
 			}
 		})
 
+		t.Run("Node", func(t *testing.T) {
+			if got := field.New("id").Children(); got != nil {
+				t.Errorf("expected a leaf, got %v", got)
+			}
+		})
+
 		t.Run("Rawable", func(t *testing.T) {
 			f := field.New("id")
 			if f.IsRaw() {
@@ -0,0 +1,41 @@
+// Package fn provides canonical SQL function calls that render with the
+// spelling of each dialect.
+//
+// # Overview
+//
+// Field tokens keep string expressions verbatim, so NOW(), IFNULL() or
+// LEN() only run on the engines that know them. A Func names a canonical
+// function instead, and dialect.RenderFunction translates it per dialect:
+//
+//	fn.Substring("name", 1, 3)
+//	// generic: SUBSTRING(name FROM 1 FOR 3)
+//	// oracle:  SUBSTR(name, 1, 3)
+//
+// # Functions
+//
+//   - Coalesce(args...)               — first non-NULL argument
+//   - Now()                           — current timestamp
+//   - Concat(args...)                 — string concatenation
+//   - Substring(expr, start, [len])   — 1-based substring
+//   - DateTrunc(unit, expr)           — timestamp truncation (Year ... Second)
+//   - Length(expr)                    — character length
+//   - Cast(expr, type)                — type conversion
+//   - New(name, args...)              — any other function registered
+//     through dialect.Options.WithFunctions
+//
+// Arguments are SQL expressions (strings), integers or nested Func values.
+//
+// # Translation
+//
+// RenderFor(d) renders a call for d; RenderFor(nil) and String() render the
+// neutral form. Dialects without a translation, such as DateTrunc on
+// Firebird, yield dialect.ErrNoTranslation, and builders refuse to build.
+//
+// # Integration
+//
+// field.New accepts a Func as its expression:
+//
+//	sb := selects.New(nil).
+//	    Fields(field.New(fn.Coalesce("nickname", "name"), "display")).
+//	    From("users")
+package fn
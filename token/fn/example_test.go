@@ -0,0 +1,35 @@
+package fn_test
+
+import (
+	"fmt"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/bigquery"
+	"github.com/entiqon/db/dialect/firebird"
+	"github.com/entiqon/db/dialect/generic"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/token/fn"
+)
+
+func ExampleFunc_RenderFor() {
+	f := fn.DateTrunc(fn.Month, "created_at")
+
+	for _, d := range []dialect.SQLDialect{oracle.New(), bigquery.New(), firebird.New()} {
+		sql, err := f.RenderFor(d)
+		fmt.Println(d.Name(), sql, err)
+	}
+	// Output:
+	// oracle TRUNC(created_at, 'MM') <nil>
+	// bigquery TIMESTAMP_TRUNC(created_at, MONTH) <nil>
+	// firebird  no function translation: DATE_TRUNC for firebird
+}
+
+func ExampleNew() {
+	d := generic.NewWithOptions(dialect.Options{Name: "sqlite"}.WithFunctions(dialect.FunctionMap{
+		"JSON_VALUE": dialect.Call("json_extract"),
+	}))
+
+	sql, _ := fn.New("JSON_VALUE", "data", "'$.id'").RenderFor(d)
+	fmt.Println(sql)
+	// Output: json_extract(data, '$.id')
+}
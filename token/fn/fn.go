@@ -0,0 +1,244 @@
+// File: db/token/fn/fn.go
+
+package fn
+
+import (
+	stdErr "errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/entiqon/db/dialect"
+)
+
+// Units accepted by DateTrunc.
+const (
+	Year    = "year"
+	Quarter = "quarter"
+	Month   = "month"
+	Week    = "week"
+	Day     = "day"
+	Hour    = "hour"
+	Minute  = "minute"
+	Second  = "second"
+)
+
+// units lists the valid DateTrunc units.
+var units = map[string]bool{
+	Year: true, Quarter: true, Month: true, Week: true,
+	Day: true, Hour: true, Minute: true, Second: true,
+}
+
+// neutral renders functions without a standard form when no dialect is
+// given, in their most common spelling.
+var neutral = dialect.FunctionMap{
+	dialect.FuncDateTrunc: func(args []string) (string, error) {
+		return fmt.Sprintf("DATE_TRUNC('%s', %s)", args[0], args[1]), nil
+	},
+}
+
+// Func is a canonical function call, such as COALESCE(a, b), rendered with
+// the spelling of each dialect.
+//
+// Arguments are SQL expressions (strings, emitted verbatim), integers, or
+// nested Func values. Construction never panics: invalid input is kept in
+// Error() and makes RenderFor fail.
+type Func struct {
+	name string
+	args []any
+	err  error
+}
+
+// New returns a call to the canonical function name. It is the extension
+// point for functions registered through dialect.Options.WithFunctions; the
+// named constructors below cover the built-in ones.
+//
+// Example:
+//
+//	f := fn.New("JSON_VALUE", "data", "'$.id'")
+func New(name string, args ...any) Func {
+	f := Func{name: strings.ToUpper(strings.TrimSpace(name)), args: args}
+	if f.name == "" {
+		f.err = stdErr.New("function name is empty")
+		return f
+	}
+	for i, a := range args {
+		if err := validateArg(a); err != nil {
+			f.err = fmt.Errorf("%s: argument %d: %w", f.name, i+1, err)
+			return f
+		}
+	}
+	return f
+}
+
+// Coalesce returns the first non-NULL argument.
+//
+// Example:
+//
+//	fn.Coalesce("nickname", "name") // COALESCE(nickname, name)
+func Coalesce(args ...any) Func {
+	return arity(New(dialect.FuncCoalesce, args...), 1)
+}
+
+// Now returns the current timestamp.
+func Now() Func {
+	return New(dialect.FuncNow)
+}
+
+// Concat concatenates its arguments as strings.
+//
+// Example:
+//
+//	fn.Concat("first_name", "' '", "last_name") // (first_name || ' ' || last_name)
+func Concat(args ...any) Func {
+	return arity(New(dialect.FuncConcat, args...), 2)
+}
+
+// Substring returns the characters of expr from the 1-based position start,
+// optionally limited to length characters.
+//
+// Example:
+//
+//	fn.Substring("name", 1, 3) // SUBSTRING(name FROM 1 FOR 3)
+func Substring(expr any, start int, length ...int) Func {
+	args := []any{expr, start}
+	for _, n := range length {
+		args = append(args, n)
+	}
+	f := New(dialect.FuncSubstring, args...)
+	switch {
+	case f.err != nil:
+	case start < 1:
+		f.err = fmt.Errorf("%s: start must be positive, got %d", f.name, start)
+	case len(length) > 1:
+		f.err = fmt.Errorf("%s: expected at most one length, got %d", f.name, len(length))
+	case len(length) == 1 && length[0] < 0:
+		f.err = fmt.Errorf("%s: length must not be negative, got %d", f.name, length[0])
+	}
+	return f
+}
+
+// DateTrunc truncates the timestamp expr to unit (Year, Month, Day, ...).
+//
+// Example:
+//
+//	fn.DateTrunc(fn.Month, "created_at")
+//	// postgres-like: DATE_TRUNC('month', created_at)
+//	// oracle:        TRUNC(created_at, 'MM')
+//	// bigquery:      TIMESTAMP_TRUNC(created_at, MONTH)
+func DateTrunc(unit string, expr any) Func {
+	unit = strings.ToLower(strings.TrimSpace(unit))
+	f := New(dialect.FuncDateTrunc, unit, expr)
+	if f.err == nil && !units[unit] {
+		f.err = fmt.Errorf("%s: unknown unit %q", f.name, unit)
+	}
+	return f
+}
+
+// Length returns the number of characters of expr.
+func Length(expr any) Func {
+	return New(dialect.FuncLength, expr)
+}
+
+// Cast converts expr to the SQL type typ.
+//
+// Example:
+//
+//	fn.Cast("price", "DECIMAL(10,2)") // CAST(price AS DECIMAL(10,2))
+func Cast(expr any, typ string) Func {
+	f := New(dialect.FuncCast, expr, typ)
+	if f.err == nil && strings.TrimSpace(typ) == "" {
+		f.err = fmt.Errorf("%s: type is empty", f.name)
+	}
+	return f
+}
+
+// Name returns the canonical function name (e.g. "COALESCE").
+func (f Func) Name() string { return f.name }
+
+// Args returns the arguments as given to the constructor.
+func (f Func) Args() []any { return f.args }
+
+// Error returns the construction error, if any.
+func (f Func) Error() error { return f.err }
+
+// RenderFor renders the call for dialect d through dialect.RenderFunction.
+// Nested calls are rendered for d as well. A nil d renders the neutral
+// form: standard SQL, or the common spelling for functions without one.
+//
+// Example:
+//
+//	fn.Substring("name", 1, 3).RenderFor(oracle.New()) // SUBSTR(name, 1, 3), nil
+//	fn.DateTrunc(fn.Day, "ts").RenderFor(generic.New()) // "", no function translation
+func (f Func) RenderFor(d dialect.SQLDialect) (string, error) {
+	if f.err != nil {
+		return "", f.err
+	}
+
+	args := make([]string, len(f.args))
+	for i, a := range f.args {
+		s, err := renderArg(d, a)
+		if err != nil {
+			return "", err
+		}
+		args[i] = s
+	}
+
+	if d != nil {
+		return dialect.RenderFunction(d, f.name, args)
+	}
+	if r, ok := dialect.StandardFunctions[f.name]; ok {
+		return r(args)
+	}
+	if r, ok := neutral[f.name]; ok {
+		return r(args)
+	}
+	return dialect.Call(f.name)(args)
+}
+
+// String returns the neutral rendering, or the construction error.
+func (f Func) String() string {
+	s, err := f.RenderFor(nil)
+	if err != nil {
+		return fmt.Sprintf("Func(%q): %v", f.name, err)
+	}
+	return s
+}
+
+// arity sets an error on f when it has fewer than n arguments.
+func arity(f Func, n int) Func {
+	if f.err == nil && len(f.args) < n {
+		f.err = fmt.Errorf("%s: expected at least %d arguments, got %d", f.name, n, len(f.args))
+	}
+	return f
+}
+
+// validateArg reports whether a is a supported argument.
+func validateArg(a any) error {
+	switch v := a.(type) {
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return stdErr.New("empty expression")
+		}
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+	case Func:
+		return v.err
+	default:
+		return fmt.Errorf("unsupported type %T", a)
+	}
+	return nil
+}
+
+// renderArg renders a validated argument for d.
+func renderArg(d dialect.SQLDialect, a any) (string, error) {
+	switch v := a.(type) {
+	case string:
+		return strings.TrimSpace(v), nil
+	case Func:
+		return v.RenderFor(d)
+	case int:
+		return strconv.Itoa(v), nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
@@ -0,0 +1,97 @@
+package fn_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/bigquery"
+	"github.com/entiqon/db/dialect/firebird"
+	"github.com/entiqon/db/dialect/generic"
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/token/fn"
+)
+
+func TestFunc(t *testing.T) {
+	t.Run("Constructors", func(t *testing.T) {
+		cases := []struct {
+			f    fn.Func
+			name string
+			want string
+		}{
+			{fn.Coalesce("a", "b"), "COALESCE", "COALESCE(a, b)"},
+			{fn.Now(), "NOW", "CURRENT_TIMESTAMP"},
+			{fn.Concat("a", "' '", "b"), "CONCAT", "(a || ' ' || b)"},
+			{fn.Substring("s", 2), "SUBSTRING", "SUBSTRING(s FROM 2)"},
+			{fn.Substring("s", 1, 3), "SUBSTRING", "SUBSTRING(s FROM 1 FOR 3)"},
+			{fn.DateTrunc("Month", "ts"), "DATE_TRUNC", "DATE_TRUNC('month', ts)"},
+			{fn.Length("s"), "LENGTH", "CHAR_LENGTH(s)"},
+			{fn.Cast("x", "INTEGER"), "CAST", "CAST(x AS INTEGER)"},
+			{fn.New("json_value", "data", "'$.id'"), "JSON_VALUE", "JSON_VALUE(data, '$.id')"},
+			{fn.Coalesce("a", fn.Length("b")), "COALESCE", "COALESCE(a, CHAR_LENGTH(b))"},
+		}
+		for _, c := range cases {
+			if c.f.Error() != nil {
+				t.Errorf("%s: unexpected error %v", c.name, c.f.Error())
+			}
+			if c.f.Name() != c.name {
+				t.Errorf("expected name %q, got %q", c.name, c.f.Name())
+			}
+			if got := c.f.String(); got != c.want {
+				t.Errorf("expected %q, got %q", c.want, got)
+			}
+		}
+		if len(fn.Substring("s", 1, 3).Args()) != 3 {
+			t.Error("expected Args to keep all arguments")
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		cases := []struct {
+			f    fn.Func
+			want string
+		}{
+			{fn.New(" "), "function name is empty"},
+			{fn.Coalesce(), "expected at least 1 arguments"},
+			{fn.Concat("a"), "expected at least 2 arguments"},
+			{fn.Substring("s", 0), "start must be positive"},
+			{fn.Substring("s", 1, 2, 3), "at most one length"},
+			{fn.Substring("s", 1, -1), "must not be negative"},
+			{fn.Substring("", 1), "empty expression"},
+			{fn.DateTrunc("decade", "ts"), "unknown unit"},
+			{fn.Cast("x", " "), "empty"},
+			{fn.Length(3.5), "unsupported type float64"},
+			{fn.Coalesce("a", fn.Concat("b")), "expected at least 2 arguments"},
+		}
+		for _, c := range cases {
+			if c.f.Error() == nil || !strings.Contains(c.f.Error().Error(), c.want) {
+				t.Errorf("expected error containing %q, got %v", c.want, c.f.Error())
+			}
+			if _, err := c.f.RenderFor(generic.New()); err == nil {
+				t.Errorf("expected RenderFor to fail for %q", c.want)
+			}
+			if !strings.HasPrefix(c.f.String(), "Func(") {
+				t.Errorf("expected errored String, got %q", c.f.String())
+			}
+		}
+	})
+
+	t.Run("RenderFor", func(t *testing.T) {
+		f := fn.Coalesce("nickname", fn.Concat("first_name", "' '", "last_name"))
+		got, err := f.RenderFor(bigquery.New())
+		if err != nil || got != "COALESCE(nickname, CONCAT(first_name, ' ', last_name))" {
+			t.Errorf("unexpected bigquery render %q (%v)", got, err)
+		}
+
+		got, err = fn.DateTrunc(fn.Month, "ts").RenderFor(oracle.New())
+		if err != nil || got != "TRUNC(ts, 'MM')" {
+			t.Errorf("unexpected oracle render %q (%v)", got, err)
+		}
+
+		_, err = fn.Coalesce("a", fn.DateTrunc(fn.Day, "ts")).RenderFor(firebird.New())
+		if !errors.Is(err, dialect.ErrNoTranslation) {
+			t.Errorf("expected nested ErrNoTranslation, got %v", err)
+		}
+	})
+}
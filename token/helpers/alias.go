@@ -5,11 +5,13 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+
+	"github.com/entiqon/db/dialect"
 )
 
 // reserved defines a minimal, dialect-agnostic set of SQL keywords
-// that cannot be used as identifiers or aliases.
-// Future dialect-specific packages may extend or override this list.
+// that cannot be used as identifiers or aliases when no dialect is known.
+// Dialect-specific sets are reported by dialect.KeywordsOf.
 var reserved = map[string]struct{}{
 	"AS": {}, "SELECT": {}, "FROM": {}, "WHERE": {}, "JOIN": {},
 	"ON": {}, "GROUP": {}, "ORDER": {}, "BY": {}, "LIMIT": {},
@@ -28,9 +30,13 @@ var reserved = map[string]struct{}{
 //   - "invalid identifier syntax: ..."
 //   - Must not be a reserved keyword (case-insensitive).
 //
-// Future: reserved keyword rules will be extended or delegated
-// to dialect-specific packages (e.g. Postgres, MySQL).
+// The reserved keywords are the dialect-agnostic set returned by
+// ReservedKeywords. Use ValidateAliasFor when the target dialect is known.
+// Quoted aliases ("Total") are accepted as written.
 func ValidateAlias(s string) error {
+	if IsQuotedIdentifier(s) {
+		return nil
+	}
 	if err := ValidateIdentifier(s); err != nil {
 		return fmt.Errorf("invalid alias %w", err)
 	}
@@ -40,6 +46,41 @@ func ValidateAlias(s string) error {
 	return nil
 }
 
+// ValidateAliasFor checks whether s is a valid alias for dialect d.
+//
+// Unlike ValidateAlias, words reserved by d are not rejected: a token bound
+// to d renders its alias through d.QuoteIdentifier, which quotes them. The
+// bare keyword AS is still rejected, as it always denotes a malformed
+// alias clause. Dialects implementing dialect.IdentifierValidator enforce
+// their own limits, such as Oracle's maximum identifier length.
+//
+// A nil d falls back to ValidateAlias.
+//
+// Example:
+//
+//	helpers.ValidateAlias("order")                   // → error
+//	helpers.ValidateAliasFor(generic.New(), "order") // → nil, renders "order"
+func ValidateAliasFor(d dialect.SQLDialect, s string) error {
+	if d == nil {
+		return ValidateAlias(s)
+	}
+	if IsQuotedIdentifier(s) {
+		return nil
+	}
+	if err := ValidateIdentifier(s); err != nil {
+		return fmt.Errorf("invalid alias %w", err)
+	}
+	if strings.EqualFold(s, "AS") {
+		return fmt.Errorf("alias is a reserved keyword: %q", s)
+	}
+	if v, ok := d.(dialect.IdentifierValidator); ok {
+		if err := v.ValidateIdentifier(s); err != nil {
+			return fmt.Errorf("invalid alias %w", err)
+		}
+	}
+	return nil
+}
+
 // IsValidAlias reports whether s is a valid alias.
 // It is a convenience wrapper around ValidateAlias, returning true
 // if the alias is valid and false otherwise.
@@ -97,8 +138,9 @@ func HasTrailingAlias(expr string) bool {
 // and can be used safely in tests or diagnostics without risk of
 // modification.
 //
-// The list is dialect-agnostic and intentionally minimal; dialect
-// packages may extend it with additional keywords.
+// The list is dialect-agnostic and intentionally minimal. Vendor
+// reserved and non-reserved keywords are available through
+// dialect.KeywordsOf, and are honored by ValidateAliasFor.
 func ReservedKeywords() []string {
 	out := make([]string, 0, len(reserved))
 	for k := range reserved {
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/entiqon/db/dialect/generic"
+	"github.com/entiqon/db/dialect/oracle"
 	"github.com/entiqon/db/token/helpers"
 	"github.com/entiqon/db/token/types/identifier"
 )
@@ -57,6 +59,19 @@ func ExampleIsValidAlias() {
 	// false
 }
 
+// ExampleValidateAliasFor shows that reserved words are rejected without a
+// dialect but accepted, and later quoted, when one is given.
+func ExampleValidateAliasFor() {
+	fmt.Println(helpers.ValidateAlias("order"))
+	fmt.Println(helpers.ValidateAliasFor(generic.New(), "order"))
+	fmt.Println(helpers.ValidateAliasFor(oracle.New(), "level"))
+
+	// Output:
+	// alias is a reserved keyword: "order"
+	// <nil>
+	// <nil>
+}
+
 // ExampleValidateTrailingAlias demonstrates extracting a trailing alias
 // when no explicit AS is present.
 func ExampleValidateTrailingAlias() {
@@ -82,7 +97,7 @@ func ExampleValidateTrailingAlias() {
 // from a prefix and expression string.
 func ExampleGenerateAlias() {
 	// Function expression with "fn" prefix
-	got := helpers.GenerateAlias(identifier.Function.Alias(), "SUM(price)")
+	got := helpers.GenerateAlias(identifier.TypeFunction.Alias(), "SUM(price)")
 	fmt.Println(fmt.Sprintf(
 		"Contains(fn)=%t, Length=%d",
 		strings.Contains(got, "fn"),
@@ -90,7 +105,7 @@ func ExampleGenerateAlias() {
 	))
 
 	// Subquery expression with "sq" prefix
-	got = helpers.GenerateAlias(identifier.Subquery.Alias(), "(SELECT * FROM users)")
+	got = helpers.GenerateAlias(identifier.TypeSubquery.Alias(), "(SELECT * FROM users)")
 	fmt.Println(fmt.Sprintf(
 		"Contains(sq)=%t, Length=%d",
 		strings.Contains(got, "fn"),
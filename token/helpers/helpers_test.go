@@ -5,6 +5,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/entiqon/db/dialect"
+	"github.com/entiqon/db/dialect/generic"
+	"github.com/entiqon/db/dialect/oracle"
 	"github.com/entiqon/db/token/field"
 	"github.com/entiqon/db/token/helpers"
 	"github.com/entiqon/db/token/types/identifier"
@@ -104,6 +107,37 @@ func TestHelpers(t *testing.T) {
 		}
 	})
 
+	t.Run("ValidateAliasFor", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			d     dialect.SQLDialect
+			alias string
+			valid bool
+		}{
+			{"NilReserved", nil, "order", false},
+			{"NilPlain", nil, "total", true},
+			{"GenericReserved", generic.New(), "order", true},
+			{"OracleReserved", oracle.New(), "level", true},
+			{"OracleTooLong", oracle.New(), strings.Repeat("a", 129), false},
+			{"BareAS", generic.New(), "as", false},
+			{"InvalidSyntax", generic.New(), "123abc", false},
+			{"Quoted", generic.New(), `"Order Total"`, true},
+			{"NilQuoted", nil, `"order"`, true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := helpers.ValidateAliasFor(tt.d, tt.alias)
+				if tt.valid && err != nil {
+					t.Errorf("expected %q to be valid, got %v", tt.alias, err)
+				}
+				if !tt.valid && err == nil {
+					t.Errorf("expected %q to be invalid, got nil", tt.alias)
+				}
+			})
+		}
+	})
+
 	t.Run("TrailingAlias", func(t *testing.T) {
 		tests := []struct {
 			name     string
@@ -237,15 +271,16 @@ func TestHelpers(t *testing.T) {
 			expr string
 			want identifier.Type
 		}{
-			{"Empty", "", identifier.Invalid},
-			{"Subquery", "(SELECT * FROM users)", identifier.Subquery},
-			{"Computed", "(a+b)", identifier.Computed},
-			{"AggregateSUM", "SUM(qty)", identifier.Aggregate},
-			{"AggregateCOUNT", "COUNT(*)", identifier.Aggregate},
-			{"Function", "JSON_EXTRACT(data, '$.id')", identifier.Function},
-			{"LiteralString", "'abc'", identifier.Literal},
-			{"LiteralNumber", "42", identifier.Literal},
-			{"Identifier", "users", identifier.Expression},
+			{"Empty", "", identifier.TypeInvalid},
+			{"Subquery", "(SELECT * FROM users)", identifier.TypeSubquery},
+			{"Computed", "(a+b)", identifier.TypeComputed},
+			{"AggregateSUM", "SUM(qty)", identifier.TypeAggregate},
+			{"AggregateCOUNT", "COUNT(*)", identifier.TypeAggregate},
+			{"Function", "JSON_EXTRACT(data, '$.id')", identifier.TypeFunction},
+			{"LiteralString", "'abc'", identifier.TypeLiteral},
+			{"LiteralNumber", "42", identifier.TypeLiteral},
+			{"Identifier", "users", identifier.TypeExpression},
+			{"QuotedIdentifier", `"UserId"`, identifier.TypeExpression},
 		}
 
 		for _, tt := range tests {
@@ -270,61 +305,69 @@ func TestHelpers(t *testing.T) {
 		}{
 			//
 			// === Invalid ===
-			{"EmptyInput", "", true, identifier.Invalid, "", "", true},
-			{"GarbageInput", "foo bar baz qux", true, identifier.Invalid, "", "", true},
+			{"EmptyInput", "", true, identifier.TypeInvalid, "", "", true},
+			{"GarbageInput", "foo bar baz qux", true, identifier.TypeInvalid, "", "", true},
 
 			//=== Identifiers ===
-			{"Identifier", "field", true, identifier.Expression, "field", "", false},
-			{"IdentifierWithAlias", "field alias", true, identifier.Expression, "field", "alias", false},
-			{"IdentifierWithNotAllowAlias", "field alias", false, identifier.Expression, "field", "alias", true},
-			{"IdentifierWithInvalidAlias", "field 123invalid", true, identifier.Invalid, "field", "123invalid", true},
-			{"IdentifierWithASAlias", "field AS alias", true, identifier.Expression, "field", "alias", false},
-			{"IdentifierWithASAliasNotAllowAlias", "field AS alias", false, identifier.Expression, "field", "alias", true},
-			{"IdentifierWithASAliasInvalidAlias", "field AS 123invalid", true, identifier.Invalid, "field", "123invalid", true},
-			{"IdentifierInvalidForm", "field alias extra", true, identifier.Invalid, "", "", true},
-			{"IdentifierTooManyTokens", "field AS alias extra", true, identifier.Invalid, "", "", true},
+			{"Identifier", "field", true, identifier.TypeExpression, "field", "", false},
+			{"IdentifierWithAlias", "field alias", true, identifier.TypeExpression, "field", "alias", false},
+			{"IdentifierWithNotAllowAlias", "field alias", false, identifier.TypeExpression, "field", "alias", true},
+			{"IdentifierWithInvalidAlias", "field 123invalid", true, identifier.TypeInvalid, "field", "123invalid", true},
+			{"IdentifierWithASAlias", "field AS alias", true, identifier.TypeExpression, "field", "alias", false},
+			{"IdentifierWithASAliasNotAllowAlias", "field AS alias", false, identifier.TypeExpression, "field", "alias", true},
+			{"IdentifierWithASAliasInvalidAlias", "field AS 123invalid", true, identifier.TypeInvalid, "field", "123invalid", true},
+			{"IdentifierInvalidForm", "field alias extra", true, identifier.TypeInvalid, "", "", true},
+			{"IdentifierTooManyTokens", "field AS alias extra", true, identifier.TypeInvalid, "", "", true},
 
 			// === Subqueries ===
-			{"SubqueryNoAlias", "(SELECT * FROM users)", true, identifier.Subquery, "(SELECT * FROM users)", "", false},
-			{"SubqueryNoAlias", "(SELECT * FROM users", true, identifier.Subquery, "(SELECT * FROM users", "", true},
-			{"SubqueryWithAlias", "(SELECT * FROM users) u", true, identifier.Subquery, "(SELECT * FROM users)", "u", false},
-			{"SubqueryWithAliasNotAllowAlias", "(SELECT * FROM users) u", false, identifier.Subquery, "(SELECT * FROM users)", "u", true},
-			{"SubqueryWithAliasInvalidAlias", "(SELECT * FROM users) 123u", true, identifier.Subquery, "(SELECT * FROM users)", "123u", true},
-			{"SubqueryWithASAlias", "(SELECT * FROM users) AS u", true, identifier.Subquery, "(SELECT * FROM users)", "u", false},
-			{"SubqueryWithASAliasNotAllowAlias", "(SELECT * FROM users) AS u", false, identifier.Subquery, "(SELECT * FROM users)", "u", true},
-			{"SubqueryWithASAliasInvalidAlias", "(SELECT * FROM users) AS 123u", true, identifier.Subquery, "(SELECT * FROM users)", "123u", true},
-			{"BareSelectRejected", "SELECT * FROM users", true, identifier.Invalid, "", "", true},
-			{"SubqueryInvalidAlias", "(SELECT * FROM users) AS abc 123", true, identifier.Invalid, "", "", true},
+			{"SubqueryNoAlias", "(SELECT * FROM users)", true, identifier.TypeSubquery, "(SELECT * FROM users)", "", false},
+			{"SubqueryNoAlias", "(SELECT * FROM users", true, identifier.TypeSubquery, "(SELECT * FROM users", "", true},
+			{"SubqueryWithAlias", "(SELECT * FROM users) u", true, identifier.TypeSubquery, "(SELECT * FROM users)", "u", false},
+			{"SubqueryWithAliasNotAllowAlias", "(SELECT * FROM users) u", false, identifier.TypeSubquery, "(SELECT * FROM users)", "u", true},
+			{"SubqueryWithAliasInvalidAlias", "(SELECT * FROM users) 123u", true, identifier.TypeSubquery, "(SELECT * FROM users)", "123u", true},
+			{"SubqueryWithASAlias", "(SELECT * FROM users) AS u", true, identifier.TypeSubquery, "(SELECT * FROM users)", "u", false},
+			{"SubqueryWithASAliasNotAllowAlias", "(SELECT * FROM users) AS u", false, identifier.TypeSubquery, "(SELECT * FROM users)", "u", true},
+			{"SubqueryWithASAliasInvalidAlias", "(SELECT * FROM users) AS 123u", true, identifier.TypeSubquery, "(SELECT * FROM users)", "123u", true},
+			{"BareSelectRejected", "SELECT * FROM users", true, identifier.TypeInvalid, "", "", true},
+			{"SubqueryInvalidAlias", "(SELECT * FROM users) AS abc 123", true, identifier.TypeInvalid, "", "", true},
 
 			// === Computed ===
-			{"ComputedNoAlias", "(price * qty)", true, identifier.Computed, "(price * qty)", "", false},
-			{"ComputedWithAlias", "(price * qty) total", true, identifier.Computed, "(price * qty)", "total", false},
-			{"ComputedWithASAlias", "(price * qty) AS total", true, identifier.Computed, "(price * qty)", "total", false},
-			{"ComputedWithASAlias", "(price * qty) AS abc 123", true, identifier.Computed, "", "", true},
-			{"ComputedBareRejected", "price * qty", true, identifier.Invalid, "", "", true},
+			{"ComputedNoAlias", "(price * qty)", true, identifier.TypeComputed, "(price * qty)", "", false},
+			{"ComputedWithAlias", "(price * qty) total", true, identifier.TypeComputed, "(price * qty)", "total", false},
+			{"ComputedWithASAlias", "(price * qty) AS total", true, identifier.TypeComputed, "(price * qty)", "total", false},
+			{"ComputedWithASAlias", "(price * qty) AS abc 123", true, identifier.TypeComputed, "", "", true},
+			{"ComputedBareRejected", "price * qty", true, identifier.TypeInvalid, "", "", true},
 
 			// === Aggregates ===
-			{"AggregateCount", "COUNT(*)", true, identifier.Aggregate, "COUNT(*)", "", false},
-			{"AggregateCountWithAlias", "COUNT(*) total", true, identifier.Aggregate, "COUNT(*)", "total", false},
-			{"AggregateCountWithASAlias", "COUNT(*) AS total", true, identifier.Aggregate, "COUNT(*)", "total", false},
-			{"AggregateSum", "SUM(price * qty)", true, identifier.Aggregate, "SUM(price * qty)", "", false},
-			{"AggregateSumWithAlias", "SUM(price * qty) total", true, identifier.Aggregate, "SUM(price * qty)", "total", false},
-			{"AggregateSumWithASAlias", "SUM(price * qty) AS total", true, identifier.Aggregate, "SUM(price * qty)", "total", false},
-			{"ComputedInvalidAlias", "SUM(price * qty) AS abc 123", true, identifier.Computed, "", "", true},
+			{"AggregateCount", "COUNT(*)", true, identifier.TypeAggregate, "COUNT(*)", "", false},
+			{"AggregateCountWithAlias", "COUNT(*) total", true, identifier.TypeAggregate, "COUNT(*)", "total", false},
+			{"AggregateCountWithASAlias", "COUNT(*) AS total", true, identifier.TypeAggregate, "COUNT(*)", "total", false},
+			{"AggregateSum", "SUM(price * qty)", true, identifier.TypeAggregate, "SUM(price * qty)", "", false},
+			{"AggregateSumWithAlias", "SUM(price * qty) total", true, identifier.TypeAggregate, "SUM(price * qty)", "total", false},
+			{"AggregateSumWithASAlias", "SUM(price * qty) AS total", true, identifier.TypeAggregate, "SUM(price * qty)", "total", false},
+			{"ComputedInvalidAlias", "SUM(price * qty) AS abc 123", true, identifier.TypeComputed, "", "", true},
 
 			// === Functions ===
-			{"FunctionNoAlias", "JSON_EXTRACT(data,'$.id')", true, identifier.Function, "JSON_EXTRACT(data,'$.id')", "", false},
-			{"FunctionWithAlias", "LOWER(name) alias", true, identifier.Function, "LOWER(name)", "alias", false},
-			{"FunctionWithASAlias", "LOWER(name) AS alias", true, identifier.Function, "LOWER(name)", "alias", false},
-			{"FunctionWithReservedAlias", "LOWER(name) AS SELECT", true, identifier.Invalid, "", "", true},
-			{"ComputedInvalidAlias", "LOWER(name) AS abc 123", true, identifier.Computed, "", "", true},
+			{"FunctionNoAlias", "JSON_EXTRACT(data,'$.id')", true, identifier.TypeFunction, "JSON_EXTRACT(data,'$.id')", "", false},
+			{"FunctionWithAlias", "LOWER(name) alias", true, identifier.TypeFunction, "LOWER(name)", "alias", false},
+			{"FunctionWithASAlias", "LOWER(name) AS alias", true, identifier.TypeFunction, "LOWER(name)", "alias", false},
+			{"FunctionWithReservedAlias", "LOWER(name) AS SELECT", true, identifier.TypeInvalid, "", "", true},
+			{"ComputedInvalidAlias", "LOWER(name) AS abc 123", true, identifier.TypeComputed, "", "", true},
 
 			// === Literals ===
-			{"LiteralString", "'abc'", true, identifier.Literal, "'abc'", "", false},
-			{"LiteralStringWithAlias", "'abc' val", true, identifier.Literal, "'abc'", "val", false},
-			{"LiteralStringWithASAlias", "'abc' AS val", true, identifier.Literal, "'abc'", "val", false},
-			{"LiteralStringWithReservedAlias", "'abc' AS SELECT", true, identifier.Invalid, "", "", true},
-			{"LiteralInvalidAlias", "'abc' AS abc 123", true, identifier.Computed, "", "", true},
+			{"LiteralString", "'abc'", true, identifier.TypeLiteral, "'abc'", "", false},
+			{"LiteralStringWithAlias", "'abc' val", true, identifier.TypeLiteral, "'abc'", "val", false},
+			{"LiteralStringWithASAlias", "'abc' AS val", true, identifier.TypeLiteral, "'abc'", "val", false},
+			{"LiteralStringWithReservedAlias", "'abc' AS SELECT", true, identifier.TypeInvalid, "", "", true},
+			{"LiteralInvalidAlias", "'abc' AS abc 123", true, identifier.TypeComputed, "", "", true},
+
+			// === Quoted identifiers ===
+			{"Quoted", `"UserId"`, true, identifier.TypeExpression, `"UserId"`, "", false},
+			{"QuotedQualified", `u."Last Name" AS "Name"`, true, identifier.TypeExpression, `u."Last Name"`, `"Name"`, false},
+			{"QuotedReservedAlias", `id AS "order"`, true, identifier.TypeExpression, "id", `"order"`, false},
+			{"QuotedFunctionAlias", `LOWER(name) AS "Full Name"`, true, identifier.TypeFunction, "LOWER(name)", `"Full Name"`, false},
+			{"QuotedEmpty", `""`, true, identifier.TypeInvalid, "", "", true},
+			{"QuotedUnbalanced", `"User"Id"`, true, identifier.TypeInvalid, "", "", true},
 		}
 
 		for _, tt := range tests {
@@ -355,6 +398,41 @@ func TestHelpers(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("ResolveExpressionFor", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			d         dialect.SQLDialect
+			input     string
+			wantKind  identifier.Type
+			wantAlias string
+			wantErr   bool
+		}{
+			{"NilDialect", nil, "id AS order", identifier.TypeInvalid, "", true},
+			{"ReservedAlias", generic.New(), "id AS order", identifier.TypeExpression, "order", false},
+			{"ReservedFunctionAlias", generic.New(), "LOWER(name) AS select", identifier.TypeFunction, "select", false},
+			{"VendorAlias", oracle.New(), "depth level", identifier.TypeExpression, "level", false},
+			{"VendorIdentifier", oracle.New(), strings.Repeat("a", 129), identifier.TypeInvalid, "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				kind, _, alias, err := helpers.ResolveExpressionFor(tt.d, tt.input, true)
+				if tt.wantErr {
+					if err == nil {
+						t.Errorf("expected error, got nil (kind=%v alias=%q)", kind, alias)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if kind != tt.wantKind || alias != tt.wantAlias {
+					t.Errorf("got kind=%v alias=%q, want kind=%v alias=%q", kind, alias, tt.wantKind, tt.wantAlias)
+				}
+			})
+		}
+	})
 }
 
 func TestValidateType(t *testing.T) {
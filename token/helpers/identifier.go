@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"unicode"
 
 	"github.com/entiqon/common/extension"
 	"github.com/entiqon/db/contract"
+	"github.com/entiqon/db/dialect"
 	"github.com/entiqon/db/errors"
 	"github.com/entiqon/db/token/helpers/wildcard"
 	"github.com/entiqon/db/token/types/identifier"
@@ -33,9 +35,33 @@ func IsValidIdentifier(s string) bool {
 // Classification covers identifiers, subqueries, computed expressions,
 // aggregates, functions, and literals. Inline or explicit aliases are
 // supported depending on allowAlias.
+//
+// Aliases are checked with ValidateAlias, so the dialect-agnostic reserved
+// keywords are rejected. Use ResolveExpressionFor when the target dialect
+// is known.
 func ResolveExpression(
 	input any,
 	allowAlias bool,
+) (identifier.Type, string, string, error) {
+	return ResolveExpressionFor(nil, input, allowAlias)
+}
+
+// ResolveExpressionFor behaves like ResolveExpression, but validates names
+// against dialect d: aliases go through ValidateAliasFor, so words reserved
+// by d are accepted and left for d.QuoteIdentifier to quote, and plain
+// identifiers are checked by d when it implements
+// dialect.IdentifierValidator. A nil d is equivalent to ResolveExpression.
+//
+// Example:
+//
+//	helpers.ResolveExpression("id AS order", true)
+//	// Invalid, "id", "", invalid alias: order
+//	helpers.ResolveExpressionFor(generic.New(), "id AS order", true)
+//	// Expression, "id", "order", nil
+func ResolveExpressionFor(
+	d dialect.SQLDialect,
+	input any,
+	allowAlias bool,
 ) (identifier.Type, string, string, error) {
 	in := strings.TrimSpace(input.(string))
 	kind := ResolveExpressionType(in)
@@ -43,29 +69,24 @@ func ResolveExpression(
 	var expr, alias string
 	var err error
 	switch kind {
-	case identifier.TypeWildcard:
-		expr, err = wildcard.ParseWildcard(in)
-		if err != nil {
-			return kind, "", "", err
-		}
-
 	case identifier.TypeExpression:
-		parts := strings.Fields(in)
+		parts := splitFields(in)
 		switch len(parts) {
 		case 1:
 			expr = parts[0]
 
 		case 2:
 			if !allowAlias {
-				return kind, "", "", stdErr.New("alias not allowed: " + in)
+				return identifier.TypeInvalid, "", "", stdErr.New("alias not allowed: " + in)
 			}
 			expr, alias = parts[0], parts[1]
 
 		case 3:
-			if strings.EqualFold(parts[1], "AS") {
-				if !allowAlias {
-					return kind, "", "", stdErr.New("alias not allowed: " + in)
-				}
+			if !strings.EqualFold(parts[1], "AS") {
+				return identifier.TypeInvalid, "", "", stdErr.New("invalid identifier: " + in)
+			}
+			if !allowAlias {
+				return identifier.TypeInvalid, "", "", stdErr.New("alias not allowed: " + in)
 			}
 			expr, alias = parts[0], parts[2]
 
@@ -73,22 +94,33 @@ func ResolveExpression(
 			return identifier.TypeInvalid, "", "", stdErr.New("invalid identifier: " + in)
 		}
 
-	case identifier.TypeSubquery:
-		closeIdx := strings.LastIndex(in, ")")
-		expr = strings.TrimSpace(in[:closeIdx+1])
-		rest := strings.TrimSpace(in[closeIdx+1:])
-		alias, err = resolveAlias(rest, in, allowAlias)
-		if err != nil {
-			return kind, expr, alias, err
+		// Wildcards are checked against their alias by ValidateWildcard.
+		if !wildcard.IsWildcard(expr) {
+			for _, part := range dialect.SplitQualified(expr, `"`, `"`) {
+				if IsQuotedIdentifier(part) {
+					continue
+				}
+				if err = ValidateIdentifier(part); err != nil {
+					return identifier.TypeInvalid, "", "", err
+				}
+			}
+			if v, ok := d.(dialect.IdentifierValidator); ok {
+				if err = v.ValidateIdentifier(expr); err != nil {
+					return identifier.TypeInvalid, "", "", err
+				}
+			}
+		}
+		if alias != "" && ValidateAliasFor(d, alias) != nil {
+			return identifier.TypeInvalid, expr, "", fmt.Errorf("invalid alias: %s", alias)
 		}
 
-	case identifier.TypeComputed, identifier.TypeAggregate, identifier.TypeFunction:
+	case identifier.TypeSubquery, identifier.TypeComputed, identifier.TypeAggregate, identifier.TypeFunction:
 		closeIdx := strings.LastIndex(in, ")")
 		expr = strings.TrimSpace(in[:closeIdx+1])
 		rest := strings.TrimSpace(in[closeIdx+1:])
-		alias, err = resolveAlias(rest, in, allowAlias)
+		alias, err = resolveAlias(d, rest, in, allowAlias)
 		if err != nil {
-			return kind, expr, alias, err
+			return identifier.TypeInvalid, expr, "", err
 		}
 
 	case identifier.TypeLiteral:
@@ -98,26 +130,15 @@ func ResolveExpression(
 		if len(parts) > 1 {
 			rest = strings.Join(parts[1:], " ")
 		}
-		alias, err = resolveAlias(rest, in, allowAlias)
+		alias, err = resolveAlias(d, rest, in, allowAlias)
 		if err != nil {
-			return kind, expr, alias, err
+			return identifier.TypeInvalid, expr, "", err
 		}
 
 	default:
 		return identifier.TypeInvalid, "", "", fmt.Errorf("empty identifier is not allowed: %q", in)
 	}
 
-	if kind.IsWildcard() && alias != "" {
-		return kind, expr, "", fmt.Errorf("wilcard cannot be aliased: %q", alias)
-	}
-
-	if err = ValidateIdentifier(expr); err != nil {
-		return kind, "", "", err
-	}
-	if err = ValidateAlias(expr); err != nil {
-		return kind, expr, "", err
-	}
-
 	return kind, expr, alias, nil
 }
 
@@ -135,18 +156,14 @@ func ResolveExpression(
 //  2. Computed: any other parenthesized expression, e.g. "(a+b)"
 //  3. Aggregate: aggregate functions (SUM, COUNT, MAX, MIN, AVG)
 //  4. Function: other calls with parentheses, e.g. JSON_EACH(data)
-//  5. Literal: quoted string or numeric constant
-//  6. Identifier: default fallback (plain table or column name)
+//  5. Literal: single-quoted string or numeric constant
+//  6. Identifier: default fallback (plain or "quoted" table or column name)
 func ResolveExpressionType(expr string) identifier.Type {
 	expr = strings.TrimSpace(expr)
 	if expr == "" {
 		return identifier.TypeInvalid // invalid
 	}
 
-	if wildcard.IsWildcard(expr) {
-		return identifier.TypeWildcard
-	}
-
 	upper := strings.ToUpper(expr)
 
 	// Subquery
@@ -178,9 +195,9 @@ func ResolveExpressionType(expr string) identifier.Type {
 		return identifier.TypeFunction
 	}
 
-	// Literal → numeric or quoted string
+	// Literal → numeric or quoted string. Double quotes delimit
+	// identifiers, not strings.
 	if strings.HasPrefix(expr, "'") ||
-		strings.HasPrefix(expr, "\"") ||
 		extension.NumberOr(expr, -1) != -1 {
 		return identifier.TypeLiteral
 	}
@@ -210,6 +227,54 @@ func ValidateIdentifier(s string) error {
 	return nil
 }
 
+// IsQuotedIdentifier reports whether s is a single identifier part in ANSI
+// double quotes, such as "UserId" or "odd""name". Quoted identifiers keep
+// the author's exact spelling: dialects neither fold nor re-validate them.
+func IsQuotedIdentifier(s string) bool {
+	name, ok := dialect.Unquote(s)
+	return ok && name != "" && !strings.Contains(strings.ReplaceAll(s[1:len(s)-1], `""`, ""), `"`)
+}
+
+// splitFields splits s on whitespace outside of double-quoted identifiers.
+func splitFields(s string) []string {
+	var parts []string
+	var sb strings.Builder
+	quoted := false
+	for _, r := range s {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			sb.WriteRune(r)
+		case !quoted && unicode.IsSpace(r):
+			if sb.Len() > 0 {
+				parts = append(parts, sb.String())
+				sb.Reset()
+			}
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	if sb.Len() > 0 {
+		parts = append(parts, sb.String())
+	}
+	return parts
+}
+
+// ValidateWildcard checks that a wildcard expression carries no alias.
+// Expressions that are not wildcards are ignored and return nil.
+//
+// Examples:
+//
+//	ValidateWildcard("*", "")        → nil
+//	ValidateWildcard("users.*", "u") → error
+//	ValidateWildcard("id", "user")   → nil
+func ValidateWildcard(expr, alias string) error {
+	if !wildcard.IsWildcard(expr) || alias == "" {
+		return nil
+	}
+	return fmt.Errorf("'%s' cannot be aliased or raw", expr)
+}
+
 // ValidateType ensures input type is allowed for token constructors.
 //
 // Rules:
@@ -237,20 +302,20 @@ func ValidateType(v any) error {
 //   - "alias" → simple alias
 //   - "AS alias" → explicit alias
 //
-// Applies allowAlias and IsValidAlias checks.
-func resolveAlias(expr, in string, allowAlias bool) (string, error) {
+// Applies allowAlias and ValidateAliasFor checks.
+func resolveAlias(d dialect.SQLDialect, expr, in string, allowAlias bool) (string, error) {
 	if expr == "" {
 		return "", nil
 	}
 
-	parts := strings.Fields(expr)
+	parts := splitFields(expr)
 
 	// "AS alias"
 	if len(parts) == 2 && strings.EqualFold(parts[0], "AS") {
 		if !allowAlias {
 			return "", fmt.Errorf("alias not allowed: %s", in)
 		}
-		if !IsValidAlias(parts[1]) {
+		if ValidateAliasFor(d, parts[1]) != nil {
 			return "", fmt.Errorf("invalid alias: %s", parts[1])
 		}
 		return parts[1], nil
@@ -261,7 +326,7 @@ func resolveAlias(expr, in string, allowAlias bool) (string, error) {
 		if !allowAlias {
 			return "", fmt.Errorf("alias not allowed: %s", in)
 		}
-		if !IsValidAlias(parts[0]) {
+		if ValidateAliasFor(d, parts[0]) != nil {
 			return "", fmt.Errorf("invalid alias: %s", parts[0])
 		}
 		return parts[0], nil
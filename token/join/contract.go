@@ -2,6 +2,7 @@ package join
 
 import (
 	"github.com/entiqon/db/contract"
+	"github.com/entiqon/db/dialect"
 	"github.com/entiqon/db/token/table"
 	"github.com/entiqon/db/token/types/join"
 )
@@ -14,9 +15,10 @@ import (
 //   - Debuggable → human-readable diagnostics
 //   - Errorable  → explicit error state
 //   - Rawable    → raw SQL fragment
-//   - Renderable → dialect-agnostic rendering
+//   - Renderable → dialect-agnostic rendering, RenderFor(d) for a dialect
 //   - Stringable → concise string form
 //   - Validable  → structural validity
+//   - Parent     → tree node over the left and right tables
 //
 // In addition, Join tokens expose token-specific accessors:
 //
@@ -41,6 +43,10 @@ type Token interface {
 	contract.Stringable
 	contract.Validable
 
+	// Parent exposes the left and right tables as children, so joins
+	// can be walked and rewritten with token.Walk and token.Rewrite.
+	contract.Parent
+
 	// Kind reports the type of token (INNER, LEFT, RIGHT, FULL).
 	Kind() join.Type
 
@@ -52,6 +58,11 @@ type Token interface {
 
 	// Condition returns the ON condition expression.
 	Condition() string
+
+	// RenderFor returns the join rendered for dialect d, with the right
+	// table aliased following the dialect's rules. A nil d renders like
+	// Raw.
+	RenderFor(d dialect.SQLDialect) string
 }
 
 // Ensure token implements the Token interface.
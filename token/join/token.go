@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/entiqon/db/contract"
+	"github.com/entiqon/db/dialect"
 	"github.com/entiqon/db/token/table"
 	"github.com/entiqon/db/token/types/join"
 )
@@ -82,13 +84,14 @@ func NewNatural(left, right any) Token {
 
 // Clone returns a deep copy of the token.
 func (t *token) Clone() Token {
-	return &token{
-		kind:      t.kind,
-		left:      t.left.Clone(),
-		right:     t.right.Clone(),
-		condition: t.condition,
-		err:       t.err,
+	cp := *t
+	if t.left != nil {
+		cp.left = t.left.Clone()
+	}
+	if t.right != nil {
+		cp.right = t.right.Clone()
 	}
+	return &cp
 }
 
 // Kind returns the type of the token (INNER, LEFT, RIGHT, FULL).
@@ -111,6 +114,26 @@ func (t *token) Condition() string {
 	return t.condition
 }
 
+// Children returns the left and right table operands. Either may be nil
+// on an errored join.
+func (t *token) Children() []contract.Node {
+	return []contract.Node{t.left, t.right}
+}
+
+// WithChildren returns a new join of the same kind and condition whose
+// operands are children[0] and children[1], validated as by New. The
+// receiver is unchanged.
+func (t *token) WithChildren(children []contract.Node) contract.Node {
+	if len(children) != 2 {
+		cp := t.Clone()
+		return cp.SetError(fmt.Errorf("token expects 2 children, got %d", len(children)))
+	}
+	if !t.kind.IsValid() {
+		return t.Clone()
+	}
+	return newWithKind(t.kind, children[0], children[1], t.condition)
+}
+
 // Debug returns an auditable representation of the token.
 // Includes validity state and error if applicable.
 func (t *token) Debug() string {
@@ -173,25 +196,40 @@ func (t *token) Raw() string {
 	if t.err != nil {
 		return ""
 	}
+	return t.sql(t.right.Raw())
+}
 
+// Render produces the canonical SQL fragment for the token.
+// It is dialect-agnostic and delegates to Raw().
+func (t *token) Render() string {
+	return t.Raw()
+}
+
+// RenderFor produces the SQL fragment for dialect d: the right table is
+// rendered through table.Token.RenderFor, so its alias follows the
+// dialect's aliasing rules (no AS on Oracle). A nil d renders like Raw.
+// If the token is errored, RenderFor returns an empty string.
+func (t *token) RenderFor(d dialect.SQLDialect) string {
+	if t.err != nil {
+		return ""
+	}
+	return t.sql(t.right.RenderFor(d))
+}
+
+// sql renders the token with the given right table text.
+func (t *token) sql(right string) string {
 	// 🔑 Special rendering for CROSS / NATURAL joins
 	if t.kind == join.Cross || t.kind == join.Natural {
-		return fmt.Sprintf("%s %s", t.kind, t.right.Raw())
+		return fmt.Sprintf("%s %s", t.kind, right)
 	}
 
 	return fmt.Sprintf("%s %s ON %s",
 		t.kind,
-		t.right.Raw(),
+		right,
 		strings.TrimSpace(t.condition),
 	)
 }
 
-// Render produces the canonical SQL fragment for the token.
-// It is dialect-agnostic and delegates to Raw().
-func (t *token) Render() string {
-	return t.Raw()
-}
-
 // String returns a concise, loggable representation of the token.
 // Valid joins are marked with ✅, invalid ones with ⛔.
 func (t *token) String() string {
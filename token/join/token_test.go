@@ -4,6 +4,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/entiqon/db/dialect/oracle"
+	"github.com/entiqon/db/token/field"
 	"github.com/entiqon/db/token/join"
 	"github.com/entiqon/db/token/table"
 	jt "github.com/entiqon/db/token/types/join"
@@ -189,6 +191,39 @@ func TestJoin(t *testing.T) {
 			}
 		})
 
+		t.Run("Node", func(t *testing.T) {
+			j := join.NewInner("users u", "orders o", "u.id = o.user_id")
+			children := j.Children()
+			if len(children) != 2 || children[0] != j.Left() || children[1] != j.Right() {
+				t.Fatalf("expected the left and right tables, got %v", children)
+			}
+
+			children[1] = table.New("orders_07 o")
+			rj := j.WithChildren(children).(join.Token)
+			if rj == j || !rj.IsValid() {
+				t.Fatalf("expected a valid copy, got %v", rj)
+			}
+			if got := rj.Render(); got != "INNER JOIN orders_07 AS o ON u.id = o.user_id" {
+				t.Errorf("unexpected render %q", got)
+			}
+			if got := j.Right().Name(); got != "orders" {
+				t.Errorf("expected the original to be unchanged, got %q", got)
+			}
+
+			children[1] = field.New("id")
+			if rj = j.WithChildren(children).(join.Token); !rj.IsErrored() {
+				t.Errorf("expected an errored join for a non-table child, got %v", rj)
+			}
+			if rj = j.WithChildren(children[:1]).(join.Token); !rj.IsErrored() {
+				t.Errorf("expected an errored join for missing children, got %v", rj)
+			}
+
+			invalid := join.NewCross(nil, "orders")
+			if cp := invalid.Clone(); cp.Left() != nil || !cp.IsErrored() {
+				t.Errorf("expected Clone to keep a nil operand, got %v", cp)
+			}
+		})
+
 		t.Run("Rawable", func(t *testing.T) {
 			j := join.NewLeft("users", "orders", "users.id = orders.user_id")
 			if !strings.HasPrefix(j.Raw(), "LEFT JOIN") {
@@ -208,6 +243,19 @@ func TestJoin(t *testing.T) {
 			}
 		})
 
+		t.Run("RenderFor", func(t *testing.T) {
+			j := join.NewLeft("users u", "orders o", "o.user_id = u.id")
+			if got := j.RenderFor(oracle.New()); got != "LEFT JOIN orders o ON o.user_id = u.id" {
+				t.Errorf("unexpected Oracle render %q", got)
+			}
+			if got := j.RenderFor(nil); got != j.Raw() {
+				t.Errorf("expected RenderFor(nil) to match Raw, got %q", got)
+			}
+			if got := join.New("SIDEWAYS", "users", "orders", "id = 1").RenderFor(oracle.New()); got != "" {
+				t.Errorf("expected an empty render for an errored join, got %q", got)
+			}
+		})
+
 		t.Run("Stringable", func(t *testing.T) {
 			j := join.NewRight("users", "orders", "users.id = orders.user_id")
 			s := j.String()
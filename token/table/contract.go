@@ -1,6 +1,9 @@
 package table
 
-import "github.com/entiqon/db/contract"
+import (
+	"github.com/entiqon/db/contract"
+	"github.com/entiqon/db/dialect"
+)
 
 // Token defines the contract implemented by all table tokens.
 // A table token represents a SQL source (table or subquery)
@@ -47,6 +50,11 @@ type Token interface {
 	// Renderable produces canonical SQL output (expr + alias).
 	contract.Renderable
 
+	// RenderFor produces the SQL output for dialect d: the alias is
+	// quoted and introduced following the dialect's aliasing rules.
+	// A nil d renders like Raw.
+	RenderFor(d dialect.SQLDialect) string
+
 	// Stringable produces a concise log/debug string
 	// prefixed with validity markers (✅/❌).
 	contract.Stringable
@@ -54,6 +62,9 @@ type Token interface {
 	// Validable exposes structural validation via IsValid().
 	contract.Validable
 
+	// Node makes the token a leaf of the query tree walked by token.Walk.
+	contract.Node
+
 	// Name returns the normalized table identifier
 	// (base name without alias). For subqueries, this is
 	// the unaliased expression string.
@@ -8,7 +8,7 @@
 //
 // # Construction
 //
-// Tables are created using New(...):
+// Tables are created using New(...) or NewWithDialect(...):
 //
 //   - Plain table:
 //     table.New("users") → users
@@ -29,6 +29,9 @@
 //     table.New("users AS")    → errored
 //     table.New("users x y z") → errored
 //
+//   - Dialect-bound (reserved aliases are quoted on Render):
+//     table.NewWithDialect(generic.New(), "users", "user") → users AS "user"
+//
 // # Contracts
 //
 // Table implements the following contracts from db/contract:
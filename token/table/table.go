@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/entiqon/db/contract"
+	"github.com/entiqon/db/dialect"
 	"github.com/entiqon/db/errors"
 	"github.com/entiqon/db/token/helpers"
 	"github.com/entiqon/db/token/types/identifier"
@@ -28,6 +30,7 @@ import (
 //   - err: any construction or validation error.
 //   - isRaw: whether the table was constructed via the two-argument
 //     form or as a subquery.
+//   - dialect: the optional dialect the table was validated against.
 type table struct {
 	kind identifier.Type
 
@@ -46,6 +49,10 @@ type table struct {
 	// isRaw reports whether the table was constructed via the
 	// explicit two-argument form or is a subquery.
 	isRaw bool
+
+	// dialect is the optional dialect used to validate the table and to
+	// quote its alias on Render.
+	dialect dialect.SQLDialect
 }
 
 // New constructs a new Table from user input.
@@ -64,9 +71,30 @@ type table struct {
 // If construction fails, the returned table is errored but
 // still carries the original input for diagnostics.
 func New(input ...any) Token {
+	return newTable(nil, input...)
+}
+
+// NewWithDialect constructs a Table validated against dialect d.
+//
+// Accepted forms are those of New. Aliases reserved by d are accepted
+// and quoted by Render through d.QuoteIdentifier, and table names are
+// checked by d when it implements dialect.IdentifierValidator.
+// A nil d behaves like New.
+//
+// Example:
+//
+//	t := table.NewWithDialect(generic.New(), "users", "user")
+//	t.Render() // users AS "user"
+func NewWithDialect(d dialect.SQLDialect, input ...any) Token {
+	return newTable(d, input...)
+}
+
+// newTable implements New and NewWithDialect.
+func newTable(d dialect.SQLDialect, input ...any) Token {
 	t := &table{
-		kind:  identifier.Invalid,
-		input: fmt.Sprint(input...),
+		kind:    identifier.TypeInvalid,
+		input:   fmt.Sprint(input...),
+		dialect: d,
 	}
 
 	if len(input) == 0 {
@@ -90,7 +118,7 @@ func New(input ...any) Token {
 	t.input = strings.Join(helpers.Stringify(input), " ") // keep audit trail
 
 	// always resolve the first part
-	kind, expr, alias, err := helpers.ResolveExpression(fmt.Sprint(input[0]), true)
+	kind, expr, alias, err := helpers.ResolveExpressionFor(d, fmt.Sprint(input[0]), true)
 	if err != nil {
 		return t.SetError(err)
 	}
@@ -101,14 +129,14 @@ func New(input ...any) Token {
 		if !ok {
 			return t.SetError(fmt.Errorf("alias must be a string, got %T", input[1]))
 		}
-		if err := helpers.ValidateAlias(a); err != nil {
+		if err := helpers.ValidateAliasFor(d, a); err != nil {
 			return t.SetError(err)
 		}
 		t.alias = a
 	}
 
 	// ✅ one place only: context rule
-	if t.kind == identifier.Literal || t.kind == identifier.Aggregate {
+	if t.kind == identifier.TypeLiteral || t.kind == identifier.TypeAggregate {
 		return t.SetError(fmt.Errorf(
 			"%s %q cannot be used as a table source",
 			strings.ToLower(t.kind.String()), t.name,
@@ -139,14 +167,19 @@ func (t *table) IsAliased() bool { return t.alias != "" }
 // Clone returns a semantic copy of the table.
 func (t *table) Clone() Token {
 	return &table{
-		input: t.input,
-		name:  t.name,
-		alias: t.alias,
-		err:   t.err,
-		isRaw: t.isRaw,
+		kind:    t.kind,
+		input:   t.input,
+		name:    t.name,
+		alias:   t.alias,
+		err:     t.err,
+		isRaw:   t.isRaw,
+		dialect: t.dialect,
 	}
 }
 
+// Children returns nil: a table is a leaf of the query tree.
+func (t *table) Children() []contract.Node { return nil }
+
 // Debug returns a developer-facing representation of the table.
 //
 // The output is verbose and intended for diagnostics, showing the
@@ -183,7 +216,7 @@ func (t *table) SetError(err error) Token {
 // (subquery, explicit 2-arg form, or anything that is not a plain identifier).
 func (t *table) IsRaw() bool {
 	switch t.kind {
-	case identifier.Subquery, identifier.Computed, identifier.Function, identifier.Aggregate:
+	case identifier.TypeSubquery, identifier.TypeComputed, identifier.TypeFunction, identifier.TypeAggregate:
 		return true
 	default:
 		return false
@@ -207,18 +240,28 @@ func (t *table) Raw() string {
 // Render returns the canonical SQL representation of the table.
 //
 // Render() differs from Raw() in that it represents the resolved form
-// the builder will actually use. It may later be extended to apply
-// dialect-specific quoting or rewriting.
+// the builder will actually use. Tables built with NewWithDialect quote
+// their alias through the dialect, so reserved words are delimited.
 //
 // If the table is invalid or errored, Render() returns an empty string.
 func (t *table) Render() string {
+	return t.RenderFor(t.dialect)
+}
+
+// RenderFor returns the SQL representation of the table for dialect d,
+// regardless of the dialect it was built with. The alias is quoted through
+// d and introduced by dialect.AliasTable, so dialects that forbid AS for
+// table aliases (Oracle) render "name alias". A nil d returns Raw().
+//
+// If the table is invalid or errored, RenderFor() returns an empty string.
+func (t *table) RenderFor(d dialect.SQLDialect) string {
 	if !t.IsValid() {
 		return ""
 	}
-	if t.alias != "" {
-		return fmt.Sprintf("%s AS %s", t.name, t.alias)
+	if d == nil || t.alias == "" {
+		return t.Raw()
 	}
-	return t.name
+	return dialect.AliasTable(d, t.name, d.QuoteIdentifier(t.alias))
 }
 
 // String returns the human-facing representation of the table.
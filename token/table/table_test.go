@@ -4,6 +4,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/entiqon/db/dialect/generic"
+	"github.com/entiqon/db/dialect/oracle"
 	"github.com/entiqon/db/token/table"
 	"github.com/entiqon/db/token/types/identifier"
 )
@@ -44,7 +46,7 @@ func TestTable(t *testing.T) {
 				if tbl.Input() != "table" {
 					t.Errorf("expected table 'name', got %v", tbl.Name())
 				}
-				if tbl.ExpressionKind() != identifier.Expression {
+				if tbl.ExpressionKind() != identifier.TypeExpression {
 					t.Errorf("expected kind=Expression, got %v", tbl.ExpressionKind())
 				}
 				if tbl.Name() != "table" {
@@ -114,6 +116,57 @@ func TestTable(t *testing.T) {
 				t.Fatal("expected error for literal, got nil")
 			}
 		})
+		t.Run("Quoted", func(t *testing.T) {
+			src := table.New(`public."Users" u`)
+			if src.IsErrored() {
+				t.Fatalf("expected no error, got %v", src.Error())
+			}
+			if src.Name() != `public."Users"` || src.Render() != `public."Users" AS u` {
+				t.Errorf("unexpected Name()=%q Render()=%q", src.Name(), src.Render())
+			}
+			if src = table.New(`"Order Items"`); src.IsErrored() || src.Name() != `"Order Items"` {
+				t.Errorf("expected quoted name with a space to be kept, got %q (%v)", src.Name(), src.Error())
+			}
+		})
+
+		t.Run("NewWithDialect", func(t *testing.T) {
+			src := table.NewWithDialect(generic.New(), "users user")
+			if src.IsErrored() {
+				t.Fatalf("expected no error, got %v", src.Error())
+			}
+			if src.Raw() != "users AS user" || src.Render() != `users AS "user"` {
+				t.Errorf("unexpected Raw()=%q Render()=%q", src.Raw(), src.Render())
+			}
+			if src.Clone().Render() != `users AS "user"` {
+				t.Errorf("expected Clone() to keep the dialect, got %q", src.Clone().Render())
+			}
+
+			src = table.NewWithDialect(oracle.New(), strings.Repeat("t", 129))
+			if !src.IsErrored() {
+				t.Error("expected Oracle to reject an over-long table name")
+			}
+
+			src = table.NewWithDialect(nil, "users", "order")
+			if !src.IsErrored() {
+				t.Error("expected reserved alias to be rejected without a dialect")
+			}
+		})
+
+		t.Run("RenderFor", func(t *testing.T) {
+			src := table.New("users u")
+			if src.RenderFor(nil) != "users AS u" || src.RenderFor(generic.New()) != "users AS u" {
+				t.Errorf("unexpected RenderFor() %q", src.RenderFor(generic.New()))
+			}
+			if got := src.RenderFor(oracle.New()); got != "users u" {
+				t.Errorf("expected oracle alias without AS, got %q", got)
+			}
+			if got := table.New("users").RenderFor(oracle.New()); got != "users" {
+				t.Errorf("expected unaliased table, got %q", got)
+			}
+			if got := table.New("").RenderFor(generic.New()); got != "" {
+				t.Errorf("expected empty render for invalid table, got %q", got)
+			}
+		})
 	})
 
 	t.Run("Contracts", func(t *testing.T) {
@@ -125,7 +178,7 @@ func TestTable(t *testing.T) {
 			if src.Input() != "table t" {
 				t.Errorf("expected field, got %v", src.Input())
 			}
-			if src.ExpressionKind() != identifier.Expression {
+			if src.ExpressionKind() != identifier.TypeExpression {
 				t.Errorf("expected kind Identifier, got %s", src.ExpressionKind().String())
 			}
 			if src.Expr() != "table" {
@@ -152,6 +205,11 @@ func TestTable(t *testing.T) {
 			if src == clone {
 				t.Error("expected clone to be a different instance")
 			}
+
+			sub := table.New("(SELECT 1) t")
+			if got := sub.Clone().ExpressionKind(); got != sub.ExpressionKind() {
+				t.Errorf("expected clone to keep kind %v, got %v", sub.ExpressionKind(), got)
+			}
 		})
 
 		t.Run("Debuggable", func(t *testing.T) {
@@ -177,6 +235,12 @@ func TestTable(t *testing.T) {
 			}
 		})
 
+		t.Run("Node", func(t *testing.T) {
+			if got := table.New("users u").Children(); got != nil {
+				t.Errorf("expected a leaf, got %v", got)
+			}
+		})
+
 		t.Run("Rawable", func(t *testing.T) {
 			src := table.New("table")
 			if src.IsRaw() {
@@ -40,7 +40,7 @@ func ExampleGetKnownOperators() {
 	fmt.Println(len(operator.GetKnownOperators()), "active operators")
 
 	// Output:
-	// 15 active operators
+	// 17 active operators
 }
 
 func ExampleParseFrom() {
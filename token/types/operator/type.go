@@ -198,6 +198,26 @@ const (
 	//   Operator:   Concat
 	//   Output:     "||"
 	Concat
+
+	// ----------------------------------------------------------------------
+	// Case-insensitive pattern matching (emulated on dialects without ILIKE)
+	// ----------------------------------------------------------------------
+
+	// ILike represents the case-insensitive "ILIKE" operator.
+	//
+	// Example (PostgreSQL, Snowflake):
+	//   Condition: "name ILIKE ?"
+	//   Operator:  ILike
+	//   Output:    "ILIKE"
+	ILike
+
+	// NotILike represents the "NOT ILIKE" operator.
+	//
+	// Example (PostgreSQL, Snowflake):
+	//   Condition: "name NOT ILIKE ?"
+	//   Operator:  NotILike
+	//   Output:    "NOT ILIKE"
+	NotILike
 )
 
 // Meta describes a supported operator: its canonical spelling, alias, and a
@@ -213,23 +233,25 @@ type Meta struct {
 // registry declares all supported operators in one place.
 // Positions are chosen to match the expected order:
 //
-// [IS NOT DISTINCT FROM IS DISTINCT FROM IS NOT NULL NOT LIKE BETWEEN IS NULL NOT IN LIKE IN != >= <= > < =]
+// [IS NOT DISTINCT FROM IS DISTINCT FROM IS NOT NULL NOT ILIKE NOT LIKE BETWEEN IS NULL NOT IN ILIKE LIKE IN != >= <= > < =]
 var registry = map[Type]Meta{
 	NotIsDistinctFrom:  {String: "IS NOT DISTINCT FROM", Alias: "nd", Position: 1, Synonyms: []string{"is not distinct from", "notdistinct"}},
 	IsDistinctFrom:     {String: "IS DISTINCT FROM", Alias: "isdf", Position: 2, Synonyms: []string{"is distinct from", "isdistinct"}},
 	IsNotNull:          {String: "IS NOT NULL", Alias: "nn", Position: 3, Synonyms: []string{"is not null", "notnull"}},
-	NotLike:            {String: "NOT LIKE", Alias: "nl", Position: 4, Synonyms: []string{"not like", "nlike"}},
-	Between:            {String: "BETWEEN", Alias: "between", Position: 5, Synonyms: []string{"between"}},
-	IsNull:             {String: "IS NULL", Alias: "isn", Position: 6, Synonyms: []string{"is null", "isnull"}},
-	NotIn:              {String: "NOT IN", Alias: "nin", Position: 7, Synonyms: []string{"not in", "nin"}},
-	Like:               {String: "LIKE", Alias: "like", Position: 8, Synonyms: []string{"like"}},
-	In:                 {String: "IN", Alias: "in", Position: 9, Synonyms: []string{"in"}},
-	NotEqual:           {String: "!=", Alias: "neq", Position: 10, Synonyms: []string{"!=", "<>", "neq"}},
-	GreaterThanOrEqual: {String: ">=", Alias: "gte", Position: 11, Synonyms: []string{">=", "gte"}},
-	LessThanOrEqual:    {String: "<=", Alias: "lte", Position: 12, Synonyms: []string{"<=", "lte"}},
-	GreaterThan:        {String: ">", Alias: "gt", Position: 13, Synonyms: []string{">", "gt"}},
-	LessThan:           {String: "<", Alias: "lt", Position: 14, Synonyms: []string{"<", "lt"}},
-	Equal:              {String: "=", Alias: "eq", Position: 15, Synonyms: []string{"=", "eq"}},
+	NotILike:           {String: "NOT ILIKE", Alias: "nilike", Position: 4, Synonyms: []string{"not ilike"}},
+	NotLike:            {String: "NOT LIKE", Alias: "nl", Position: 5, Synonyms: []string{"not like", "nlike"}},
+	Between:            {String: "BETWEEN", Alias: "between", Position: 6, Synonyms: []string{"between"}},
+	IsNull:             {String: "IS NULL", Alias: "isn", Position: 7, Synonyms: []string{"is null", "isnull"}},
+	NotIn:              {String: "NOT IN", Alias: "nin", Position: 8, Synonyms: []string{"not in", "nin"}},
+	ILike:              {String: "ILIKE", Alias: "ilike", Position: 9, Synonyms: []string{"ilike"}},
+	Like:               {String: "LIKE", Alias: "like", Position: 10, Synonyms: []string{"like"}},
+	In:                 {String: "IN", Alias: "in", Position: 11, Synonyms: []string{"in"}},
+	NotEqual:           {String: "!=", Alias: "neq", Position: 12, Synonyms: []string{"!=", "<>", "neq"}},
+	GreaterThanOrEqual: {String: ">=", Alias: "gte", Position: 13, Synonyms: []string{">=", "gte"}},
+	LessThanOrEqual:    {String: "<=", Alias: "lte", Position: 14, Synonyms: []string{"<=", "lte"}},
+	GreaterThan:        {String: ">", Alias: "gt", Position: 15, Synonyms: []string{">", "gt"}},
+	LessThan:           {String: "<", Alias: "lt", Position: 16, Synonyms: []string{"<", "lt"}},
+	Equal:              {String: "=", Alias: "eq", Position: 17, Synonyms: []string{"=", "eq"}},
 }
 
 // Arithmetic operators appended for computed expression support.
@@ -127,10 +127,12 @@ func TestGetKnownOperators(t *testing.T) {
 			"IS NOT DISTINCT FROM",
 			"IS DISTINCT FROM",
 			"IS NOT NULL",
+			"NOT ILIKE",
 			"NOT LIKE",
 			"BETWEEN",
 			"IS NULL",
 			"NOT IN",
+			"ILIKE",
 			"LIKE",
 			"IN",
 			"!=",
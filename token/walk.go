@@ -0,0 +1,64 @@
+// File: db/token/walk.go
+
+package token
+
+import "github.com/entiqon/db/contract"
+
+// Visitor is called by Walk for each node of a query tree. Returning
+// false skips the children of the node.
+type Visitor func(n contract.Node) bool
+
+// Walk visits node, then its children recursively, depth first and in
+// rendering order. Nil nodes are skipped. Use it for analyses that read
+// the tree: collecting the referenced tables, auditing the columns, ...
+//
+// Example:
+//
+//	var tables []string
+//	token.Walk(sb, func(n contract.Node) bool {
+//	    if t, ok := n.(table.Token); ok {
+//	        tables = append(tables, t.Name())
+//	    }
+//	    return true
+//	})
+func Walk(node contract.Node, visit Visitor) {
+	if node == nil || !visit(node) {
+		return
+	}
+	for _, child := range node.Children() {
+		Walk(child, visit)
+	}
+}
+
+// Rewrite returns a copy of the tree rooted at node in which each node is
+// replaced by fn(node). Children are rewritten before their parent, which
+// is rebuilt through contract.Parent.WithChildren, so fn sees a parent
+// with its rewritten children. The original tree is left unchanged.
+//
+// fn returns the node itself to keep it. Returning nil removes a field,
+// join or condition; returning a node of the wrong type yields an errored
+// token, reported by Build.
+//
+// Example:
+//
+//	// route orders to their shard
+//	sb = token.Rewrite(sb, func(n contract.Node) contract.Node {
+//	    if t, ok := n.(table.Token); ok && t.Name() == "orders" {
+//	        return table.New("orders_07", t.Alias())
+//	    }
+//	    return n
+//	}).(selects.SelectBuilder)
+func Rewrite(node contract.Node, fn func(contract.Node) contract.Node) contract.Node {
+	if node == nil {
+		return nil
+	}
+	if p, ok := node.(contract.Parent); ok {
+		children := p.Children()
+		rewritten := make([]contract.Node, len(children))
+		for i, child := range children {
+			rewritten[i] = Rewrite(child, fn)
+		}
+		node = p.WithChildren(rewritten)
+	}
+	return fn(node)
+}
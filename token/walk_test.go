@@ -0,0 +1,161 @@
+// File: db/token/walk_test.go
+
+package token_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/entiqon/db/builder/selects"
+	"github.com/entiqon/db/contract"
+	"github.com/entiqon/db/token"
+	"github.com/entiqon/db/token/condition"
+	"github.com/entiqon/db/token/field"
+	"github.com/entiqon/db/token/join"
+	"github.com/entiqon/db/token/table"
+	"github.com/entiqon/db/token/types/operator"
+)
+
+func query() selects.SelectBuilder {
+	return selects.New(nil).
+		Fields("u.id, u.name, o.total").
+		From("users u").
+		InnerJoin("users u", "orders o", "u.id = o.user_id").
+		Where("u.active", operator.Equal, true).
+		AndWhere("o.total", operator.GreaterThan, 100)
+}
+
+func TestWalk(t *testing.T) {
+	t.Run("Tables", func(t *testing.T) {
+		var tables []string
+		token.Walk(query(), func(n contract.Node) bool {
+			if tb, ok := n.(table.Token); ok {
+				tables = append(tables, tb.Name())
+			}
+			return true
+		})
+		if want := []string{"users", "users", "orders"}; !slices.Equal(tables, want) {
+			t.Errorf("expected %v, got %v", want, tables)
+		}
+	})
+
+	t.Run("Columns", func(t *testing.T) {
+		var columns []string
+		token.Walk(query(), func(n contract.Node) bool {
+			switch v := n.(type) {
+			case field.Token:
+				columns = append(columns, v.Expr())
+			case condition.Token:
+				columns = append(columns, v.Field())
+			}
+			return true
+		})
+		if want := []string{"u.id", "u.name", "o.total", "u.active", "o.total"}; !slices.Equal(columns, want) {
+			t.Errorf("expected %v, got %v", want, columns)
+		}
+	})
+
+	t.Run("Skip", func(t *testing.T) {
+		var visited int
+		token.Walk(query(), func(n contract.Node) bool {
+			visited++
+			_, isJoin := n.(join.Token)
+			return !isJoin
+		})
+		// builder, 3 fields, table, join, 2 conditions
+		if visited != 8 {
+			t.Errorf("expected 8 visited nodes, got %d", visited)
+		}
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		token.Walk(nil, func(n contract.Node) bool {
+			t.Errorf("unexpected visit of %v", n)
+			return true
+		})
+		token.Walk(join.NewCross(nil, "orders"), func(n contract.Node) bool {
+			if n == nil {
+				t.Error("unexpected visit of a nil node")
+			}
+			return true
+		})
+	})
+}
+
+func TestRewrite(t *testing.T) {
+	t.Run("Shard", func(t *testing.T) {
+		sb := query()
+		routed := token.Rewrite(sb, func(n contract.Node) contract.Node {
+			if tb, ok := n.(table.Token); ok && tb.Name() == "orders" {
+				return table.New("orders_07", tb.Alias())
+			}
+			return n
+		}).(selects.SelectBuilder)
+
+		sql, _, err := routed.Build()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := "SELECT u.id, u.name, o.total FROM users AS u INNER JOIN orders_07 AS o ON u.id = o.user_id " +
+			"WHERE u.active = :u_active AND o.total > :o_total"
+		if sql != want {
+			t.Errorf("expected %q, got %q", want, sql)
+		}
+
+		if sql, _, _ := sb.Build(); sql == want {
+			t.Error("expected the original builder to be unchanged")
+		}
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		sb := token.Rewrite(query(), func(n contract.Node) contract.Node {
+			switch v := n.(type) {
+			case field.Token:
+				if v.Expr() == "u.name" {
+					return nil
+				}
+			case condition.Token:
+				if v.Field() == "u.active" {
+					return nil
+				}
+			}
+			return n
+		}).(selects.SelectBuilder)
+
+		sql, _, err := sb.Build()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := "SELECT u.id, o.total FROM users AS u INNER JOIN orders AS o ON u.id = o.user_id " +
+			"WHERE o.total > :o_total"
+		if sql != want {
+			t.Errorf("expected %q, got %q", want, sql)
+		}
+	})
+
+	t.Run("WrongType", func(t *testing.T) {
+		sb := token.Rewrite(query(), func(n contract.Node) contract.Node {
+			if _, ok := n.(condition.Token); ok {
+				return table.New("users")
+			}
+			return n
+		}).(selects.SelectBuilder)
+
+		if _, _, err := sb.Build(); err == nil {
+			t.Error("expected an error for a table in place of a condition")
+		}
+	})
+
+	t.Run("Leaf", func(t *testing.T) {
+		f := field.New("id")
+		got := token.Rewrite(f, func(n contract.Node) contract.Node {
+			return field.New("user_id")
+		})
+		if got.(field.Token).Expr() != "user_id" || f.Expr() != "id" {
+			t.Errorf("unexpected rewrite %v of %v", got, f)
+		}
+		if token.Rewrite(nil, func(n contract.Node) contract.Node { return n }) != nil {
+			t.Error("expected nil for a nil node")
+		}
+	})
+}